@@ -20,6 +20,7 @@ import (
 	"github.com/kubeflow/arena/pkg/apis/types"
 	"github.com/kubeflow/arena/pkg/apis/utils"
 	"github.com/kubeflow/arena/pkg/model/analyze"
+	"github.com/kubeflow/arena/pkg/workflow"
 )
 
 type AnalyzeClient struct {
@@ -43,7 +44,12 @@ func (m *AnalyzeClient) Namespace(namespace string) *AnalyzeClient {
 	return copyModelJobClient
 }
 
+// Submit submits a model analysis job, the job is only printed or validated if option --dry-run is given
 func (m *AnalyzeClient) Submit(job *apisanalyze.Job) error {
+	return workflow.IgnoreDryRun(m.submit(job))
+}
+
+func (m *AnalyzeClient) submit(job *apisanalyze.Job) error {
 	switch job.Type() {
 	case types.ModelProfileJob:
 		args := job.Args().(*types.ModelProfileArgs)
@@ -89,6 +89,16 @@ func (a *ArenaClient) Node() *NodeClient {
 	return NewNodeClient(a.namespace, a.arenaConfiger)
 }
 
+// Queue returns the kueue queue client
+func (a *ArenaClient) Queue() *QueueClient {
+	return NewQueueClient(a.namespace, a.arenaConfiger)
+}
+
+// Template returns the job template client
+func (a *ArenaClient) Template() *TemplateClient {
+	return NewTemplateClient(a.arenaConfiger)
+}
+
 func (a *ArenaClient) Data() *DataClient {
 	return NewDataClient(a.namespace, a.arenaConfiger)
 }
@@ -101,6 +111,11 @@ func (a *ArenaClient) Analyze() *AnalyzeClient {
 	return NewAnalyzeClient(a.namespace, a.arenaConfiger)
 }
 
+// Manifest returns the job manifest client
+func (a *ArenaClient) Manifest() *ManifestClient {
+	return NewManifestClient(a.namespace, a.arenaSystemNamespace, a.arenaConfiger)
+}
+
 func (a *ArenaClient) Model() (*ModelClient, error) {
 	return NewModelClient(a.namespace, a.arenaConfiger)
 }
@@ -22,6 +22,7 @@ import (
 	"github.com/kubeflow/arena/pkg/apis/types"
 	"github.com/kubeflow/arena/pkg/apis/utils"
 	"github.com/kubeflow/arena/pkg/cron"
+	"github.com/kubeflow/arena/pkg/workflow"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -43,7 +44,7 @@ func (c *CronClient) SubmitCronTrainingJob(job *apiscron.Job) error {
 	switch job.Type() {
 	case types.CronTFTrainingJob:
 		args := job.Args().(*types.CronTFJobArgs)
-		return cron.SubmitCronTFJob(c.namespace, args)
+		return workflow.IgnoreDryRun(cron.SubmitCronTFJob(c.namespace, args))
 	}
 	return nil
 }
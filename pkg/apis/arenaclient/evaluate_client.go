@@ -25,6 +25,7 @@ import (
 	"github.com/kubeflow/arena/pkg/apis/types"
 	"github.com/kubeflow/arena/pkg/apis/utils"
 	"github.com/kubeflow/arena/pkg/evaluate"
+	"github.com/kubeflow/arena/pkg/workflow"
 )
 
 type EvaluateClient struct {
@@ -74,7 +75,7 @@ func (c *EvaluateClient) SubmitEvaluateJob(job *apievaluate.EvaluateJob) error {
 	}
 	args.Labels["jobId"] = jobId
 
-	return evaluate.SubmitEvaluateJob(c.namespace, args)
+	return workflow.IgnoreDryRun(evaluate.SubmitEvaluateJob(c.namespace, args))
 }
 
 func (c *EvaluateClient) Get(name, namespace string) (*types.EvaluateJobInfo, error) {
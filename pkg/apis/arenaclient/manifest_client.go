@@ -0,0 +1,155 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arenaclient
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/manifest"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/apis/utils"
+	"github.com/kubeflow/arena/pkg/serving"
+	"github.com/kubeflow/arena/pkg/training"
+)
+
+// ManifestClient applies, diffs and exports declarative job manifests
+type ManifestClient struct {
+	namespace            string
+	arenaSystemNamespace string
+	configer             *config.ArenaConfiger
+}
+
+// NewManifestClient creates a ManifestClient
+func NewManifestClient(namespace, arenaSystemNamespace string, configer *config.ArenaConfiger) *ManifestClient {
+	return &ManifestClient{
+		namespace:            namespace,
+		arenaSystemNamespace: arenaSystemNamespace,
+		configer:             configer,
+	}
+}
+
+// Apply submits the job described by the manifest if it does not exist,
+// returns false if the job exists and is same as the manifest
+func (m *ManifestClient) Apply(jobManifest *types.JobManifest) (bool, error) {
+	live, err := m.Live(jobManifest)
+	if err != nil {
+		return false, err
+	}
+	if live != nil {
+		items, err := manifest.Diff(jobManifest, live)
+		if err != nil {
+			return false, err
+		}
+		if len(items) == 0 {
+			return false, nil
+		}
+		return false, fmt.Errorf("the job %v/%v is already exist and differs from the manifest in %v option(s), "+
+			"please use 'arena diff' to check it and delete the job before applying", jobManifest.Kind, jobManifest.Metadata.Name, len(items))
+	}
+	namespace := m.namespaceOf(jobManifest)
+	if manifest.IsTrainingKind(jobManifest.Kind) {
+		job, err := manifest.BuildTrainingJob(jobManifest)
+		if err != nil {
+			return false, err
+		}
+		return true, NewTrainingJobClient(namespace, m.arenaSystemNamespace, m.configer).Submit(job)
+	}
+	job, err := manifest.BuildServingJob(jobManifest, namespace)
+	if err != nil {
+		return false, err
+	}
+	return true, NewServingJobClient(namespace, m.configer).Submit(job)
+}
+
+// Diff compares the manifest with the running job,
+// returns nil if the job does not exist
+func (m *ManifestClient) Diff(jobManifest *types.JobManifest) ([]types.ManifestDiffItem, error) {
+	live, err := m.Live(jobManifest)
+	if err != nil || live == nil {
+		return nil, err
+	}
+	return manifest.Diff(jobManifest, live)
+}
+
+// Live returns the manifest exported from the running job which has the same identity,
+// returns nil if the job does not exist
+func (m *ManifestClient) Live(jobManifest *types.JobManifest) (*types.JobManifest, error) {
+	namespace := m.namespaceOf(jobManifest)
+	var (
+		live *types.JobManifest
+		err  error
+	)
+	if manifest.IsTrainingKind(jobManifest.Kind) {
+		live, err = manifest.ExportTrainingJob(namespace, jobManifest.Metadata.Name, types.ManifestTrainingKinds[jobManifest.Kind])
+	} else {
+		jobType := types.ManifestServingKinds[jobManifest.Kind]
+		if jobManifest.Metadata.Version == "" && jobType != types.KServeJob {
+			return nil, fmt.Errorf("metadata.version of %v/%v must be set, otherwise a new version is created by each submission", jobManifest.Kind, jobManifest.Metadata.Name)
+		}
+		live, err = manifest.ExportServingJob(namespace, jobManifest.Metadata.Name, jobManifest.Metadata.Version, jobType)
+	}
+	if err == manifest.ErrJobValuesNotFound {
+		log.Debugf("the job %v/%v is not found in namespace %v", jobManifest.Kind, jobManifest.Metadata.Name, namespace)
+		return nil, nil
+	}
+	return live, err
+}
+
+// Export regenerates the manifest of a running job, the job type and version are optional,
+// the job type is matched with training job types firstly
+func (m *ManifestClient) Export(jobName, jobType, version string) (*types.JobManifest, error) {
+	trainingType := utils.TransferTrainingJobType(jobType)
+	servingType := utils.TransferServingJobType(jobType)
+	isTraining := trainingType != types.AllTrainingJob && trainingType != types.UnknownTrainingJob
+	isServing := servingType != types.AllServingJob && servingType != types.UnknownServingJob
+	if jobType != "" && !isTraining && !isServing {
+		return nil, fmt.Errorf("unknown job type %v", jobType)
+	}
+	if !isTraining && !isServing {
+		job, err := training.SearchTrainingJob(jobName, m.namespace, types.AllTrainingJob)
+		if err == nil {
+			return manifest.ExportTrainingJob(m.namespace, jobName, job.Trainer())
+		}
+		if err != types.ErrTrainingJobNotFound {
+			return nil, err
+		}
+		servingJob, err := serving.SearchServingJob(m.namespace, jobName, version, types.AllServingJob)
+		if err != nil {
+			return nil, fmt.Errorf("not found training or serving job %v in namespace %v", jobName, m.namespace)
+		}
+		return manifest.ExportServingJob(m.namespace, jobName, servingJob.Version(), servingJob.Type())
+	}
+	if isTraining {
+		return manifest.ExportTrainingJob(m.namespace, jobName, trainingType)
+	}
+	if version == "" && servingType != types.KServeJob {
+		servingJob, err := serving.SearchServingJob(m.namespace, jobName, version, servingType)
+		if err != nil {
+			return nil, err
+		}
+		version = servingJob.Version()
+	}
+	return manifest.ExportServingJob(m.namespace, jobName, version, servingType)
+}
+
+func (m *ManifestClient) namespaceOf(jobManifest *types.JobManifest) string {
+	if jobManifest.Metadata.Namespace != "" {
+		return jobManifest.Metadata.Namespace
+	}
+	return m.namespace
+}
@@ -0,0 +1,85 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arenaclient
+
+import (
+	"fmt"
+
+	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/apis/utils"
+	"github.com/kubeflow/arena/pkg/queue"
+)
+
+// QueueClient reads the kueue LocalQueues and their workloads
+type QueueClient struct {
+	namespace string
+	configer  *config.ArenaConfiger
+}
+
+// NewQueueClient creates a QueueClient
+func NewQueueClient(namespace string, configer *config.ArenaConfiger) *QueueClient {
+	return &QueueClient{
+		namespace: namespace,
+		configer:  configer,
+	}
+}
+
+// Namespace sets the namespace,this operation does not change the default namespace
+func (q *QueueClient) Namespace(namespace string) *QueueClient {
+	copyQueueClient := &QueueClient{
+		namespace: namespace,
+		configer:  q.configer,
+	}
+	return copyQueueClient
+}
+
+// List returns all the local queues
+func (q *QueueClient) List(allNamespaces bool) ([]*types.QueueInfo, error) {
+	return queue.ListQueues(q.namespace, allNamespaces)
+}
+
+// ListAndPrint lists and prints the local queues
+func (q *QueueClient) ListAndPrint(allNamespaces bool, format string) error {
+	outputFormat := utils.TransferPrintFormat(format)
+	if outputFormat == types.UnknownFormat {
+		return fmt.Errorf("unknown output format,only support:[wide|json|yaml]")
+	}
+	queueInfos, err := queue.ListQueues(q.namespace, allNamespaces)
+	if err != nil {
+		return err
+	}
+	queue.DisplayAllQueues(queueInfos, allNamespaces, outputFormat)
+	return nil
+}
+
+// Get returns the local queue with its unfinished workloads
+func (q *QueueClient) Get(name string) (*types.QueueInfo, error) {
+	return queue.GetQueueInfo(name, q.namespace)
+}
+
+// GetAndPrint gets and prints the local queue with its unfinished workloads
+func (q *QueueClient) GetAndPrint(name string, format string) error {
+	outputFormat := utils.TransferPrintFormat(format)
+	if outputFormat == types.UnknownFormat {
+		return fmt.Errorf("unknown output format,only support:[wide|json|yaml]")
+	}
+	queueInfo, err := queue.GetQueueInfo(name, q.namespace)
+	if err != nil {
+		return err
+	}
+	queue.DisplayQueue(queueInfo, outputFormat)
+	return nil
+}
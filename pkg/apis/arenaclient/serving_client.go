@@ -15,8 +15,11 @@
 package arenaclient
 
 import (
+	"context"
 	"fmt"
+	"os"
 	"strings"
+	"text/tabwriter"
 
 	"github.com/kubeflow/arena/pkg/apis/config"
 	apiserving "github.com/kubeflow/arena/pkg/apis/serving"
@@ -24,6 +27,7 @@ import (
 	"github.com/kubeflow/arena/pkg/apis/utils"
 	"github.com/kubeflow/arena/pkg/podexec"
 	"github.com/kubeflow/arena/pkg/serving"
+	"github.com/kubeflow/arena/pkg/workflow"
 )
 
 // ServingJobClient provides some operators for managing serving jobs.
@@ -49,8 +53,12 @@ func (t *ServingJobClient) Namespace(namespace string) *ServingJobClient {
 	return copyServingJobClient
 }
 
-// Submit submits a serving job
+// Submit submits a serving job, the job is only printed or validated if option --dry-run is given
 func (t *ServingJobClient) Submit(job *apiserving.Job) error {
+	return workflow.IgnoreDryRun(t.submit(job))
+}
+
+func (t *ServingJobClient) submit(job *apiserving.Job) error {
 	switch job.Type() {
 	case types.TFServingJob:
 		args := job.Args().(*types.TensorFlowServingArgs)
@@ -76,6 +84,9 @@ func (t *ServingJobClient) Submit(job *apiserving.Job) error {
 	case types.DistributedServingJob:
 		args := job.Args().(*types.DistributedServingArgs)
 		return serving.SubmitDistributedServingJob(args.Namespace, args)
+	case types.VLLMServingJob, types.SGLangServingJob:
+		args := job.Args().(*types.LLMServingArgs)
+		return serving.SubmitLLMServingJob(args.Namespace, args)
 	}
 	return nil
 }
@@ -134,6 +145,36 @@ func (t *ServingJobClient) ListAndPrint(allNamespaces bool, servingType types.Se
 	return nil
 }
 
+// Watch calls the handler with the events of serving jobs until the context is done,
+// the arena client must be created in daemon mode
+func (t *ServingJobClient) Watch(ctx context.Context, allNamespaces bool, servingType types.ServingJobType, handler func(*types.ServingJobEvent)) error {
+	return serving.WatchServingJobs(ctx, t.namespace, allNamespaces, servingType, handler)
+}
+
+// WatchAndPrint prints the events of serving jobs as table rows or newline-delimited json
+func (t *ServingJobClient) WatchAndPrint(ctx context.Context, allNamespaces bool, servingType types.ServingJobType, format string) error {
+	printFormat := utils.TransferPrintFormat(format)
+	if printFormat != types.WideFormat && printFormat != types.JsonFormat {
+		return fmt.Errorf("unknown output format in watch mode,only support:[wide|json]")
+	}
+	w := tabwriter.NewWriter(os.Stdout, 10, 0, 2, ' ', 0)
+	if printFormat == types.WideFormat {
+		serving.PrintServingJobEventHeader(w, allNamespaces)
+		_ = w.Flush()
+	}
+	var printErr error
+	err := t.Watch(ctx, allNamespaces, servingType, func(event *types.ServingJobEvent) {
+		if err := serving.PrintServingJobEvent(w, event, printFormat, allNamespaces); err != nil {
+			printErr = err
+		}
+		_ = w.Flush()
+	})
+	if err != nil {
+		return err
+	}
+	return printErr
+}
+
 // Logs returns the serving job log
 func (t *ServingJobClient) Logs(jobName, version string, jobType types.ServingJobType, args *types.LogArgs) error {
 	args.Namespace = t.namespace
@@ -206,6 +247,133 @@ func (t *ServingJobClient) TrafficRouterSplit(args *types.TrafficRouterSplitArgs
 	return serving.RunTrafficRouterSplit(args.Namespace, args)
 }
 
+// Rollout shifts the traffic from one version to another step by step and rolls back
+// the traffic if the new version is not ready or its metrics exceed the thresholds,
+// the traffic is also rolled back when the context is canceled
+func (t *ServingJobClient) Rollout(ctx context.Context, args *types.ServingRolloutArgs) error {
+	namespace := args.Namespace
+	if namespace == "" {
+		namespace = t.namespace
+	}
+	return serving.RunServingRollout(ctx, namespace, args)
+}
+
+// Invoke sends a request to the inference api of the serving job and returns the response
+func (t *ServingJobClient) Invoke(ctx context.Context, args *types.ServingInvokeArgs) (*types.ServingInvokeResult, error) {
+	return serving.InvokeServingJob(ctx, t.namespace, args)
+}
+
+// InvokeAndPrint sends a request to the inference api of the serving job and prints the response
+func (t *ServingJobClient) InvokeAndPrint(ctx context.Context, args *types.ServingInvokeArgs) error {
+	result, err := t.Invoke(ctx, args)
+	if err != nil {
+		return err
+	}
+	serving.PrintServingInvokeResult(result)
+	return nil
+}
+
+// LoadTest runs a load generator job in the cluster against the versions of serving job and returns the reports
+func (t *ServingJobClient) LoadTest(ctx context.Context, args *types.ServingLoadTestArgs) ([]*types.ServingLoadTestResult, error) {
+	return serving.RunServingLoadTest(ctx, t.namespace, args)
+}
+
+// LoadTestAndPrint runs the load test and prints the reports
+func (t *ServingJobClient) LoadTestAndPrint(ctx context.Context, args *types.ServingLoadTestArgs, format string) error {
+	printFormat := utils.TransferPrintFormat(format)
+	if printFormat == types.UnknownFormat {
+		return fmt.Errorf("unknown output format,only support:[wide|json|yaml]")
+	}
+	results, err := t.LoadTest(ctx, args)
+	if err != nil {
+		return err
+	}
+	serving.PrintServingLoadTestResults(results, printFormat)
+	return nil
+}
+
+// History returns the revisions of serving job
+func (t *ServingJobClient) History(jobName, version string, jobType types.ServingJobType) ([]types.ServingRevision, error) {
+	return serving.ListServingRevisions(t.namespace, jobName, version, jobType)
+}
+
+// HistoryAndPrint prints the revisions of serving job
+func (t *ServingJobClient) HistoryAndPrint(jobName, version string, jobType types.ServingJobType, format string) error {
+	printFormat := utils.TransferPrintFormat(format)
+	if printFormat == types.UnknownFormat {
+		return fmt.Errorf("unknown output format,only support:[wide|json|yaml]")
+	}
+	revisions, err := t.History(jobName, version, jobType)
+	if err != nil {
+		return err
+	}
+	serving.PrintServingRevisions(revisions, printFormat)
+	return nil
+}
+
+// Describe returns the conditions, events and diagnosed problems of serving job
+func (t *ServingJobClient) Describe(jobName, version string, jobType types.ServingJobType) (*types.ServingJobDescription, error) {
+	return serving.DescribeServingJob(t.namespace, jobName, version, jobType)
+}
+
+// DescribeAndPrint prints the diagnosis report of serving job
+func (t *ServingJobClient) DescribeAndPrint(jobName, version string, jobType types.ServingJobType, format string) error {
+	printFormat := utils.TransferPrintFormat(format)
+	if printFormat == types.UnknownFormat {
+		return fmt.Errorf("unknown output format,only support:[wide|json|yaml]")
+	}
+	description, err := t.Describe(jobName, version, jobType)
+	if err != nil {
+		return err
+	}
+	serving.PrintServingJobDescription(description, printFormat)
+	return nil
+}
+
+// Rollback restores the spec of serving job to a recorded revision
+func (t *ServingJobClient) Rollback(args *types.ServingRollbackArgs) error {
+	return serving.RollbackServingJob(t.namespace, args)
+}
+
+// Promote routes all traffic of serving job to a version and labels it as stable,
+// the other versions are kept, scaled down or deleted after the grace period
+func (t *ServingJobClient) Promote(ctx context.Context, args *types.ServingPromoteArgs) error {
+	namespace := args.Namespace
+	if namespace == "" {
+		namespace = t.namespace
+	}
+	return serving.RunServingPromote(ctx, namespace, args)
+}
+
+// AddModel registers a model into a triton serving job or a ServingRuntime of KServe ModelMesh
+func (t *ServingJobClient) AddModel(args *types.ServingModelArgs) error {
+	return serving.AddServingModel(t.namespace, args)
+}
+
+// RemoveModel unregisters a model from the model server
+func (t *ServingJobClient) RemoveModel(args *types.ServingModelArgs) error {
+	return serving.RemoveServingModel(t.namespace, args)
+}
+
+// ListModels returns the models of the model server
+func (t *ServingJobClient) ListModels(args *types.ServingModelArgs) ([]types.ServingModelInfo, error) {
+	return serving.ListServingModels(t.namespace, args)
+}
+
+// ListModelsAndPrint prints the models of the model server
+func (t *ServingJobClient) ListModelsAndPrint(args *types.ServingModelArgs, format string) error {
+	printFormat := utils.TransferPrintFormat(format)
+	if printFormat == types.UnknownFormat {
+		return fmt.Errorf("unknown output format,only support:[wide|json|yaml]")
+	}
+	models, err := t.ListModels(args)
+	if err != nil {
+		return err
+	}
+	serving.PrintServingModels(models, printFormat)
+	return nil
+}
+
 func moreThanOneInstanceHelpInfo(instances []types.ServingInstance) string {
 	header := fmt.Sprintf("There is %d instances have been found:", len(instances))
 	lines := []string{}
@@ -0,0 +1,84 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arenaclient
+
+import (
+	"fmt"
+
+	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/apis/utils"
+	"github.com/kubeflow/arena/pkg/jobtemplate"
+)
+
+// TemplateClient manages the job templates stored in the arena namespace
+type TemplateClient struct {
+	configer *config.ArenaConfiger
+}
+
+// NewTemplateClient creates a TemplateClient
+func NewTemplateClient(configer *config.ArenaConfiger) *TemplateClient {
+	return &TemplateClient{
+		configer: configer,
+	}
+}
+
+// Create stores the job template, the template should be built by training.JobTemplateBuilder
+func (t *TemplateClient) Create(template *types.JobTemplate) error {
+	return jobtemplate.CreateJobTemplate(template)
+}
+
+// List returns all the job templates
+func (t *TemplateClient) List() ([]*types.JobTemplate, error) {
+	return jobtemplate.ListJobTemplates()
+}
+
+// ListAndPrint lists and prints the job templates
+func (t *TemplateClient) ListAndPrint(format string) error {
+	outputFormat := utils.TransferPrintFormat(format)
+	if outputFormat == types.UnknownFormat {
+		return fmt.Errorf("unknown output format,only support:[wide|json|yaml]")
+	}
+	templates, err := jobtemplate.ListJobTemplates()
+	if err != nil {
+		return err
+	}
+	jobtemplate.DisplayJobTemplates(templates, outputFormat)
+	return nil
+}
+
+// Get returns the job template
+func (t *TemplateClient) Get(name string) (*types.JobTemplate, error) {
+	return jobtemplate.GetJobTemplate(name)
+}
+
+// GetAndPrint gets and prints the job template
+func (t *TemplateClient) GetAndPrint(name string, format string) error {
+	outputFormat := utils.TransferPrintFormat(format)
+	if outputFormat == types.UnknownFormat {
+		return fmt.Errorf("unknown output format,only support:[wide|json|yaml]")
+	}
+	template, err := jobtemplate.GetJobTemplate(name)
+	if err != nil {
+		return err
+	}
+	jobtemplate.DisplayJobTemplate(template, outputFormat)
+	return nil
+}
+
+// Delete deletes the job template
+func (t *TemplateClient) Delete(name string) error {
+	return jobtemplate.DeleteJobTemplate(name)
+}
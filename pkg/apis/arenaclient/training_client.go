@@ -15,15 +15,23 @@
 package arenaclient
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"text/tabwriter"
 	"time"
 
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
 	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/manifest"
 	apistraining "github.com/kubeflow/arena/pkg/apis/training"
 	"github.com/kubeflow/arena/pkg/apis/types"
 	"github.com/kubeflow/arena/pkg/apis/utils"
 	"github.com/kubeflow/arena/pkg/podexec"
 	"github.com/kubeflow/arena/pkg/training"
+	"github.com/kubeflow/arena/pkg/util/kubeclient"
+	"github.com/kubeflow/arena/pkg/workflow"
 )
 
 var (
@@ -57,8 +65,12 @@ func (t *TrainingJobClient) Namespace(namespace string) *TrainingJobClient {
 	return copyTrainingJobClient
 }
 
-// Submit submits a training job
+// Submit submits a training job, the job is only printed or validated if option --dry-run is given
 func (t *TrainingJobClient) Submit(job *apistraining.Job) error {
+	return workflow.IgnoreDryRun(t.submit(job))
+}
+
+func (t *TrainingJobClient) submit(job *apistraining.Job) error {
 	switch job.Type() {
 	case types.TFTrainingJob:
 		args := job.Args().(*types.SubmitTFJobArgs)
@@ -87,6 +99,12 @@ func (t *TrainingJobClient) Submit(job *apistraining.Job) error {
 	case types.RayJob:
 		args := job.Args().(*types.SubmitRayJobArgs)
 		return training.SubmitRayJob(t.namespace, args)
+	case types.TrainJob:
+		args := job.Args().(*types.SubmitTrainJobArgs)
+		return training.SubmitTrainJob(t.namespace, args)
+	case types.JobSetTrainingJob:
+		args := job.Args().(*types.SubmitJobSetArgs)
+		return training.SubmitJobSet(t.namespace, args)
 	}
 	return nil
 }
@@ -96,7 +114,7 @@ func (t *TrainingJobClient) ScaleIn(job *apistraining.Job) error {
 	switch job.Type() {
 	case types.ETTrainingJob:
 		args := job.Args().(*types.ScaleInETJobArgs)
-		return training.SubmitScaleInETJob(t.namespace, args)
+		return workflow.IgnoreDryRun(training.SubmitScaleInETJob(t.namespace, args))
 	}
 	return nil
 }
@@ -106,7 +124,7 @@ func (t *TrainingJobClient) ScaleOut(job *apistraining.Job) error {
 	switch job.Type() {
 	case types.ETTrainingJob:
 		args := job.Args().(*types.ScaleOutETJobArgs)
-		return training.SubmitScaleOutETJob(t.namespace, args)
+		return workflow.IgnoreDryRun(training.SubmitScaleOutETJob(t.namespace, args))
 	}
 	return nil
 }
@@ -122,6 +140,32 @@ func (t *TrainingJobClient) Get(jobName string, jobType types.TrainingJobType, s
 	return jobInfo, nil
 }
 
+// Wait blocks until the training job meets the condition or reaches a terminal state,
+// the waiting is canceled when the context is done
+func (t *TrainingJobClient) Wait(ctx context.Context, jobName string, jobType types.TrainingJobType, condition types.TrainingJobWaitCondition) (*types.TrainingJobWaitResult, error) {
+	return training.WaitTrainingJob(ctx, jobName, t.namespace, jobType, condition)
+}
+
+// Diagnose inspects the pods, events and the last tailLines lines of logs of training job,
+// and returns the likely causes of failure ordered by their scores
+func (t *TrainingJobClient) Diagnose(jobName string, jobType types.TrainingJobType, tailLines int64) (*types.TrainingJobDiagnosis, error) {
+	return training.DiagnoseTrainingJob(jobName, t.namespace, jobType, tailLines)
+}
+
+// DiagnoseAndPrint prints the likely causes of failure of training job
+func (t *TrainingJobClient) DiagnoseAndPrint(jobName string, jobType types.TrainingJobType, tailLines int64, format string) error {
+	printFormat := utils.TransferPrintFormat(format)
+	if printFormat == types.UnknownFormat {
+		return fmt.Errorf("unknown output format,only support:[wide|json|yaml]")
+	}
+	diagnosis, err := t.Diagnose(jobName, jobType, tailLines)
+	if err != nil {
+		return err
+	}
+	training.PrintTrainingJobDiagnosis(diagnosis, printFormat)
+	return nil
+}
+
 // GetAndPrint print training job information
 func (t *TrainingJobClient) GetAndPrint(jobName string, jobType types.TrainingJobType, format string, showEvent bool, showGPU bool) error {
 	if utils.TransferPrintFormat(format) == types.UnknownFormat {
@@ -169,6 +213,36 @@ func (t *TrainingJobClient) ListAndPrint(allNamespaces bool, format string, trai
 	return nil
 }
 
+// Watch calls the handler with the events of training jobs until the context is done,
+// the arena client must be created in daemon mode
+func (t *TrainingJobClient) Watch(ctx context.Context, allNamespaces bool, trainingType types.TrainingJobType, handler func(*types.TrainingJobEvent)) error {
+	return training.WatchTrainingJobs(ctx, t.namespace, allNamespaces, trainingType, handler)
+}
+
+// WatchAndPrint prints the events of training jobs as table rows or newline-delimited json
+func (t *TrainingJobClient) WatchAndPrint(ctx context.Context, allNamespaces bool, format string, trainingType types.TrainingJobType) error {
+	printFormat := utils.TransferPrintFormat(format)
+	if printFormat != types.WideFormat && printFormat != types.JsonFormat {
+		return fmt.Errorf("unknown output format in watch mode,only support:[wide|json]")
+	}
+	w := tabwriter.NewWriter(os.Stdout, 10, 0, 2, ' ', 0)
+	if printFormat == types.WideFormat {
+		training.PrintTrainingJobEventHeader(w, allNamespaces)
+		_ = w.Flush()
+	}
+	var printErr error
+	err := t.Watch(ctx, allNamespaces, trainingType, func(event *types.TrainingJobEvent) {
+		if err := training.PrintTrainingJobEvent(w, event, printFormat, allNamespaces); err != nil {
+			printErr = err
+		}
+		_ = w.Flush()
+	})
+	if err != nil {
+		return err
+	}
+	return printErr
+}
+
 // Logs returns the training job log
 func (t *TrainingJobClient) Logs(jobName string, jobType types.TrainingJobType, args *types.LogArgs) error {
 	args.Namespace = t.namespace
@@ -176,6 +250,12 @@ func (t *TrainingJobClient) Logs(jobName string, jobType types.TrainingJobType,
 	return training.AcceptJobLog(jobName, jobType, args)
 }
 
+// ExportLogs writes the job info and the logs of all the instances into a tarball, and stores it into
+// the local directory or the s3-compatible url like s3://bucket/prefix, the location of tarball is returned
+func (t *TrainingJobClient) ExportLogs(jobName string, jobType types.TrainingJobType, to string) (string, error) {
+	return training.ExportTrainingJobLogs(jobName, t.namespace, jobType, to)
+}
+
 func (t *TrainingJobClient) Attach(jobName string, jobType types.TrainingJobType, args *podexec.AttachPodArgs) error {
 	job, err := t.Get(jobName, jobType, false)
 	if err != nil {
@@ -221,11 +301,76 @@ func (t *TrainingJobClient) LogViewer(jobName string, jobType types.TrainingJobT
 	return job.GetJobDashboards(t.configer.GetClientSet(), t.namespace, t.arenaSystemNamespace)
 }
 
+// Clone submits a new training job with the options of an existing job, the options are reconstructed
+// from the values stored when submitting the job and replaced by the overrides given as "option=value".
+// The job type is searched if it is not given and the new job is named "<name>-<N>" if newName is empty
+func (t *TrainingJobClient) Clone(jobName string, jobType types.TrainingJobType, newName string, overrides ...string) (*apistraining.Job, error) {
+	if jobType == types.AllTrainingJob || jobType == types.UnknownTrainingJob {
+		job, err := training.SearchTrainingJob(jobName, t.namespace, jobType)
+		if err != nil {
+			return nil, err
+		}
+		jobType = job.Trainer()
+	}
+	m, err := manifest.ExportTrainingJob(t.namespace, jobName, jobType)
+	if err != nil {
+		return nil, err
+	}
+	if newName == "" {
+		newName, err = nextCloneName(t.namespace, jobName, jobType)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if newName == jobName {
+		return nil, fmt.Errorf("the new job name must be different from %v", jobName)
+	}
+	m.Metadata.Name = newName
+	if err := manifest.Override(m, overrides); err != nil {
+		return nil, err
+	}
+	job, err := manifest.BuildTrainingJob(m)
+	if err != nil {
+		return nil, err
+	}
+	return job, t.Submit(job)
+}
+
 // Prune cleans the not running training jobs
 func (t *TrainingJobClient) Prune(allNamespaces bool, since time.Duration) error {
 	return training.PruneTrainingJobs(t.namespace, allNamespaces, since)
 }
 
+// PruneWithPolicy deletes the training jobs selected by the retention policy and returns them,
+// the jobs are only listed if args.DryRun is true
+func (t *TrainingJobClient) PruneWithPolicy(args *types.PruneArgs) ([]types.PrunedTrainingJob, error) {
+	return training.PruneTrainingJobsWithPolicy(t.namespace, args)
+}
+
+// Suspend suspends the training job, its pods are deleted and its configuration is kept until it is resumed
+func (t *TrainingJobClient) Suspend(jobName string, jobType types.TrainingJobType) error {
+	return training.SuspendTrainingJob(jobName, t.namespace, jobType, true)
+}
+
+// Resume resumes the suspended training job
+func (t *TrainingJobClient) Resume(jobName string, jobType types.TrainingJobType) error {
+	return training.SuspendTrainingJob(jobName, t.namespace, jobType, false)
+}
+
 func (t *TrainingJobClient) Top(args []string, allNamespaces bool, jobType types.TrainingJobType, instanceName string, notStop bool, format types.FormatStyle) error {
 	return training.TopTrainingJobs(args, t.namespace, allNamespaces, jobType, instanceName, notStop, format)
 }
+
+// nextCloneName returns the first name like "<name>-<N>" whose app configmap does not exist
+func nextCloneName(namespace, jobName string, jobType types.TrainingJobType) (string, error) {
+	for i := 1; ; i++ {
+		name := fmt.Sprintf("%v-%v", jobName, i)
+		_, err := kubeclient.GetConfigMap(namespace, fmt.Sprintf("%v-%v", name, jobType))
+		if k8serrors.IsNotFound(err) {
+			return name, nil
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+}
@@ -321,6 +321,14 @@ func (c *CronTFJobBuilder) Command(args []string) *CronTFJobBuilder {
 	return c
 }
 
+// DryRun is used to only render or validate the job rather than submit it,match option --dry-run
+func (c *CronTFJobBuilder) DryRun(strategy types.DryRunStrategy) *CronTFJobBuilder {
+	if strategy != "" {
+		c.args.DryRun = strategy
+	}
+	return c
+}
+
 func (c *CronTFJobBuilder) Build() (*Job, error) {
 	for key, value := range c.argValues {
 		c.AddArgValue(key, value)
@@ -240,6 +240,14 @@ func (e *EvaluateJobBuilder) Gpu(gpu int) *EvaluateJobBuilder {
 	return e
 }
 
+// DryRun is used to only render or validate the job rather than submit it,match option --dry-run
+func (e *EvaluateJobBuilder) DryRun(strategy types.DryRunStrategy) *EvaluateJobBuilder {
+	if strategy != "" {
+		e.args.DryRun = strategy
+	}
+	return e
+}
+
 // Build is used to build the job
 func (e *EvaluateJobBuilder) Build() (*EvaluateJob, error) {
 	for key, value := range e.argValues {
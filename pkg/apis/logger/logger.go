@@ -41,6 +41,15 @@ func NewLoggerBuilder() *LoggerBuilder {
 		ArgsBuilder: argsbuilder.NewLogArgsBuilder(args),
 	}
 }
+
+// NewTrainingLoggerBuilder returns the builder of training job logs, its command flags
+// include the options only supported by training jobs, like --all, --role and --grep
+func NewTrainingLoggerBuilder() *LoggerBuilder {
+	l := NewLoggerBuilder()
+	l.AddSubBuilder(argsbuilder.NewTrainingLogArgsBuilder(l.args))
+	return l
+}
+
 func (l *LoggerBuilder) SinceSeconds(sinceSeconds string) *LoggerBuilder {
 	if sinceSeconds != "" {
 		l.argValues["since"] = &sinceSeconds
@@ -83,6 +92,30 @@ func (l *LoggerBuilder) Tail(line int) *LoggerBuilder {
 	return l
 }
 
+// AllInstances streams the logs of all the instances of the job
+func (l *LoggerBuilder) AllInstances(all bool) *LoggerBuilder {
+	l.args.AllInstances = all
+	return l
+}
+
+// AllContainers streams the logs of all the containers of each instance
+func (l *LoggerBuilder) AllContainers(all bool) *LoggerBuilder {
+	l.args.AllContainers = all
+	return l
+}
+
+// Role only streams the logs of the instances with the role
+func (l *LoggerBuilder) Role(role string) *LoggerBuilder {
+	l.args.Role = role
+	return l
+}
+
+// Grep only prints the lines which match the regular expression
+func (l *LoggerBuilder) Grep(pattern string) *LoggerBuilder {
+	l.args.Grep = pattern
+	return l
+}
+
 func (l *LoggerBuilder) WriterCloser(writerCloser io.WriteCloser) *LoggerBuilder {
 	if writerCloser != nil {
 		l.args.WriterCloser = writerCloser
@@ -0,0 +1,106 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+// Diff compares the desired manifest with the manifest exported from the running job,
+// the options which are not set and equal to defaults are seen as same
+func Diff(desired, live *types.JobManifest) ([]types.ManifestDiffItem, error) {
+	if desired.Kind != live.Kind {
+		return nil, fmt.Errorf("the kind of job %v is %v, but the manifest gives %v", desired.Metadata.Name, live.Kind, desired.Kind)
+	}
+	flags, err := kindFlags(desired.Kind)
+	if err != nil {
+		return nil, err
+	}
+	desiredValues := map[string]string{}
+	liveValues := map[string]string{}
+	for key, value := range desired.Spec {
+		if key != commandKey && isDefaultValue(flags.Lookup(key), value) {
+			continue
+		}
+		normalized, err := normalizeValue(flags.Lookup(key), key, value)
+		if err != nil {
+			return nil, err
+		}
+		desiredValues[key] = normalized
+	}
+	for key, value := range live.Spec {
+		normalized, err := normalizeValue(flags.Lookup(key), key, value)
+		if err != nil {
+			return nil, err
+		}
+		liveValues[key] = normalized
+	}
+	keys := map[string]bool{}
+	for key := range desiredValues {
+		keys[key] = true
+	}
+	for key := range liveValues {
+		keys[key] = true
+	}
+	items := []types.ManifestDiffItem{}
+	for key := range keys {
+		if desiredValues[key] == liveValues[key] {
+			continue
+		}
+		items = append(items, types.ManifestDiffItem{
+			Key:     key,
+			Live:    liveValues[key],
+			Desired: desiredValues[key],
+		})
+	}
+	if desired.Metadata.Version != live.Metadata.Version {
+		items = append(items, types.ManifestDiffItem{
+			Key:     "version",
+			Live:    live.Metadata.Version,
+			Desired: desired.Metadata.Version,
+		})
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Key < items[j].Key
+	})
+	return items, nil
+}
+
+// normalizeValue converts the value to a comparable string,
+// the items are sorted, a single value equals to a list with one item
+// and durations are compared by their lengths
+func normalizeValue(flag *pflag.Flag, key string, value interface{}) (string, error) {
+	if flag != nil && flag.Value.Type() == "duration" {
+		if d, err := time.ParseDuration(fmt.Sprint(value)); err == nil {
+			return d.String(), nil
+		}
+	}
+	values, err := optionValues(key, value)
+	if err != nil {
+		return "", err
+	}
+	if key == commandKey {
+		return strings.Join(values, " "), nil
+	}
+	sort.Strings(values)
+	return strings.Join(values, ","), nil
+}
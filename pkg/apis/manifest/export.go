@@ -0,0 +1,363 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/pflag"
+	yaml "gopkg.in/yaml.v3"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/common"
+	"github.com/kubeflow/arena/pkg/util/kubeclient"
+)
+
+// ErrJobValuesNotFound is returned when the app configmap of the job does not exist
+var ErrJobValuesNotFound = errors.New("not found the app configmap of the job, it may not be submitted by arena")
+
+// valuesKeyOptions maps the keys of the stored helm values to the options
+// whose names can not be derived from the keys
+var valuesKeyOptions = map[string]string{
+	"gpuCount":                "gpus",
+	"gpuMemory":               "gpumemory",
+	"gpuCore":                 "gpucore",
+	"envs":                    "env",
+	"envsFromSecret":          "env-from-secret",
+	"devices":                 "device",
+	"nodeSelectors":           "selector",
+	"annotations":             "annotation",
+	"labels":                  "label",
+	"tolerations":             "toleration",
+	"imagePullSecrets":        "image-pull-secret",
+	"dataset":                 "data",
+	"modelDirs":               "data",
+	"dataDirs":                "data-dir",
+	"dataSubPathExprs":        "data-subpath-expr",
+	"tempDirs":                "temp-dir",
+	"tempDirSubPathExprs":     "temp-dir-subpath-expr",
+	"loadModels":              "load-model",
+	"priorityClassName":       "priority",
+	"coscheduling":            "gang",
+	"enableQueue":             "queue",
+	"enableRDMA":              "rdma",
+	"enableSpotInstance":      "spot-instance",
+	"schedulerName":           "scheduler",
+	"useHostNetwork":          "hostNetwork",
+	"useHostIPC":              "hostIPC",
+	"useHostPID":              "hostPID",
+	"useTensorboard":          "tensorboard",
+	"trainingLogdir":          "logdir",
+	"cleanPodPolicy":          "clean-task-policy",
+	"activeDeadlineSeconds":   "running-timeout",
+	"ttlSecondsAfterFinished": "ttl-after-finished",
+	"restApiPort":             "restful-port",
+	"securityContext":         "security-context",
+}
+
+// kindValuesKeyOptions overrides valuesKeyOptions for the kinds whose values do not follow the common naming
+var kindValuesKeyOptions = map[string]map[string]string{
+	"DistributedServing": {
+		"masters":         "leader-num",
+		"workers":         "worker-num",
+		"masterCpus":      "leader-cpu",
+		"workerCpus":      "worker-cpu",
+		"masterGpus":      "leader-gpus",
+		"workerGpus":      "worker-gpus",
+		"masterMemory":    "leader-memory",
+		"workerMemory":    "worker-memory",
+		"masterGPUMemory": "leader-gpumemory",
+		"workerGPUMemory": "worker-gpumemory",
+		"masterGPUCore":   "leader-gpucore",
+		"workerGPUCore":   "worker-gpucore",
+		"masterCommand":   "leader-command",
+		"workerCommand":   "worker-command",
+	},
+}
+
+// secondsOptions stores the duration options which are stored as seconds in helm values
+var secondsOptions = map[string]bool{
+	"running-timeout":    true,
+	"ttl-after-finished": true,
+}
+
+// derivedEnvs stores the envs which are injected by arena rather than given by users
+var derivedEnvs = map[string]bool{
+	"workers":                         true,
+	"gpus":                            true,
+	"MASTER_ADDR":                     true,
+	"PET_NPROC_PER_NODE":              true,
+	common.ENV_NVIDIA_VISIBLE_DEVICES: true,
+}
+
+// derivedLabelsAndAnnotations stores the labels and annotations which are added by arena
+var derivedLabelsAndAnnotations = map[string]bool{
+	types.UserNameIdLabel:         true,
+	types.UserNameNameLabel:       true,
+	types.RequestGPUsOfJobAnnoKey: true,
+	types.KueueQueueNameLabel:     true,
+	"modelName":                   true,
+	"scheduling.x-k8s.io/suspend": true,
+}
+
+// GetJobValues returns the helm values which are stored in the app configmap when submitting the job
+func GetJobValues(namespace, configMapName string) (map[string]interface{}, error) {
+	configMap, err := kubeclient.GetConfigMap(namespace, configMapName)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, ErrJobValuesNotFound
+		}
+		return nil, err
+	}
+	values := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(configMap.Data["values"]), &values); err != nil {
+		return nil, fmt.Errorf("failed to parse the values of configmap %v: %v", configMapName, err)
+	}
+	return values, nil
+}
+
+// ExportTrainingJob regenerates the manifest of a training job from its app configmap
+func ExportTrainingJob(namespace, name string, jobType types.TrainingJobType) (*types.JobManifest, error) {
+	kind, err := TrainingKind(jobType)
+	if err != nil {
+		return nil, err
+	}
+	values, err := GetJobValues(namespace, fmt.Sprintf("%v-%v", name, jobType))
+	if err != nil {
+		return nil, err
+	}
+	// the master of pytorchjob is excluded from workers when submitting
+	if jobType == types.PytorchTrainingJob {
+		if workers, ok := values["workers"].(int); ok {
+			values["workers"] = workers + 1
+		}
+	}
+	return NewManifestFromValues(kind, types.JobManifestMetadata{Name: name, Namespace: namespace}, values)
+}
+
+// ExportServingJob regenerates the manifest of a serving job from its app configmap
+func ExportServingJob(namespace, name, version string, jobType types.ServingJobType) (*types.JobManifest, error) {
+	kind, err := ServingKind(jobType)
+	if err != nil {
+		return nil, err
+	}
+	configMapName := fmt.Sprintf("%v-%v-%v", name, version, jobType)
+	if jobType == types.KServeJob {
+		configMapName = fmt.Sprintf("%v-%v", name, jobType)
+	}
+	values, err := GetJobValues(namespace, configMapName)
+	if err != nil {
+		return nil, err
+	}
+	metadata := types.JobManifestMetadata{Name: name, Namespace: namespace}
+	if servingVersion, ok := values["servingVersion"]; ok && servingVersion != nil {
+		metadata.Version = fmt.Sprint(servingVersion)
+	}
+	return NewManifestFromValues(kind, metadata, values)
+}
+
+// NewManifestFromValues converts the helm values of a job to a manifest,
+// the values which can not be given by options or equal to the defaults are dropped
+func NewManifestFromValues(kind string, metadata types.JobManifestMetadata, values map[string]interface{}) (*types.JobManifest, error) {
+	flags, err := kindFlags(kind)
+	if err != nil {
+		return nil, err
+	}
+	m := &types.JobManifest{
+		APIVersion: types.ManifestAPIVersion,
+		Kind:       kind,
+		Metadata:   metadata,
+		Spec:       map[string]interface{}{},
+	}
+	for _, key := range sortedKeys(values) {
+		option := valuesKeyToOption(kind, key, flags)
+		if option == "" {
+			if key == commandKey && values[key] != nil && values[key] != "" {
+				m.Spec[commandKey] = values[key]
+				continue
+			}
+			log.Debugf("skip the value %v of %v which is not given by any option", key, kind)
+			continue
+		}
+		value, ok := valueToSpec(option, values[key])
+		if !ok || isDefaultValue(flags.Lookup(option), value) {
+			continue
+		}
+		m.Spec[option] = value
+	}
+	return m, nil
+}
+
+// valuesKeyToOption returns the option name of the values key, empty if no option matches
+func valuesKeyToOption(kind, key string, flags *pflag.FlagSet) string {
+	candidates := []string{}
+	if option, ok := kindValuesKeyOptions[kind][key]; ok {
+		candidates = append(candidates, option)
+	}
+	if option, ok := valuesKeyOptions[key]; ok {
+		candidates = append(candidates, option)
+	}
+	candidates = append(candidates, toKebabCase(key), key)
+	for _, candidate := range candidates {
+		// the template options are already merged into the values
+		if candidate == "name" || candidate == "version" || candidate == "template" {
+			continue
+		}
+		flag := flags.Lookup(candidate)
+		if flag != nil && flag.Deprecated == "" {
+			return candidate
+		}
+	}
+	return ""
+}
+
+// valueToSpec converts a value of helm values to the value of spec,
+// returns false if the value can not be given by the option
+func valueToSpec(option string, value interface{}) (interface{}, bool) {
+	switch option {
+	case "env":
+		return filterMap(value, derivedEnvs)
+	case "annotation", "label":
+		return filterMap(value, derivedLabelsAndAnnotations)
+	case "data-dir":
+		items, ok := value.([]interface{})
+		if !ok {
+			return nil, false
+		}
+		dirs := []interface{}{}
+		for _, item := range items {
+			dir, ok := item.(map[string]interface{})
+			if !ok {
+				return nil, false
+			}
+			dirs = append(dirs, fmt.Sprintf("%v:%v", dir["hostPath"], dir["containerPath"]))
+		}
+		return dirs, true
+	case "toleration":
+		items, ok := value.([]interface{})
+		if !ok {
+			return nil, false
+		}
+		tolerations := []interface{}{}
+		for _, item := range items {
+			t, ok := item.(map[string]interface{})
+			if !ok {
+				return nil, false
+			}
+			if t["key"] == nil && t["operator"] == "Exists" {
+				tolerations = append(tolerations, "all")
+				continue
+			}
+			tolerations = append(tolerations, fmt.Sprintf("%v=%v:%v,%v", t["key"], valueOrEmpty(t["value"]), valueOrEmpty(t["effect"]), valueOrEmpty(t["operator"])))
+		}
+		return tolerations, true
+	case "model-format":
+		format, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if format["version"] == nil {
+			return fmt.Sprint(format["name"]), true
+		}
+		return fmt.Sprintf("%v:%v", format["name"], format["version"]), true
+	}
+	if secondsOptions[option] {
+		seconds, ok := value.(int)
+		if !ok {
+			return nil, false
+		}
+		return (time.Duration(seconds) * time.Second).String(), true
+	}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for _, item := range v {
+			if !isScalar(item) {
+				return nil, false
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			if !isScalar(item) {
+				return nil, false
+			}
+		}
+	}
+	return value, true
+}
+
+func filterMap(value interface{}, excluded map[string]bool) (interface{}, bool) {
+	m, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+	filtered := map[string]interface{}{}
+	for k, v := range m {
+		if excluded[k] {
+			continue
+		}
+		filtered[k] = v
+	}
+	return filtered, true
+}
+
+func valueOrEmpty(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+	return fmt.Sprint(value)
+}
+
+// isDefaultValue returns true if the value is same as the default value of the option
+func isDefaultValue(flag *pflag.Flag, value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		return len(v) == 0
+	case []interface{}:
+		return len(v) == 0
+	}
+	if flag == nil {
+		return false
+	}
+	if flag.Value.Type() == "duration" {
+		defaultValue, err := time.ParseDuration(flag.DefValue)
+		current, err2 := time.ParseDuration(fmt.Sprint(value))
+		return err == nil && err2 == nil && defaultValue == current
+	}
+	return fmt.Sprint(value) == flag.DefValue
+}
+
+// toKebabCase converts camelCase to kebab-case, like "shareMemory" to "share-memory"
+func toKebabCase(s string) string {
+	var builder strings.Builder
+	for i, r := range s {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				builder.WriteRune('-')
+			}
+			builder.WriteRune(unicode.ToLower(r))
+			continue
+		}
+		builder.WriteRune(r)
+	}
+	return builder.String()
+}
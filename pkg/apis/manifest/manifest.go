@@ -0,0 +1,450 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	yaml "gopkg.in/yaml.v3"
+
+	apiserving "github.com/kubeflow/arena/pkg/apis/serving"
+	apistraining "github.com/kubeflow/arena/pkg/apis/training"
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+// commandKey is the spec key which stores the job command
+const commandKey = "command"
+
+// optionSeparators stores the separator between key and value for options
+// whose values are given as a map in manifest, the default separator is "="
+var optionSeparators = map[string]string{
+	"data":                  ":",
+	"data-dir":              ":",
+	"data-subpath-expr":     ":",
+	"temp-dir":              ":",
+	"temp-dir-subpath-expr": ":",
+	"config-file":           ":",
+}
+
+// trainingJobBuilder adapts the builders of apis/training
+type trainingJobBuilder struct {
+	addCommandFlags func(command *cobra.Command)
+	build           func(command []string) (*apistraining.Job, error)
+}
+
+// servingJobBuilder adapts the builders of apis/serving
+type servingJobBuilder struct {
+	addCommandFlags func(command *cobra.Command)
+	build           func(namespace string, command []string) (*apiserving.Job, error)
+}
+
+var trainingJobBuilders = map[types.TrainingJobType]func() trainingJobBuilder{
+	types.TFTrainingJob: func() trainingJobBuilder {
+		b := apistraining.NewTFJobBuilder(nil)
+		return trainingJobBuilder{b.AddCommandFlags, func(c []string) (*apistraining.Job, error) { return b.Command(c).Build() }}
+	},
+	types.PytorchTrainingJob: func() trainingJobBuilder {
+		b := apistraining.NewPytorchJobBuilder()
+		return trainingJobBuilder{b.AddCommandFlags, func(c []string) (*apistraining.Job, error) { return b.Command(c).Build() }}
+	},
+	types.MPITrainingJob: func() trainingJobBuilder {
+		b := apistraining.NewMPIJobBuilder()
+		return trainingJobBuilder{b.AddCommandFlags, func(c []string) (*apistraining.Job, error) { return b.Command(c).Build() }}
+	},
+	types.HorovodTrainingJob: func() trainingJobBuilder {
+		b := apistraining.NewHorovodJobBuilder()
+		return trainingJobBuilder{b.AddCommandFlags, func(c []string) (*apistraining.Job, error) { return b.Command(c).Build() }}
+	},
+	types.VolcanoTrainingJob: func() trainingJobBuilder {
+		b := apistraining.NewVolcanoJobBuilder()
+		return trainingJobBuilder{b.AddCommandFlags, func(c []string) (*apistraining.Job, error) { return b.Command(c).Build() }}
+	},
+	types.ETTrainingJob: func() trainingJobBuilder {
+		b := apistraining.NewETJobBuilder()
+		return trainingJobBuilder{b.AddCommandFlags, func(c []string) (*apistraining.Job, error) { return b.Command(c).Build() }}
+	},
+	types.SparkTrainingJob: func() trainingJobBuilder {
+		b := apistraining.NewSparkJobBuilder()
+		return trainingJobBuilder{b.AddCommandFlags, func(c []string) (*apistraining.Job, error) {
+			if len(c) != 0 {
+				return nil, fmt.Errorf("the spark job does not support option %q", commandKey)
+			}
+			return b.Build()
+		}}
+	},
+	types.DeepSpeedTrainingJob: func() trainingJobBuilder {
+		b := apistraining.NewDeepSpeedJobBuilder()
+		return trainingJobBuilder{b.AddCommandFlags, func(c []string) (*apistraining.Job, error) { return b.Command(c).Build() }}
+	},
+	types.RayJob: func() trainingJobBuilder {
+		b := apistraining.NewRayJobBuilder()
+		return trainingJobBuilder{b.AddCommandFlags, func(c []string) (*apistraining.Job, error) { return b.Command(c).Build() }}
+	},
+	types.TrainJob: func() trainingJobBuilder {
+		b := apistraining.NewTrainJobBuilder()
+		return trainingJobBuilder{b.AddCommandFlags, func(c []string) (*apistraining.Job, error) { return b.Command(c).Build() }}
+	},
+	types.JobSetTrainingJob: func() trainingJobBuilder {
+		b := apistraining.NewJobSetBuilder()
+		return trainingJobBuilder{b.AddCommandFlags, func(c []string) (*apistraining.Job, error) { return b.Command(c).Build() }}
+	},
+}
+
+var servingJobBuilders = map[types.ServingJobType]func() servingJobBuilder{
+	types.TFServingJob: func() servingJobBuilder {
+		b := apiserving.NewTFServingJobBuilder()
+		return servingJobBuilder{b.AddCommandFlags, func(ns string, c []string) (*apiserving.Job, error) { return b.Namespace(ns).Command(c).Build() }}
+	},
+	types.KFServingJob: func() servingJobBuilder {
+		b := apiserving.NewKFServingJobBuilder()
+		return servingJobBuilder{b.AddCommandFlags, func(ns string, c []string) (*apiserving.Job, error) { return b.Namespace(ns).Command(c).Build() }}
+	},
+	types.KServeJob: func() servingJobBuilder {
+		b := apiserving.NewKServeJobBuilder()
+		return servingJobBuilder{b.AddCommandFlags, func(ns string, c []string) (*apiserving.Job, error) { return b.Namespace(ns).Command(c).Build() }}
+	},
+	types.SeldonServingJob: func() servingJobBuilder {
+		b := apiserving.NewSeldonServingJobBuilder()
+		return servingJobBuilder{b.AddCommandFlags, func(ns string, c []string) (*apiserving.Job, error) { return b.Namespace(ns).Command(c).Build() }}
+	},
+	types.TritonServingJob: func() servingJobBuilder {
+		b := apiserving.NewTritonServingJobBuilder()
+		return servingJobBuilder{b.AddCommandFlags, func(ns string, c []string) (*apiserving.Job, error) { return b.Namespace(ns).Command(c).Build() }}
+	},
+	types.CustomServingJob: func() servingJobBuilder {
+		b := apiserving.NewCustomServingJobBuilder()
+		return servingJobBuilder{b.AddCommandFlags, func(ns string, c []string) (*apiserving.Job, error) { return b.Namespace(ns).Command(c).Build() }}
+	},
+	types.DistributedServingJob: func() servingJobBuilder {
+		b := apiserving.NewDistributedServingJobBuilder()
+		return servingJobBuilder{b.AddCommandFlags, func(ns string, c []string) (*apiserving.Job, error) { return b.Namespace(ns).Command(c).Build() }}
+	},
+	types.VLLMServingJob: func() servingJobBuilder {
+		b := apiserving.NewVLLMServingJobBuilder()
+		return servingJobBuilder{b.AddCommandFlags, func(ns string, c []string) (*apiserving.Job, error) { return b.Namespace(ns).Command(c).Build() }}
+	},
+	types.SGLangServingJob: func() servingJobBuilder {
+		b := apiserving.NewSGLangServingJobBuilder()
+		return servingJobBuilder{b.AddCommandFlags, func(ns string, c []string) (*apiserving.Job, error) { return b.Namespace(ns).Command(c).Build() }}
+	},
+}
+
+// Load reads job manifests from a yaml or json file, "-" means reading from stdin
+func Load(filename string) ([]*types.JobManifest, error) {
+	var (
+		data []byte
+		err  error
+	)
+	if filename == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(filename)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file %v: %v", filename, err)
+	}
+	return Parse(data)
+}
+
+// Parse parses job manifests, multiple yaml documents are supported
+func Parse(data []byte) ([]*types.JobManifest, error) {
+	manifests := []*types.JobManifest{}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	for {
+		m := &types.JobManifest{}
+		err := decoder.Decode(m)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse manifest: %v", err)
+		}
+		if m.Kind == "" && m.APIVersion == "" && len(m.Spec) == 0 {
+			continue
+		}
+		if err := Validate(m); err != nil {
+			return nil, err
+		}
+		manifests = append(manifests, m)
+	}
+	if len(manifests) == 0 {
+		return nil, fmt.Errorf("not found any job manifest")
+	}
+	return manifests, nil
+}
+
+// Validate checks the required fields of the manifest
+func Validate(m *types.JobManifest) error {
+	if m.APIVersion != types.ManifestAPIVersion {
+		return fmt.Errorf("unsupported apiVersion %q, only support %v", m.APIVersion, types.ManifestAPIVersion)
+	}
+	if !IsTrainingKind(m.Kind) && !IsServingKind(m.Kind) {
+		return fmt.Errorf("unknown kind %q, the possible option is %v", m.Kind, strings.Join(SupportedKinds(), ","))
+	}
+	if m.Metadata.Name == "" {
+		return fmt.Errorf("metadata.name of the %v manifest must be set", m.Kind)
+	}
+	for _, key := range []string{"name", "namespace", "version"} {
+		if _, ok := m.Spec[key]; ok {
+			return fmt.Errorf("%v of %v/%v must be set by metadata.%v rather than spec", key, m.Kind, m.Metadata.Name, key)
+		}
+	}
+	return nil
+}
+
+// SupportedKinds returns all supported manifest kinds
+func SupportedKinds() []string {
+	kinds := []string{}
+	for kind := range types.ManifestTrainingKinds {
+		kinds = append(kinds, kind)
+	}
+	for kind := range types.ManifestServingKinds {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	return kinds
+}
+
+// IsTrainingKind returns true if the kind is a training job kind
+func IsTrainingKind(kind string) bool {
+	_, ok := types.ManifestTrainingKinds[kind]
+	return ok
+}
+
+// IsServingKind returns true if the kind is a serving job kind
+func IsServingKind(kind string) bool {
+	_, ok := types.ManifestServingKinds[kind]
+	return ok
+}
+
+// TrainingKind returns the manifest kind of the training job type
+func TrainingKind(jobType types.TrainingJobType) (string, error) {
+	for kind, t := range types.ManifestTrainingKinds {
+		if t == jobType {
+			return kind, nil
+		}
+	}
+	return "", fmt.Errorf("training job type %v is not supported by manifest", jobType)
+}
+
+// ServingKind returns the manifest kind of the serving job type
+func ServingKind(jobType types.ServingJobType) (string, error) {
+	for kind, t := range types.ManifestServingKinds {
+		if t == jobType {
+			return kind, nil
+		}
+	}
+	return "", fmt.Errorf("serving job type %v is not supported by manifest", jobType)
+}
+
+// BuildTrainingJob converts the manifest to a training job,
+// the spec is validated by the same builder as 'arena submit'
+func BuildTrainingJob(m *types.JobManifest) (*apistraining.Job, error) {
+	newBuilder, ok := trainingJobBuilders[types.ManifestTrainingKinds[m.Kind]]
+	if !ok {
+		return nil, fmt.Errorf("kind %v is not a training job", m.Kind)
+	}
+	builder := newBuilder()
+	command := newFlagCommand(m.Kind, builder.addCommandFlags)
+	jobCommand, err := applySpec(command.Flags(), m)
+	if err != nil {
+		return nil, err
+	}
+	job, err := builder.build(jobCommand)
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate %v/%v: %v", m.Kind, m.Metadata.Name, err)
+	}
+	return job, nil
+}
+
+// BuildServingJob converts the manifest to a serving job,
+// the spec is validated by the same builder as 'arena serve'
+func BuildServingJob(m *types.JobManifest, namespace string) (*apiserving.Job, error) {
+	newBuilder, ok := servingJobBuilders[types.ManifestServingKinds[m.Kind]]
+	if !ok {
+		return nil, fmt.Errorf("kind %v is not a serving job", m.Kind)
+	}
+	builder := newBuilder()
+	command := newFlagCommand(m.Kind, builder.addCommandFlags)
+	jobCommand, err := applySpec(command.Flags(), m)
+	if err != nil {
+		return nil, err
+	}
+	job, err := builder.build(namespace, jobCommand)
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate %v/%v: %v", m.Kind, m.Metadata.Name, err)
+	}
+	return job, nil
+}
+
+// Override replaces the options of the manifest with the overrides given as "option=value",
+// the values of an option given multiple times replace the list of the option
+func Override(m *types.JobManifest, overrides []string) error {
+	flags, err := kindFlags(m.Kind)
+	if err != nil {
+		return err
+	}
+	listValues := map[string][]interface{}{}
+	given := map[string]bool{}
+	for _, override := range overrides {
+		items := strings.SplitN(override, "=", 2)
+		if len(items) != 2 || items[0] == "" {
+			return fmt.Errorf("invalid override %q, it should be like \"option=value\"", override)
+		}
+		option, value := strings.TrimPrefix(items[0], "--"), items[1]
+		switch option {
+		case "name", "namespace", "version":
+			return fmt.Errorf("%v of %v can not be overridden", option, m.Kind)
+		}
+		flag := flags.Lookup(option)
+		if flag == nil && option != commandKey {
+			return fmt.Errorf("unknown option %q for kind %v", option, m.Kind)
+		}
+		if flag != nil && isMultiValueFlag(flag) {
+			listValues[option] = append(listValues[option], value)
+			m.Spec[option] = listValues[option]
+			continue
+		}
+		if given[option] {
+			return fmt.Errorf("option %q of kind %v only accepts a single value", option, m.Kind)
+		}
+		given[option] = true
+		m.Spec[option] = value
+	}
+	return nil
+}
+
+// kindFlags returns the options which the kind accepts
+func kindFlags(kind string) (*pflag.FlagSet, error) {
+	if newBuilder, ok := trainingJobBuilders[types.ManifestTrainingKinds[kind]]; ok {
+		return newFlagCommand(kind, newBuilder().addCommandFlags).Flags(), nil
+	}
+	if newBuilder, ok := servingJobBuilders[types.ManifestServingKinds[kind]]; ok {
+		return newFlagCommand(kind, newBuilder().addCommandFlags).Flags(), nil
+	}
+	return nil, fmt.Errorf("unknown kind %q", kind)
+}
+
+func newFlagCommand(kind string, addCommandFlags func(command *cobra.Command)) *cobra.Command {
+	command := &cobra.Command{Use: kind}
+	addCommandFlags(command)
+	return command
+}
+
+// applySpec sets the options of the manifest and returns the job command
+func applySpec(flags *pflag.FlagSet, m *types.JobManifest) ([]string, error) {
+	if err := flags.Set("name", m.Metadata.Name); err != nil {
+		return nil, err
+	}
+	if m.Metadata.Version != "" {
+		if flags.Lookup("version") == nil {
+			return nil, fmt.Errorf("kind %v does not support metadata.version", m.Kind)
+		}
+		if err := flags.Set("version", m.Metadata.Version); err != nil {
+			return nil, err
+		}
+	}
+	jobCommand := []string{}
+	for _, key := range sortedKeys(m.Spec) {
+		flag := flags.Lookup(key)
+		if flag == nil && key == commandKey {
+			values, err := optionValues(key, m.Spec[key])
+			if err != nil {
+				return nil, err
+			}
+			jobCommand = values
+			continue
+		}
+		if flag == nil {
+			return nil, fmt.Errorf("unknown option %q for kind %v", key, m.Kind)
+		}
+		values, err := optionValues(key, m.Spec[key])
+		if err != nil {
+			return nil, err
+		}
+		if len(values) > 1 && !isMultiValueFlag(flag) {
+			return nil, fmt.Errorf("option %q of kind %v only accepts a single value", key, m.Kind)
+		}
+		for _, value := range values {
+			if err := flags.Set(key, value); err != nil {
+				return nil, fmt.Errorf("invalid value %q for option %q: %v", value, key, err)
+			}
+		}
+	}
+	return jobCommand, nil
+}
+
+// optionValues converts the value of spec to the values of command option,
+// a list gives an option multiple times and a map gives "key=value" pairs
+func optionValues(key string, value interface{}) ([]string, error) {
+	switch v := value.(type) {
+	case nil:
+		return []string{}, nil
+	case []interface{}:
+		values := []string{}
+		for _, item := range v {
+			if !isScalar(item) {
+				return nil, fmt.Errorf("the items of option %q must be scalar values", key)
+			}
+			values = append(values, fmt.Sprint(item))
+		}
+		return values, nil
+	case map[string]interface{}:
+		separator, ok := optionSeparators[key]
+		if !ok {
+			separator = "="
+		}
+		values := []string{}
+		for _, k := range sortedKeys(v) {
+			if !isScalar(v[k]) {
+				return nil, fmt.Errorf("the values of option %q must be scalar values", key)
+			}
+			values = append(values, fmt.Sprintf("%v%v%v", k, separator, v[k]))
+		}
+		return values, nil
+	default:
+		return []string{fmt.Sprint(v)}, nil
+	}
+}
+
+func isScalar(value interface{}) bool {
+	switch value.(type) {
+	case []interface{}, map[string]interface{}, nil:
+		return false
+	}
+	return true
+}
+
+func isMultiValueFlag(flag *pflag.Flag) bool {
+	t := flag.Value.Type()
+	return strings.HasSuffix(t, "Array") || strings.HasSuffix(t, "Slice")
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := []string{}
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,190 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+const pytorchManifest = `
+apiVersion: arena.kubeflow.org/v1alpha1
+kind: PyTorchJob
+metadata:
+  name: demo
+spec:
+  image: pytorch:latest
+  workers: 2
+  gpus: 1
+  env:
+    A: "1"
+  command: python train.py
+---
+apiVersion: arena.kubeflow.org/v1alpha1
+kind: KServe
+metadata:
+  name: demo-serving
+spec:
+  image: kserve:latest
+`
+
+func TestParse(t *testing.T) {
+	manifests, err := Parse([]byte(pytorchManifest))
+	if err != nil {
+		t.Fatalf("failed to parse manifests: %v", err)
+	}
+	if len(manifests) != 2 {
+		t.Fatalf("expected 2 manifests, got %d", len(manifests))
+	}
+	if manifests[0].Kind != "PyTorchJob" || manifests[1].Metadata.Name != "demo-serving" {
+		t.Fatalf("unexpected manifests: %+v", manifests)
+	}
+
+	invalid := map[string]string{
+		"apiVersion": "apiVersion: v1\nkind: PyTorchJob\nmetadata:\n  name: demo\n",
+		"kind":       "apiVersion: arena.kubeflow.org/v1alpha1\nkind: Unknown\nmetadata:\n  name: demo\n",
+		"name":       "apiVersion: arena.kubeflow.org/v1alpha1\nkind: PyTorchJob\nmetadata: {}\n",
+		"spec name":  "apiVersion: arena.kubeflow.org/v1alpha1\nkind: PyTorchJob\nmetadata:\n  name: demo\nspec:\n  name: other\n",
+		"empty":      "---\n",
+	}
+	for name, data := range invalid {
+		if _, err := Parse([]byte(data)); err == nil {
+			t.Errorf("expected an error for invalid %v", name)
+		}
+	}
+}
+
+func TestApplySpec(t *testing.T) {
+	manifests, err := Parse([]byte(pytorchManifest))
+	if err != nil {
+		t.Fatalf("failed to parse manifests: %v", err)
+	}
+	flags, err := kindFlags("PyTorchJob")
+	if err != nil {
+		t.Fatalf("failed to get flags: %v", err)
+	}
+	command, err := applySpec(flags, manifests[0])
+	if err != nil {
+		t.Fatalf("failed to apply spec: %v", err)
+	}
+	if strings.Join(command, " ") != "python train.py" {
+		t.Errorf("unexpected command %v", command)
+	}
+	if got := flags.Lookup("workers").Value.String(); got != "2" {
+		t.Errorf("expected workers 2, got %v", got)
+	}
+
+	manifests[0].Spec["unknown-option"] = "x"
+	flags, _ = kindFlags("PyTorchJob")
+	if _, err := applySpec(flags, manifests[0]); err == nil {
+		t.Error("expected an error for unknown option")
+	}
+}
+
+func TestNewManifestFromValuesAndDiff(t *testing.T) {
+	values := map[string]interface{}{
+		"image":    "pytorch:latest",
+		"workers":  2,
+		"gpuCount": 1,
+		"envs": map[string]interface{}{
+			"A":       "1",
+			"workers": "2",
+		},
+		"command":               "python train.py",
+		"activeDeadlineSeconds": 3600,
+		"useTensorboard":        false,
+		"chart":                 "pytorchjob",
+	}
+	live, err := NewManifestFromValues("PyTorchJob", types.JobManifestMetadata{Name: "demo"}, values)
+	if err != nil {
+		t.Fatalf("failed to convert values: %v", err)
+	}
+	if live.Spec["gpus"] != 1 || live.Spec["running-timeout"] != "1h0m0s" {
+		t.Errorf("unexpected spec %+v", live.Spec)
+	}
+	if _, ok := live.Spec["tensorboard"]; ok {
+		t.Error("expected the default value of tensorboard to be dropped")
+	}
+	if env := live.Spec["env"].(map[string]interface{}); len(env) != 1 {
+		t.Errorf("expected the derived envs to be dropped, got %v", env)
+	}
+
+	manifests, err := Parse([]byte(pytorchManifest))
+	if err != nil {
+		t.Fatalf("failed to parse manifests: %v", err)
+	}
+	desired := manifests[0]
+	desired.Spec["running-timeout"] = "60m"
+	items, err := Diff(desired, live)
+	if err != nil {
+		t.Fatalf("failed to diff: %v", err)
+	}
+	if len(items) != 0 {
+		t.Errorf("expected no differences, got %+v", items)
+	}
+
+	desired.Spec["workers"] = 3
+	items, err = Diff(desired, live)
+	if err != nil {
+		t.Fatalf("failed to diff: %v", err)
+	}
+	if len(items) != 1 || items[0].Key != "workers" || items[0].Live != "2" || items[0].Desired != "3" {
+		t.Errorf("unexpected differences %+v", items)
+	}
+}
+
+func TestToKebabCase(t *testing.T) {
+	for input, expected := range map[string]string{
+		"image":       "image",
+		"shareMemory": "share-memory",
+		"cpuLimit":    "cpu-limit",
+	} {
+		if got := toKebabCase(input); got != expected {
+			t.Errorf("toKebabCase(%q) = %q, expected %q", input, got, expected)
+		}
+	}
+}
+
+func TestOverride(t *testing.T) {
+	manifests, err := Parse([]byte(pytorchManifest))
+	if err != nil {
+		t.Fatalf("failed to parse manifests: %v", err)
+	}
+	m := manifests[0]
+	if err := Override(m, []string{"gpus=2", "--env=B=2", "env=C=3", "command=python train.py --resume"}); err != nil {
+		t.Fatalf("failed to override manifest: %v", err)
+	}
+	if m.Spec["gpus"] != "2" || m.Spec["command"] != "python train.py --resume" {
+		t.Errorf("unexpected spec after override: %v", m.Spec)
+	}
+	if envs, ok := m.Spec["env"].([]interface{}); !ok || len(envs) != 2 || envs[0] != "B=2" {
+		t.Errorf("expected the env to be replaced by the overrides, got %v", m.Spec["env"])
+	}
+	flags, err := kindFlags("PyTorchJob")
+	if err != nil {
+		t.Fatalf("failed to get flags: %v", err)
+	}
+	if _, err := applySpec(flags, m); err != nil {
+		t.Errorf("failed to apply the overridden spec: %v", err)
+	}
+
+	for _, override := range []string{"gpus", "unknown=1", "name=other", "gpus=1"} {
+		if err := Override(m, []string{"gpus=3", override}); err == nil {
+			t.Errorf("expected an error for override %q", override)
+		}
+	}
+}
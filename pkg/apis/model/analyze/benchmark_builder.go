@@ -288,6 +288,14 @@ func (m *ModelBenchmarkArgsBuilder) ReportPath(reportPath string) *ModelBenchmar
 	return m
 }
 
+// DryRun is used to only render or validate the job rather than submit it,match option --dry-run
+func (m *ModelBenchmarkArgsBuilder) DryRun(strategy types.DryRunStrategy) *ModelBenchmarkArgsBuilder {
+	if strategy != "" {
+		m.args.DryRun = strategy
+	}
+	return m
+}
+
 // Build is used to build the job
 func (m *ModelBenchmarkArgsBuilder) Build() (*Job, error) {
 	for key, value := range m.argValues {
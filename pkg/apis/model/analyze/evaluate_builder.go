@@ -280,6 +280,14 @@ func (m *ModelEvaluateJobBuilder) ReportPath(reportPath string) *ModelEvaluateJo
 	return m
 }
 
+// DryRun is used to only render or validate the job rather than submit it,match option --dry-run
+func (m *ModelEvaluateJobBuilder) DryRun(strategy types.DryRunStrategy) *ModelEvaluateJobBuilder {
+	if strategy != "" {
+		m.args.DryRun = strategy
+	}
+	return m
+}
+
 // Build is used to build the job
 func (m *ModelEvaluateJobBuilder) Build() (*Job, error) {
 	for key, value := range m.argValues {
@@ -280,6 +280,14 @@ func (m *ModelOptimizeJobBuilder) ExportPath(exportPath string) *ModelOptimizeJo
 	return m
 }
 
+// DryRun is used to only render or validate the job rather than submit it,match option --dry-run
+func (m *ModelOptimizeJobBuilder) DryRun(strategy types.DryRunStrategy) *ModelOptimizeJobBuilder {
+	if strategy != "" {
+		m.args.DryRun = strategy
+	}
+	return m
+}
+
 // Build is used to build the job
 func (m *ModelOptimizeJobBuilder) Build() (*Job, error) {
 	for key, value := range m.argValues {
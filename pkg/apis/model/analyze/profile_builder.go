@@ -264,6 +264,14 @@ func (m *ModelProfileJobBuilder) ReportPath(reportPath string) *ModelProfileJobB
 	return m
 }
 
+// DryRun is used to only render or validate the job rather than submit it,match option --dry-run
+func (m *ModelProfileJobBuilder) DryRun(strategy types.DryRunStrategy) *ModelProfileJobBuilder {
+	if strategy != "" {
+		m.args.DryRun = strategy
+	}
+	return m
+}
+
 // Build is used to build the job
 func (m *ModelProfileJobBuilder) Build() (*Job, error) {
 	for key, value := range m.argValues {
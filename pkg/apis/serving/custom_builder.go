@@ -319,6 +319,46 @@ func (b *CustomServingJobBuilder) MetricsPort(port int) *CustomServingJobBuilder
 	return b
 }
 
+// MinReplicas is used to set the minimum replicas of autoscaler,match the option --min-replicas
+func (b *CustomServingJobBuilder) MinReplicas(count int) *CustomServingJobBuilder {
+	if count > 0 {
+		b.args.MinReplicas = count
+	}
+	return b
+}
+
+// MaxReplicas is used to enable autoscaler and set its maximum replicas,match the option --max-replicas
+func (b *CustomServingJobBuilder) MaxReplicas(count int) *CustomServingJobBuilder {
+	if count > 0 {
+		b.args.MaxReplicas = count
+	}
+	return b
+}
+
+// ScaleMetric is used to set the metric watched by autoscaler,match the option --scale-metric
+func (b *CustomServingJobBuilder) ScaleMetric(metric types.ServingScaleMetric) *CustomServingJobBuilder {
+	if metric != "" {
+		b.args.ScaleMetric = metric
+	}
+	return b
+}
+
+// ScaleTarget is used to set the target value of the scale metric,match the option --scale-target
+func (b *CustomServingJobBuilder) ScaleTarget(target int) *CustomServingJobBuilder {
+	if target > 0 {
+		b.args.ScaleTarget = target
+	}
+	return b
+}
+
+// DryRun is used to only render or validate the job rather than submit it,match option --dry-run
+func (b *CustomServingJobBuilder) DryRun(strategy types.DryRunStrategy) *CustomServingJobBuilder {
+	if strategy != "" {
+		b.args.DryRun = strategy
+	}
+	return b
+}
+
 // Build is used to build the job
 func (b *CustomServingJobBuilder) Build() (*Job, error) {
 	for key, value := range b.argValues {
@@ -430,6 +430,14 @@ func (b *DistributedServingJobBuilder) InitBackend(backend string) *DistributedS
 	return b
 }
 
+// DryRun is used to only render or validate the job rather than submit it,match option --dry-run
+func (b *DistributedServingJobBuilder) DryRun(strategy types.DryRunStrategy) *DistributedServingJobBuilder {
+	if strategy != "" {
+		b.args.DryRun = strategy
+	}
+	return b
+}
+
 // Build is used to build the job
 func (b *DistributedServingJobBuilder) Build() (*Job, error) {
 	for key, value := range b.argValues {
@@ -367,6 +367,14 @@ func (b *KServeJobBuilder) Port(port int) *KServeJobBuilder {
 	return b
 }
 
+// DryRun is used to only render or validate the job rather than submit it,match option --dry-run
+func (b *KServeJobBuilder) DryRun(strategy types.DryRunStrategy) *KServeJobBuilder {
+	if strategy != "" {
+		b.args.DryRun = strategy
+	}
+	return b
+}
+
 // Build is used to build the job
 func (b *KServeJobBuilder) Build() (*Job, error) {
 	for key, value := range b.argValues {
@@ -283,6 +283,14 @@ func (b *KFServingJobBuilder) ConfigFiles(files map[string]string) *KFServingJob
 	return b
 }
 
+// DryRun is used to only render or validate the job rather than submit it,match option --dry-run
+func (b *KFServingJobBuilder) DryRun(strategy types.DryRunStrategy) *KFServingJobBuilder {
+	if strategy != "" {
+		b.args.DryRun = strategy
+	}
+	return b
+}
+
 // Build is used to build the job
 func (b *KFServingJobBuilder) Build() (*Job, error) {
 	for key, value := range b.argValues {
@@ -0,0 +1,320 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/argsbuilder"
+)
+
+// LLMServingJobBuilder builds vllm and sglang serving jobs
+type LLMServingJobBuilder struct {
+	args      *types.LLMServingArgs
+	argValues map[string]interface{}
+	argsbuilder.ArgsBuilder
+}
+
+// NewVLLMServingJobBuilder creates the builder of vllm serving job
+func NewVLLMServingJobBuilder() *LLMServingJobBuilder {
+	args := newLLMServingArgs()
+	return &LLMServingJobBuilder{
+		args:        args,
+		argValues:   map[string]interface{}{},
+		ArgsBuilder: argsbuilder.NewVLLMServingArgsBuilder(args),
+	}
+}
+
+// NewSGLangServingJobBuilder creates the builder of sglang serving job
+func NewSGLangServingJobBuilder() *LLMServingJobBuilder {
+	args := newLLMServingArgs()
+	return &LLMServingJobBuilder{
+		args:        args,
+		argValues:   map[string]interface{}{},
+		ArgsBuilder: argsbuilder.NewSGLangServingArgsBuilder(args),
+	}
+}
+
+func newLLMServingArgs() *types.LLMServingArgs {
+	return &types.LLMServingArgs{
+		CustomServingArgs: types.CustomServingArgs{
+			CommonServingArgs: types.CommonServingArgs{
+				ImagePullPolicy: "IfNotPresent",
+				Replicas:        1,
+				Shell:           "sh",
+			},
+		},
+	}
+}
+
+// Name is used to set job name,match option --name
+func (b *LLMServingJobBuilder) Name(name string) *LLMServingJobBuilder {
+	if name != "" {
+		b.args.Name = name
+	}
+	return b
+}
+
+// Namespace is used to set job namespace,match option --namespace
+func (b *LLMServingJobBuilder) Namespace(namespace string) *LLMServingJobBuilder {
+	if namespace != "" {
+		b.args.Namespace = namespace
+	}
+	return b
+}
+
+// Version is used to set serving job version,match the option --version
+func (b *LLMServingJobBuilder) Version(version string) *LLMServingJobBuilder {
+	if version != "" {
+		b.args.Version = version
+	}
+	return b
+}
+
+// Command is used to replace the generated server command,match the option --command
+func (b *LLMServingJobBuilder) Command(args []string) *LLMServingJobBuilder {
+	if b.args.Command == "" {
+		b.args.Command = strings.Join(args, " ")
+	}
+	return b
+}
+
+// ExtraArgs is used to append args to the generated server command
+func (b *LLMServingJobBuilder) ExtraArgs(args []string) *LLMServingJobBuilder {
+	b.args.ExtraArgs = append(b.args.ExtraArgs, args...)
+	return b
+}
+
+// ModelPath is used to set the model to serve,match the option --model-path
+func (b *LLMServingJobBuilder) ModelPath(path string) *LLMServingJobBuilder {
+	if path != "" {
+		b.args.ModelPath = path
+	}
+	return b
+}
+
+// ServedModelName is used to set the model name of OpenAI api,match the option --served-model-name
+func (b *LLMServingJobBuilder) ServedModelName(name string) *LLMServingJobBuilder {
+	if name != "" {
+		b.args.ServedModelName = name
+	}
+	return b
+}
+
+// TensorParallelSize is used to set tensor parallel size,match the option --tensor-parallel-size
+func (b *LLMServingJobBuilder) TensorParallelSize(size int) *LLMServingJobBuilder {
+	if size > 0 {
+		b.args.TensorParallelSize = size
+	}
+	return b
+}
+
+// PipelineParallelSize is used to set pipeline parallel size,match the option --pipeline-parallel-size
+func (b *LLMServingJobBuilder) PipelineParallelSize(size int) *LLMServingJobBuilder {
+	if size > 0 {
+		b.args.PipelineParallelSize = size
+	}
+	return b
+}
+
+// MaxModelLen is used to set the max context length,match the option --max-model-len
+func (b *LLMServingJobBuilder) MaxModelLen(length int) *LLMServingJobBuilder {
+	if length > 0 {
+		b.args.MaxModelLen = length
+	}
+	return b
+}
+
+// Quantization is used to set the quantization method,match the option --quantization
+func (b *LLMServingJobBuilder) Quantization(method string) *LLMServingJobBuilder {
+	if method != "" {
+		b.args.Quantization = method
+	}
+	return b
+}
+
+// GPUCount is used to set count of gpu for the job,match the option --gpus
+func (b *LLMServingJobBuilder) GPUCount(count int) *LLMServingJobBuilder {
+	if count > 0 {
+		b.args.GPUCount = count
+	}
+	return b
+}
+
+// Image is used to set job image,match the option --image
+func (b *LLMServingJobBuilder) Image(image string) *LLMServingJobBuilder {
+	if image != "" {
+		b.args.Image = image
+	}
+	return b
+}
+
+// ImagePullPolicy is used to set image pull policy,match the option --image-pull-policy
+func (b *LLMServingJobBuilder) ImagePullPolicy(policy string) *LLMServingJobBuilder {
+	if policy != "" {
+		b.args.ImagePullPolicy = policy
+	}
+	return b
+}
+
+// CPU assign cpu limits,match the option --cpu
+func (b *LLMServingJobBuilder) CPU(cpu string) *LLMServingJobBuilder {
+	if cpu != "" {
+		b.args.Cpu = cpu
+	}
+	return b
+}
+
+// Memory assign memory limits,match option --memory
+func (b *LLMServingJobBuilder) Memory(memory string) *LLMServingJobBuilder {
+	if memory != "" {
+		b.args.Memory = memory
+	}
+	return b
+}
+
+// ShareMemory is used to set shared memory of the pod,match option --share-memory
+func (b *LLMServingJobBuilder) ShareMemory(memory string) *LLMServingJobBuilder {
+	if memory != "" {
+		b.args.ShareMemory = memory
+	}
+	return b
+}
+
+// Envs is used to set env of job containers,match option --env
+func (b *LLMServingJobBuilder) Envs(envs map[string]string) *LLMServingJobBuilder {
+	if len(envs) != 0 {
+		envSlice := []string{}
+		for key, value := range envs {
+			envSlice = append(envSlice, fmt.Sprintf("%v=%v", key, value))
+		}
+		b.argValues["env"] = &envSlice
+	}
+	return b
+}
+
+// EnvsFromSecret is used to set env of job containers,match option --env-from-secret
+func (b *LLMServingJobBuilder) EnvsFromSecret(envs map[string]string) *LLMServingJobBuilder {
+	if len(envs) != 0 {
+		envSlice := []string{}
+		for key, value := range envs {
+			envSlice = append(envSlice, fmt.Sprintf("%v=%v", key, value))
+		}
+		b.argValues["env-from-secret"] = &envSlice
+	}
+	return b
+}
+
+// Replicas is used to set serving job replicas,match the option --replicas
+func (b *LLMServingJobBuilder) Replicas(count int) *LLMServingJobBuilder {
+	if count > 0 {
+		b.args.Replicas = count
+	}
+	return b
+}
+
+// Tolerations is used to set tolerations for tolerate nodes,match option --toleration
+func (b *LLMServingJobBuilder) Tolerations(tolerations []string) *LLMServingJobBuilder {
+	b.argValues["toleration"] = &tolerations
+	return b
+}
+
+// NodeSelectors is used to set node selectors for scheduling job,match option --selector
+func (b *LLMServingJobBuilder) NodeSelectors(selectors map[string]string) *LLMServingJobBuilder {
+	if len(selectors) != 0 {
+		selectorsSlice := []string{}
+		for key, value := range selectors {
+			selectorsSlice = append(selectorsSlice, fmt.Sprintf("%v=%v", key, value))
+		}
+		b.argValues["selector"] = &selectorsSlice
+	}
+	return b
+}
+
+// Datas is used to mount k8s pvc to job pods,match option --data
+func (b *LLMServingJobBuilder) Datas(volumes map[string]string) *LLMServingJobBuilder {
+	if len(volumes) != 0 {
+		s := []string{}
+		for key, value := range volumes {
+			s = append(s, fmt.Sprintf("%v:%v", key, value))
+		}
+		b.argValues["data"] = &s
+	}
+	return b
+}
+
+// RestfulPort is used to set the port of OpenAI api,match the option --restful-port
+func (b *LLMServingJobBuilder) RestfulPort(port int) *LLMServingJobBuilder {
+	if port > 0 {
+		b.args.RestfulPort = port
+	}
+	return b
+}
+
+// MinReplicas is used to set the minimum replicas of autoscaler,match the option --min-replicas
+func (b *LLMServingJobBuilder) MinReplicas(count int) *LLMServingJobBuilder {
+	if count > 0 {
+		b.args.MinReplicas = count
+	}
+	return b
+}
+
+// MaxReplicas is used to enable autoscaler and set its maximum replicas,match the option --max-replicas
+func (b *LLMServingJobBuilder) MaxReplicas(count int) *LLMServingJobBuilder {
+	if count > 0 {
+		b.args.MaxReplicas = count
+	}
+	return b
+}
+
+// ScaleMetric is used to set the metric watched by autoscaler,match the option --scale-metric
+func (b *LLMServingJobBuilder) ScaleMetric(metric types.ServingScaleMetric) *LLMServingJobBuilder {
+	if metric != "" {
+		b.args.ScaleMetric = metric
+	}
+	return b
+}
+
+// ScaleTarget is used to set the target value of the scale metric,match the option --scale-target
+func (b *LLMServingJobBuilder) ScaleTarget(target int) *LLMServingJobBuilder {
+	if target > 0 {
+		b.args.ScaleTarget = target
+	}
+	return b
+}
+
+// DryRun is used to only render or validate the job rather than submit it,match option --dry-run
+func (b *LLMServingJobBuilder) DryRun(strategy types.DryRunStrategy) *LLMServingJobBuilder {
+	if strategy != "" {
+		b.args.DryRun = strategy
+	}
+	return b
+}
+
+// Build is used to build the job
+func (b *LLMServingJobBuilder) Build() (*Job, error) {
+	for key, value := range b.argValues {
+		b.AddArgValue(key, value)
+	}
+	if err := b.PreBuild(); err != nil {
+		return nil, err
+	}
+	if err := b.ArgsBuilder.Build(); err != nil {
+		return nil, err
+	}
+	return NewJob(b.args.Name, b.args.ServingType, b.args), nil
+}
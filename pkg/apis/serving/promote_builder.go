@@ -0,0 +1,127 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/argsbuilder"
+)
+
+type ServingPromoteBuilder struct {
+	args      *types.ServingPromoteArgs
+	argValues map[string]interface{}
+	argsbuilder.ArgsBuilder
+}
+
+func NewServingPromoteBuilder() *ServingPromoteBuilder {
+	args := &types.ServingPromoteArgs{
+		OldVersions:      types.KeepOldServingVersions,
+		GracePeriod:      5 * time.Minute,
+		ReadinessTimeout: 10 * time.Minute,
+	}
+	return &ServingPromoteBuilder{
+		args:        args,
+		argValues:   map[string]interface{}{},
+		ArgsBuilder: argsbuilder.NewServingPromoteArgsBuilder(args),
+	}
+}
+
+// Name is used to set serving name
+func (b *ServingPromoteBuilder) Name(name string) *ServingPromoteBuilder {
+	if name != "" {
+		b.args.ServingName = name
+	}
+	return b
+}
+
+// Namespace is used to set serving namespace, the namespace of client is used if it is not set
+func (b *ServingPromoteBuilder) Namespace(namespace string) *ServingPromoteBuilder {
+	if namespace != "" {
+		b.args.Namespace = namespace
+	}
+	return b
+}
+
+// Type is used to set serving type, all serving types are searched if it is not set
+func (b *ServingPromoteBuilder) Type(servingType types.ServingJobType) *ServingPromoteBuilder {
+	b.args.Type = servingType
+	return b
+}
+
+// Version is used to set the version to promote,match option --version
+func (b *ServingPromoteBuilder) Version(version string) *ServingPromoteBuilder {
+	if version != "" {
+		b.args.Version = version
+	}
+	return b
+}
+
+// OldVersions is used to set what to do with the other versions after promotion,match option --old-versions
+func (b *ServingPromoteBuilder) OldVersions(policy types.OldServingVersionPolicy) *ServingPromoteBuilder {
+	if policy != "" {
+		value := string(policy)
+		b.argValues["old-versions"] = &value
+	}
+	return b
+}
+
+// GracePeriod is used to set the time to wait before handling the other versions,match option --grace-period
+func (b *ServingPromoteBuilder) GracePeriod(gracePeriod time.Duration) *ServingPromoteBuilder {
+	if gracePeriod >= 0 {
+		b.args.GracePeriod = gracePeriod
+	}
+	return b
+}
+
+// ReadinessTimeout is used to set the max time to wait for the version to be available,match option --readiness-timeout
+func (b *ServingPromoteBuilder) ReadinessTimeout(timeout time.Duration) *ServingPromoteBuilder {
+	if timeout > 0 {
+		b.args.ReadinessTimeout = timeout
+	}
+	return b
+}
+
+// Router is used to set the traffic router,match option --router
+func (b *ServingPromoteBuilder) Router(router types.TrafficRouterType) *ServingPromoteBuilder {
+	if router != "" {
+		b.args.Router = router
+	}
+	return b
+}
+
+// Gateway is used to set the parent gateway of HTTPRoute for the gateway-api router,match option --gateway
+func (b *ServingPromoteBuilder) Gateway(gateway string) *ServingPromoteBuilder {
+	if gateway != "" {
+		b.args.Gateway = gateway
+	}
+	return b
+}
+
+// Build is used to build the serving promote args
+func (b *ServingPromoteBuilder) Build() (*types.ServingPromoteArgs, error) {
+	for key, value := range b.argValues {
+		b.AddArgValue(key, value)
+	}
+	if err := b.PreBuild(); err != nil {
+		return nil, err
+	}
+	if err := b.ArgsBuilder.Build(); err != nil {
+		return nil, fmt.Errorf("failed to build serving promote args: %v", err)
+	}
+	return b.args, nil
+}
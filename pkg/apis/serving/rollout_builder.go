@@ -0,0 +1,152 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/argsbuilder"
+)
+
+type ServingRolloutBuilder struct {
+	args      *types.ServingRolloutArgs
+	argValues map[string]interface{}
+	argsbuilder.ArgsBuilder
+}
+
+func NewServingRolloutBuilder() *ServingRolloutBuilder {
+	args := &types.ServingRolloutArgs{
+		Steps:            []int{10, 25, 50, 100},
+		Interval:         5 * time.Minute,
+		ReadinessTimeout: 10 * time.Minute,
+	}
+	return &ServingRolloutBuilder{
+		args:        args,
+		argValues:   map[string]interface{}{},
+		ArgsBuilder: argsbuilder.NewServingRolloutArgsBuilder(args),
+	}
+}
+
+// Name is used to set serving name
+func (b *ServingRolloutBuilder) Name(name string) *ServingRolloutBuilder {
+	if name != "" {
+		b.args.ServingName = name
+	}
+	return b
+}
+
+// Namespace is used to set serving namespace, the namespace of client is used if it is not set
+func (b *ServingRolloutBuilder) Namespace(namespace string) *ServingRolloutBuilder {
+	if namespace != "" {
+		b.args.Namespace = namespace
+	}
+	return b
+}
+
+// Type is used to set serving type, all serving types are searched if it is not set
+func (b *ServingRolloutBuilder) Type(servingType types.ServingJobType) *ServingRolloutBuilder {
+	b.args.Type = servingType
+	return b
+}
+
+// From is used to set the version which the traffic is shifted from,match option --from
+func (b *ServingRolloutBuilder) From(version string) *ServingRolloutBuilder {
+	if version != "" {
+		b.args.FromVersion = version
+	}
+	return b
+}
+
+// To is used to set the version which the traffic is shifted to,match option --to
+func (b *ServingRolloutBuilder) To(version string) *ServingRolloutBuilder {
+	if version != "" {
+		b.args.ToVersion = version
+	}
+	return b
+}
+
+// Steps is used to set the traffic percentages of new version,match option --steps
+func (b *ServingRolloutBuilder) Steps(steps []int) *ServingRolloutBuilder {
+	if len(steps) != 0 {
+		b.args.Steps = steps
+	}
+	return b
+}
+
+// Interval is used to set the time to observe new version after each step,match option --interval
+func (b *ServingRolloutBuilder) Interval(interval time.Duration) *ServingRolloutBuilder {
+	if interval > 0 {
+		b.args.Interval = interval
+	}
+	return b
+}
+
+// ReadinessTimeout is used to set the max time to wait for new version to be available,match option --readiness-timeout
+func (b *ServingRolloutBuilder) ReadinessTimeout(timeout time.Duration) *ServingRolloutBuilder {
+	if timeout > 0 {
+		b.args.ReadinessTimeout = timeout
+	}
+	return b
+}
+
+// ErrorRateQuery is used to set the prometheus query of error rate and its max value,match option --error-rate-query and --max-error-rate
+func (b *ServingRolloutBuilder) ErrorRateQuery(query string, maxErrorRate float64) *ServingRolloutBuilder {
+	if query != "" {
+		b.argValues["error-rate-query"] = &query
+		b.argValues["max-error-rate"] = &maxErrorRate
+	}
+	return b
+}
+
+// LatencyQuery is used to set the prometheus query of latency and its max value,match option --latency-query and --max-latency
+func (b *ServingRolloutBuilder) LatencyQuery(query string, maxLatency float64) *ServingRolloutBuilder {
+	if query != "" {
+		b.argValues["latency-query"] = &query
+		b.argValues["max-latency"] = &maxLatency
+	}
+	return b
+}
+
+// Router is used to set the traffic router,match option --router
+func (b *ServingRolloutBuilder) Router(router types.TrafficRouterType) *ServingRolloutBuilder {
+	if router != "" {
+		b.args.Router = router
+	}
+	return b
+}
+
+// Gateway is used to set the parent gateway of HTTPRoute for the gateway-api router,match option --gateway
+func (b *ServingRolloutBuilder) Gateway(gateway string) *ServingRolloutBuilder {
+	if gateway != "" {
+		b.args.Gateway = gateway
+	}
+	return b
+}
+
+// Build is used to build the serving rollout args
+func (b *ServingRolloutBuilder) Build() (*types.ServingRolloutArgs, error) {
+	for key, value := range b.argValues {
+		b.AddArgValue(key, value)
+	}
+	if err := b.PreBuild(); err != nil {
+		return nil, err
+	}
+	if err := b.ArgsBuilder.Build(); err != nil {
+		return nil, fmt.Errorf("failed to build serving rollout args: %v", err)
+	}
+	return b.args, nil
+}
@@ -267,6 +267,14 @@ func (b *SeldonJobBuilder) ConfigFiles(files map[string]string) *SeldonJobBuilde
 	return b
 }
 
+// DryRun is used to only render or validate the job rather than submit it,match option --dry-run
+func (b *SeldonJobBuilder) DryRun(strategy types.DryRunStrategy) *SeldonJobBuilder {
+	if strategy != "" {
+		b.args.DryRun = strategy
+	}
+	return b
+}
+
 // Build is used to build the job
 func (b *SeldonJobBuilder) Build() (*Job, error) {
 	for key, value := range b.argValues {
@@ -345,6 +345,46 @@ func (b *TFServingJobBuilder) ConfigFiles(files map[string]string) *TFServingJob
 	return b
 }
 
+// MinReplicas is used to set the minimum replicas of autoscaler,match the option --min-replicas
+func (b *TFServingJobBuilder) MinReplicas(count int) *TFServingJobBuilder {
+	if count > 0 {
+		b.args.MinReplicas = count
+	}
+	return b
+}
+
+// MaxReplicas is used to enable autoscaler and set its maximum replicas,match the option --max-replicas
+func (b *TFServingJobBuilder) MaxReplicas(count int) *TFServingJobBuilder {
+	if count > 0 {
+		b.args.MaxReplicas = count
+	}
+	return b
+}
+
+// ScaleMetric is used to set the metric watched by autoscaler,match the option --scale-metric
+func (b *TFServingJobBuilder) ScaleMetric(metric types.ServingScaleMetric) *TFServingJobBuilder {
+	if metric != "" {
+		b.args.ScaleMetric = metric
+	}
+	return b
+}
+
+// ScaleTarget is used to set the target value of the scale metric,match the option --scale-target
+func (b *TFServingJobBuilder) ScaleTarget(target int) *TFServingJobBuilder {
+	if target > 0 {
+		b.args.ScaleTarget = target
+	}
+	return b
+}
+
+// DryRun is used to only render or validate the job rather than submit it,match option --dry-run
+func (b *TFServingJobBuilder) DryRun(strategy types.DryRunStrategy) *TFServingJobBuilder {
+	if strategy != "" {
+		b.args.DryRun = strategy
+	}
+	return b
+}
+
 // Build is used to build the job
 func (b *TFServingJobBuilder) Build() (*Job, error) {
 	for key, value := range b.argValues {
@@ -292,6 +292,14 @@ func (b *TRTServingJobBuilder) ConfigFiles(files map[string]string) *TRTServingJ
 	return b
 }
 
+// DryRun is used to only render or validate the job rather than submit it,match option --dry-run
+func (b *TRTServingJobBuilder) DryRun(strategy types.DryRunStrategy) *TRTServingJobBuilder {
+	if strategy != "" {
+		b.args.DryRun = strategy
+	}
+	return b
+}
+
 // Build is used to build the job
 func (b *TRTServingJobBuilder) Build() (*Job, error) {
 	for key, value := range b.argValues {
@@ -66,6 +66,22 @@ func (b *TrafficRouterBuilder) VersionWeight(weights []types.ServingVersionWeigh
 	return b
 }
 
+// Router is used to set the traffic router,match option --router
+func (b *TrafficRouterBuilder) Router(router types.TrafficRouterType) *TrafficRouterBuilder {
+	if router != "" {
+		b.args.Router = router
+	}
+	return b
+}
+
+// Gateway is used to set the parent gateway of HTTPRoute for the gateway-api router,match option --gateway
+func (b *TrafficRouterBuilder) Gateway(gateway string) *TrafficRouterBuilder {
+	if gateway != "" {
+		b.args.Gateway = gateway
+	}
+	return b
+}
+
 // Build is used to build the traffic router split args
 func (b *TrafficRouterBuilder) Build() (*types.TrafficRouterSplitArgs, error) {
 	if b.args.Namespace == "" {
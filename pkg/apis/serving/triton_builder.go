@@ -339,6 +339,46 @@ func (b *TritonServingJobBuilder) ConfigFiles(files map[string]string) *TritonSe
 	return b
 }
 
+// MinReplicas is used to set the minimum replicas of autoscaler,match the option --min-replicas
+func (b *TritonServingJobBuilder) MinReplicas(count int) *TritonServingJobBuilder {
+	if count > 0 {
+		b.args.MinReplicas = count
+	}
+	return b
+}
+
+// MaxReplicas is used to enable autoscaler and set its maximum replicas,match the option --max-replicas
+func (b *TritonServingJobBuilder) MaxReplicas(count int) *TritonServingJobBuilder {
+	if count > 0 {
+		b.args.MaxReplicas = count
+	}
+	return b
+}
+
+// ScaleMetric is used to set the metric watched by autoscaler,match the option --scale-metric
+func (b *TritonServingJobBuilder) ScaleMetric(metric types.ServingScaleMetric) *TritonServingJobBuilder {
+	if metric != "" {
+		b.args.ScaleMetric = metric
+	}
+	return b
+}
+
+// ScaleTarget is used to set the target value of the scale metric,match the option --scale-target
+func (b *TritonServingJobBuilder) ScaleTarget(target int) *TritonServingJobBuilder {
+	if target > 0 {
+		b.args.ScaleTarget = target
+	}
+	return b
+}
+
+// DryRun is used to only render or validate the job rather than submit it,match option --dry-run
+func (b *TritonServingJobBuilder) DryRun(strategy types.DryRunStrategy) *TritonServingJobBuilder {
+	if strategy != "" {
+		b.args.DryRun = strategy
+	}
+	return b
+}
+
 // Build is used to build the job
 func (b *TritonServingJobBuilder) Build() (*Job, error) {
 	for key, value := range b.argValues {
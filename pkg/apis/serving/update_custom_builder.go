@@ -143,6 +143,38 @@ func (b *UpdateCustomServingJobBuilder) Replicas(count int) *UpdateCustomServing
 	return b
 }
 
+// MinReplicas is used to set the minimum replicas of autoscaler,match the option --min-replicas
+func (b *UpdateCustomServingJobBuilder) MinReplicas(count int) *UpdateCustomServingJobBuilder {
+	if count > 0 {
+		b.args.MinReplicas = count
+	}
+	return b
+}
+
+// MaxReplicas is used to enable autoscaler and set its maximum replicas,match the option --max-replicas
+func (b *UpdateCustomServingJobBuilder) MaxReplicas(count int) *UpdateCustomServingJobBuilder {
+	if count > 0 {
+		b.args.MaxReplicas = count
+	}
+	return b
+}
+
+// ScaleMetric is used to set the metric watched by autoscaler,match the option --scale-metric
+func (b *UpdateCustomServingJobBuilder) ScaleMetric(metric types.ServingScaleMetric) *UpdateCustomServingJobBuilder {
+	if metric != "" {
+		b.args.ScaleMetric = metric
+	}
+	return b
+}
+
+// ScaleTarget is used to set the target value of the scale metric,match the option --scale-target
+func (b *UpdateCustomServingJobBuilder) ScaleTarget(target int) *UpdateCustomServingJobBuilder {
+	if target > 0 {
+		b.args.ScaleTarget = target
+	}
+	return b
+}
+
 // Build is used to build the job
 func (b *UpdateCustomServingJobBuilder) Build() (*Job, error) {
 	for key, value := range b.argValues {
@@ -167,6 +167,38 @@ func (b *UpdateTFServingJobBuilder) ModelPath(path string) *UpdateTFServingJobBu
 	return b
 }
 
+// MinReplicas is used to set the minimum replicas of autoscaler,match the option --min-replicas
+func (b *UpdateTFServingJobBuilder) MinReplicas(count int) *UpdateTFServingJobBuilder {
+	if count > 0 {
+		b.args.MinReplicas = count
+	}
+	return b
+}
+
+// MaxReplicas is used to enable autoscaler and set its maximum replicas,match the option --max-replicas
+func (b *UpdateTFServingJobBuilder) MaxReplicas(count int) *UpdateTFServingJobBuilder {
+	if count > 0 {
+		b.args.MaxReplicas = count
+	}
+	return b
+}
+
+// ScaleMetric is used to set the metric watched by autoscaler,match the option --scale-metric
+func (b *UpdateTFServingJobBuilder) ScaleMetric(metric types.ServingScaleMetric) *UpdateTFServingJobBuilder {
+	if metric != "" {
+		b.args.ScaleMetric = metric
+	}
+	return b
+}
+
+// ScaleTarget is used to set the target value of the scale metric,match the option --scale-target
+func (b *UpdateTFServingJobBuilder) ScaleTarget(target int) *UpdateTFServingJobBuilder {
+	if target > 0 {
+		b.args.ScaleTarget = target
+	}
+	return b
+}
+
 // Build is used to build the job
 func (b *UpdateTFServingJobBuilder) Build() (*Job, error) {
 	for key, value := range b.argValues {
@@ -149,6 +149,38 @@ func (b *UpdateTritonServingJobBuilder) AllowMetrics() *UpdateTritonServingJobBu
 	return b
 }
 
+// MinReplicas is used to set the minimum replicas of autoscaler,match the option --min-replicas
+func (b *UpdateTritonServingJobBuilder) MinReplicas(count int) *UpdateTritonServingJobBuilder {
+	if count > 0 {
+		b.args.MinReplicas = count
+	}
+	return b
+}
+
+// MaxReplicas is used to enable autoscaler and set its maximum replicas,match the option --max-replicas
+func (b *UpdateTritonServingJobBuilder) MaxReplicas(count int) *UpdateTritonServingJobBuilder {
+	if count > 0 {
+		b.args.MaxReplicas = count
+	}
+	return b
+}
+
+// ScaleMetric is used to set the metric watched by autoscaler,match the option --scale-metric
+func (b *UpdateTritonServingJobBuilder) ScaleMetric(metric types.ServingScaleMetric) *UpdateTritonServingJobBuilder {
+	if metric != "" {
+		b.args.ScaleMetric = metric
+	}
+	return b
+}
+
+// ScaleTarget is used to set the target value of the scale metric,match the option --scale-target
+func (b *UpdateTritonServingJobBuilder) ScaleTarget(target int) *UpdateTritonServingJobBuilder {
+	if target > 0 {
+		b.args.ScaleTarget = target
+	}
+	return b
+}
+
 // Build is used to build the job
 func (b *UpdateTritonServingJobBuilder) Build() (*Job, error) {
 	for key, value := range b.argValues {
@@ -155,6 +155,30 @@ func (b *DeepSpeedJobBuilder) Labels(labels map[string]string) *DeepSpeedJobBuil
 	return b
 }
 
+// QueueName submits the job to the kueue LocalQueue,match option --queue-name
+func (b *DeepSpeedJobBuilder) QueueName(name string) *DeepSpeedJobBuilder {
+	if name != "" {
+		b.args.QueueName = name
+	}
+	return b
+}
+
+// ExportLogsTo exports the logs to the absolute path or s3://bucket/prefix on arena wait or prune,match option --export-logs-to
+func (b *DeepSpeedJobBuilder) ExportLogsTo(to string) *DeepSpeedJobBuilder {
+	if to != "" {
+		b.args.ExportLogsTo = to
+	}
+	return b
+}
+
+// Template is used to set the job template whose options are used when they are not set,match option --template
+func (b *DeepSpeedJobBuilder) Template(name string) *DeepSpeedJobBuilder {
+	if name != "" {
+		b.args.Template = name
+	}
+	return b
+}
+
 // Datas is used to mount k8s pvc to job pods,match option --data
 func (b *DeepSpeedJobBuilder) Datas(volumes map[string]string) *DeepSpeedJobBuilder {
 	if len(volumes) != 0 {
@@ -271,6 +295,14 @@ func (b *DeepSpeedJobBuilder) Memory(memory string) *DeepSpeedJobBuilder {
 	return b
 }
 
+// DryRun is used to only render or validate the job rather than submit it,match option --dry-run
+func (b *DeepSpeedJobBuilder) DryRun(strategy types.DryRunStrategy) *DeepSpeedJobBuilder {
+	if strategy != "" {
+		b.args.DryRun = strategy
+	}
+	return b
+}
+
 // Build is used to build the job
 func (b *DeepSpeedJobBuilder) Build() (*Job, error) {
 	for key, value := range b.argValues {
@@ -289,6 +289,22 @@ func (b *ETJobBuilder) MinWorkers(count int) *ETJobBuilder {
 	return b
 }
 
+// Template is used to set the job template whose options are used when they are not set,match option --template
+func (b *ETJobBuilder) Template(name string) *ETJobBuilder {
+	if name != "" {
+		b.args.Template = name
+	}
+	return b
+}
+
+// DryRun is used to only render or validate the job rather than submit it,match option --dry-run
+func (b *ETJobBuilder) DryRun(strategy types.DryRunStrategy) *ETJobBuilder {
+	if strategy != "" {
+		b.args.DryRun = strategy
+	}
+	return b
+}
+
 // Build is used to build the job
 func (b *ETJobBuilder) Build() (*Job, error) {
 	for key, value := range b.argValues {
@@ -267,6 +267,22 @@ func (b *HorovodJobBuilder) SSHPort(port int) *HorovodJobBuilder {
 	return b
 }
 
+// Template is used to set the job template whose options are used when they are not set,match option --template
+func (b *HorovodJobBuilder) Template(name string) *HorovodJobBuilder {
+	if name != "" {
+		b.args.Template = name
+	}
+	return b
+}
+
+// DryRun is used to only render or validate the job rather than submit it,match option --dry-run
+func (b *HorovodJobBuilder) DryRun(strategy types.DryRunStrategy) *HorovodJobBuilder {
+	if strategy != "" {
+		b.args.DryRun = strategy
+	}
+	return b
+}
+
 // Build is used to build the job
 func (b *HorovodJobBuilder) Build() (*Job, error) {
 	for key, value := range b.argValues {
@@ -0,0 +1,294 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/argsbuilder"
+)
+
+type JobSetBuilder struct {
+	args      *types.SubmitJobSetArgs
+	argValues map[string]interface{}
+	argsbuilder.ArgsBuilder
+}
+
+func NewJobSetBuilder() *JobSetBuilder {
+	args := &types.SubmitJobSetArgs{
+		CommonSubmitArgs: types.CommonSubmitArgs{
+			Namespace:   "default",
+			Shell:       "sh",
+			WorkingDir:  "/root",
+			WorkerCount: 1,
+		},
+	}
+	return &JobSetBuilder{
+		args:        args,
+		argValues:   map[string]interface{}{},
+		ArgsBuilder: argsbuilder.NewSubmitJobSetArgsBuilder(args),
+	}
+}
+
+// Name is used to set job name,match option --name
+func (b *JobSetBuilder) Name(name string) *JobSetBuilder {
+	if name != "" {
+		b.args.Name = name
+	}
+	return b
+}
+
+// Shell is used to set bash or sh
+func (b *JobSetBuilder) Shell(shell string) *JobSetBuilder {
+	if shell != "" {
+		b.args.Shell = shell
+	}
+	return b
+}
+
+// Command is used to set the default command of replicated jobs
+func (b *JobSetBuilder) Command(args []string) *JobSetBuilder {
+	if b.args.Command == "" {
+		b.args.Command = strings.Join(args, " ")
+	}
+	return b
+}
+
+// WorkingDir is used to set working directory of job containers,default is '/root'
+// match option --working-dir
+func (b *JobSetBuilder) WorkingDir(dir string) *JobSetBuilder {
+	if dir != "" {
+		b.args.WorkingDir = dir
+	}
+	return b
+}
+
+// Envs is used to set env of job containers,match option --env
+func (b *JobSetBuilder) Envs(envs map[string]string) *JobSetBuilder {
+	if len(envs) != 0 {
+		envSlice := []string{}
+		for key, value := range envs {
+			envSlice = append(envSlice, fmt.Sprintf("%v=%v", key, value))
+		}
+		b.argValues["env"] = &envSlice
+	}
+	return b
+}
+
+// GPUCount is used to set the default count of gpu for each pod,match the option --gpus
+func (b *JobSetBuilder) GPUCount(count int) *JobSetBuilder {
+	if count > 0 {
+		b.args.GPUCount = count
+	}
+	return b
+}
+
+// Image is used to set the default image of replicated jobs,match the option --image
+func (b *JobSetBuilder) Image(image string) *JobSetBuilder {
+	if image != "" {
+		b.args.Image = image
+	}
+	return b
+}
+
+// Tolerations is used to set tolerations for tolerate nodes,match option --toleration
+func (b *JobSetBuilder) Tolerations(tolerations []string) *JobSetBuilder {
+	b.argValues["toleration"] = &tolerations
+	return b
+}
+
+// NodeSelectors is used to set node selectors for scheduling job,match option --selector
+func (b *JobSetBuilder) NodeSelectors(selectors map[string]string) *JobSetBuilder {
+	if len(selectors) != 0 {
+		selectorsSlice := []string{}
+		for key, value := range selectors {
+			selectorsSlice = append(selectorsSlice, fmt.Sprintf("%v=%v", key, value))
+		}
+		b.argValues["selector"] = &selectorsSlice
+	}
+	return b
+}
+
+// Annotations is used to add annotations for job,match option --annotation
+func (b *JobSetBuilder) Annotations(annotations map[string]string) *JobSetBuilder {
+	if len(annotations) != 0 {
+		s := []string{}
+		for key, value := range annotations {
+			s = append(s, fmt.Sprintf("%v=%v", key, value))
+		}
+		b.argValues["annotation"] = &s
+	}
+	return b
+}
+
+// Labels is used to add labels for job
+func (b *JobSetBuilder) Labels(labels map[string]string) *JobSetBuilder {
+	if len(labels) != 0 {
+		s := []string{}
+		for key, value := range labels {
+			s = append(s, fmt.Sprintf("%v=%v", key, value))
+		}
+		b.argValues["label"] = &s
+	}
+	return b
+}
+
+// QueueName submits the job to the kueue LocalQueue,match option --queue-name
+func (b *JobSetBuilder) QueueName(name string) *JobSetBuilder {
+	if name != "" {
+		b.args.QueueName = name
+	}
+	return b
+}
+
+// ExportLogsTo exports the logs to the absolute path or s3://bucket/prefix on arena wait or prune,match option --export-logs-to
+func (b *JobSetBuilder) ExportLogsTo(to string) *JobSetBuilder {
+	if to != "" {
+		b.args.ExportLogsTo = to
+	}
+	return b
+}
+
+// Template is used to set the job template whose options are used when they are not set,match option --template
+func (b *JobSetBuilder) Template(name string) *JobSetBuilder {
+	if name != "" {
+		b.args.Template = name
+	}
+	return b
+}
+
+// Datas is used to mount k8s pvc to job pods,match option --data
+func (b *JobSetBuilder) Datas(volumes map[string]string) *JobSetBuilder {
+	if len(volumes) != 0 {
+		s := []string{}
+		for key, value := range volumes {
+			s = append(s, fmt.Sprintf("%v:%v", key, value))
+		}
+		b.argValues["data"] = &s
+	}
+	return b
+}
+
+// DataDirs is used to mount host files to job containers,match option --data-dir
+func (b *JobSetBuilder) DataDirs(volumes map[string]string) *JobSetBuilder {
+	if len(volumes) != 0 {
+		s := []string{}
+		for key, value := range volumes {
+			s = append(s, fmt.Sprintf("%v:%v", key, value))
+		}
+		b.argValues["data-dir"] = &s
+	}
+	return b
+}
+
+// ImagePullSecrets is used to set image pull secrests,match option --image-pull-secret
+func (b *JobSetBuilder) ImagePullSecrets(secrets []string) *JobSetBuilder {
+	if secrets != nil {
+		b.argValues["image-pull-secret"] = &secrets
+	}
+	return b
+}
+
+// WorkerCount is used to set the replicas of the default replicated job,match option --workers
+func (b *JobSetBuilder) WorkerCount(count int) *JobSetBuilder {
+	if count > 0 {
+		b.args.WorkerCount = count
+	}
+	return b
+}
+
+// CPU assign the default cpu limits of each pod,match option --cpu
+func (b *JobSetBuilder) CPU(cpu string) *JobSetBuilder {
+	if cpu != "" {
+		b.args.Cpu = cpu
+	}
+	return b
+}
+
+// Memory assign the default memory limits of each pod,match option --memory
+func (b *JobSetBuilder) Memory(memory string) *JobSetBuilder {
+	if memory != "" {
+		b.args.Memory = memory
+	}
+	return b
+}
+
+// ShareMemory is used to set the shared memory of each pod,match option --share-memory
+func (b *JobSetBuilder) ShareMemory(shm string) *JobSetBuilder {
+	if shm != "" {
+		b.args.ShareMemory = shm
+	}
+	return b
+}
+
+// ReplicatedJobs is used to add the replicated jobs,the first one is the chief,match option --replicated-job
+func (b *JobSetBuilder) ReplicatedJobs(jobs []types.JobSetReplicatedJob) *JobSetBuilder {
+	if len(jobs) != 0 {
+		s := []string{}
+		commands := []string{}
+		for _, job := range jobs {
+			item := fmt.Sprintf("name=%v", job.Name)
+			if job.Image != "" {
+				item += fmt.Sprintf(",image=%v", job.Image)
+			}
+			if job.Replicas > 0 {
+				item += fmt.Sprintf(",replicas=%v", job.Replicas)
+			}
+			if job.Pods > 0 {
+				item += fmt.Sprintf(",pods=%v", job.Pods)
+			}
+			if job.GPUCount > 0 {
+				item += fmt.Sprintf(",gpus=%v", job.GPUCount)
+			}
+			if job.Cpu != "" {
+				item += fmt.Sprintf(",cpu=%v", job.Cpu)
+			}
+			if job.Memory != "" {
+				item += fmt.Sprintf(",memory=%v", job.Memory)
+			}
+			s = append(s, item)
+			if job.Command != "" {
+				commands = append(commands, fmt.Sprintf("%v=%v", job.Name, job.Command))
+			}
+		}
+		b.argValues["replicated-job"] = &s
+		b.argValues["replicated-job-command"] = &commands
+	}
+	return b
+}
+
+// DryRun is used to only render or validate the job rather than submit it,match option --dry-run
+func (b *JobSetBuilder) DryRun(strategy types.DryRunStrategy) *JobSetBuilder {
+	if strategy != "" {
+		b.args.DryRun = strategy
+	}
+	return b
+}
+
+// Build is used to build the job
+func (b *JobSetBuilder) Build() (*Job, error) {
+	for key, value := range b.argValues {
+		b.AddArgValue(key, value)
+	}
+	if err := b.PreBuild(); err != nil {
+		return nil, err
+	}
+	if err := b.ArgsBuilder.Build(); err != nil {
+		return nil, err
+	}
+	return NewJob(b.args.Name, types.JobSetTrainingJob, b.args), nil
+}
@@ -0,0 +1,63 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/argsbuilder"
+)
+
+// JobTemplateBuilder builds the job template which stores the common options of training jobs
+type JobTemplateBuilder struct {
+	args *types.JobTemplate
+	argsbuilder.ArgsBuilder
+}
+
+func NewJobTemplateBuilder() *JobTemplateBuilder {
+	args := &types.JobTemplate{}
+	return &JobTemplateBuilder{
+		args:        args,
+		ArgsBuilder: argsbuilder.NewJobTemplateArgsBuilder(args),
+	}
+}
+
+// Name is used to set template name
+func (b *JobTemplateBuilder) Name(name string) *JobTemplateBuilder {
+	if name != "" {
+		b.args.Name = name
+	}
+	return b
+}
+
+// Options is used to set the common options of 'arena submit' (without the leading "--") and their values,
+// the value is a string or a string list for the options which can be given multiple times, like
+// {"image": "tensorflow/tensorflow:2.12.0-gpu", "toleration": []string{"all"}}
+func (b *JobTemplateBuilder) Options(options map[string]interface{}) *JobTemplateBuilder {
+	if len(options) != 0 {
+		b.args.Options = options
+	}
+	return b
+}
+
+// Build is used to build the job template
+func (b *JobTemplateBuilder) Build() (*types.JobTemplate, error) {
+	if err := b.PreBuild(); err != nil {
+		return nil, err
+	}
+	if err := b.ArgsBuilder.Build(); err != nil {
+		return nil, err
+	}
+	return b.args, nil
+}
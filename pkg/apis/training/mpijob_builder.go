@@ -156,6 +156,30 @@ func (b *MPIJobBuilder) Labels(labels map[string]string) *MPIJobBuilder {
 	return b
 }
 
+// QueueName submits the job to the kueue LocalQueue,match option --queue-name
+func (b *MPIJobBuilder) QueueName(name string) *MPIJobBuilder {
+	if name != "" {
+		b.args.QueueName = name
+	}
+	return b
+}
+
+// ExportLogsTo exports the logs to the absolute path or s3://bucket/prefix on arena wait or prune,match option --export-logs-to
+func (b *MPIJobBuilder) ExportLogsTo(to string) *MPIJobBuilder {
+	if to != "" {
+		b.args.ExportLogsTo = to
+	}
+	return b
+}
+
+// Template is used to set the job template whose options are used when they are not set,match option --template
+func (b *MPIJobBuilder) Template(name string) *MPIJobBuilder {
+	if name != "" {
+		b.args.Template = name
+	}
+	return b
+}
+
 // Datas is used to mount k8s pvc to job pods,match option --data
 func (b *MPIJobBuilder) Datas(volumes map[string]string) *MPIJobBuilder {
 	if len(volumes) != 0 {
@@ -292,6 +316,14 @@ func (b *MPIJobBuilder) CleanPodPolicy(policy string) *MPIJobBuilder {
 	return b
 }
 
+// DryRun is used to only render or validate the job rather than submit it,match option --dry-run
+func (b *MPIJobBuilder) DryRun(strategy types.DryRunStrategy) *MPIJobBuilder {
+	if strategy != "" {
+		b.args.DryRun = strategy
+	}
+	return b
+}
+
 // Build is used to build the job
 func (b *MPIJobBuilder) Build() (*Job, error) {
 	for key, value := range b.argValues {
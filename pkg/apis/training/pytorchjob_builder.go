@@ -168,6 +168,30 @@ func (b *PytorchJobBuilder) Labels(labels map[string]string) *PytorchJobBuilder
 	return b
 }
 
+// QueueName submits the job to the kueue LocalQueue,match option --queue-name
+func (b *PytorchJobBuilder) QueueName(name string) *PytorchJobBuilder {
+	if name != "" {
+		b.args.QueueName = name
+	}
+	return b
+}
+
+// ExportLogsTo exports the logs to the absolute path or s3://bucket/prefix on arena wait or prune,match option --export-logs-to
+func (b *PytorchJobBuilder) ExportLogsTo(to string) *PytorchJobBuilder {
+	if to != "" {
+		b.args.ExportLogsTo = to
+	}
+	return b
+}
+
+// Template is used to set the job template whose options are used when they are not set,match option --template
+func (b *PytorchJobBuilder) Template(name string) *PytorchJobBuilder {
+	if name != "" {
+		b.args.Template = name
+	}
+	return b
+}
+
 // Datas is used to mount k8s pvc to job pods,match option --data
 func (b *PytorchJobBuilder) Datas(volumes map[string]string) *PytorchJobBuilder {
 	if len(volumes) != 0 {
@@ -315,6 +339,14 @@ func (b *PytorchJobBuilder) ShareMemory(shm string) *PytorchJobBuilder {
 	return b
 }
 
+// DryRun is used to only render or validate the job rather than submit it,match option --dry-run
+func (b *PytorchJobBuilder) DryRun(strategy types.DryRunStrategy) *PytorchJobBuilder {
+	if strategy != "" {
+		b.args.DryRun = strategy
+	}
+	return b
+}
+
 // Build is used to build the job
 func (b *PytorchJobBuilder) Build() (*Job, error) {
 	for key, value := range b.argValues {
@@ -159,6 +159,30 @@ func (b *RayJobBuilder) Labels(labels map[string]string) *RayJobBuilder {
 	return b
 }
 
+// QueueName submits the job to the kueue LocalQueue,match option --queue-name
+func (b *RayJobBuilder) QueueName(name string) *RayJobBuilder {
+	if name != "" {
+		b.args.QueueName = name
+	}
+	return b
+}
+
+// ExportLogsTo exports the logs to the absolute path or s3://bucket/prefix on arena wait or prune,match option --export-logs-to
+func (b *RayJobBuilder) ExportLogsTo(to string) *RayJobBuilder {
+	if to != "" {
+		b.args.ExportLogsTo = to
+	}
+	return b
+}
+
+// Template is used to set the job template whose options are used when they are not set,match option --template
+func (b *RayJobBuilder) Template(name string) *RayJobBuilder {
+	if name != "" {
+		b.args.Template = name
+	}
+	return b
+}
+
 // Datas is used to mount k8s pvc to job pods,match option --data
 func (b *RayJobBuilder) Datas(volumes map[string]string) *RayJobBuilder {
 	if len(volumes) != 0 {
@@ -282,6 +306,14 @@ func (b *RayJobBuilder) ShareMemory(shm string) *RayJobBuilder {
 	return b
 }
 
+// DryRun is used to only render or validate the job rather than submit it,match option --dry-run
+func (b *RayJobBuilder) DryRun(strategy types.DryRunStrategy) *RayJobBuilder {
+	if strategy != "" {
+		b.args.DryRun = strategy
+	}
+	return b
+}
+
 // Build is used to build the job
 func (b *RayJobBuilder) Build() (*Job, error) {
 	for key, value := range b.argValues {
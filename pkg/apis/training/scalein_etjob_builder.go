@@ -91,6 +91,14 @@ func (b *ScaleInETJobBuilder) Envs(envs map[string]string) *ScaleInETJobBuilder
 	return b
 }
 
+// DryRun is used to only render or validate the job rather than submit it,match option --dry-run
+func (b *ScaleInETJobBuilder) DryRun(strategy types.DryRunStrategy) *ScaleInETJobBuilder {
+	if strategy != "" {
+		b.args.DryRun = strategy
+	}
+	return b
+}
+
 // Build is used to build the job
 func (b *ScaleInETJobBuilder) Build() (*Job, error) {
 	for key, value := range b.argValues {
@@ -91,6 +91,14 @@ func (b *ScaleOutETJobBuilder) Envs(envs map[string]string) *ScaleOutETJobBuilde
 	return b
 }
 
+// DryRun is used to only render or validate the job rather than submit it,match option --dry-run
+func (b *ScaleOutETJobBuilder) DryRun(strategy types.DryRunStrategy) *ScaleOutETJobBuilder {
+	if strategy != "" {
+		b.args.DryRun = strategy
+	}
+	return b
+}
+
 // Build is used to build the job
 func (b *ScaleOutETJobBuilder) Build() (*Job, error) {
 	for key, value := range b.argValues {
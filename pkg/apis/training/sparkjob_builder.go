@@ -135,6 +135,14 @@ func (b *SparkJobBuilder) Annotations(annotations map[string]string) *SparkJobBu
 	return b
 }
 
+// DryRun is used to only render or validate the job rather than submit it,match option --dry-run
+func (b *SparkJobBuilder) DryRun(strategy types.DryRunStrategy) *SparkJobBuilder {
+	if strategy != "" {
+		b.args.DryRun = strategy
+	}
+	return b
+}
+
 // Build is used to build the job
 func (b *SparkJobBuilder) Build() (*Job, error) {
 	for key, value := range b.argValues {
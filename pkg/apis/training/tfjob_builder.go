@@ -162,6 +162,30 @@ func (b *TFJobBuilder) Labels(labels map[string]string) *TFJobBuilder {
 	return b
 }
 
+// QueueName submits the job to the kueue LocalQueue,match option --queue-name
+func (b *TFJobBuilder) QueueName(name string) *TFJobBuilder {
+	if name != "" {
+		b.args.QueueName = name
+	}
+	return b
+}
+
+// ExportLogsTo exports the logs to the absolute path or s3://bucket/prefix on arena wait or prune,match option --export-logs-to
+func (b *TFJobBuilder) ExportLogsTo(to string) *TFJobBuilder {
+	if to != "" {
+		b.args.ExportLogsTo = to
+	}
+	return b
+}
+
+// Template is used to set the job template whose options are used when they are not set,match option --template
+func (b *TFJobBuilder) Template(name string) *TFJobBuilder {
+	if name != "" {
+		b.args.Template = name
+	}
+	return b
+}
+
 func (b *TFJobBuilder) EnableChief() *TFJobBuilder {
 	b.args.UseChief = true
 	return b
@@ -499,6 +523,14 @@ func (b *TFJobBuilder) ShareMemory(shm string) *TFJobBuilder {
 	return b
 }
 
+// DryRun is used to only render or validate the job rather than submit it,match option --dry-run
+func (b *TFJobBuilder) DryRun(strategy types.DryRunStrategy) *TFJobBuilder {
+	if strategy != "" {
+		b.args.DryRun = strategy
+	}
+	return b
+}
+
 func (b *TFJobBuilder) Build() (*Job, error) {
 	for key, value := range b.argValues {
 		b.AddArgValue(key, value)
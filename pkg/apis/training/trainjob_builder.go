@@ -0,0 +1,292 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/argsbuilder"
+	trainerv1alpha1 "github.com/kubeflow/arena/pkg/operators/trainer-operator/apis/trainer/v1alpha1"
+)
+
+type TrainJobBuilder struct {
+	args      *types.SubmitTrainJobArgs
+	argValues map[string]interface{}
+	argsbuilder.ArgsBuilder
+}
+
+func NewTrainJobBuilder() *TrainJobBuilder {
+	args := &types.SubmitTrainJobArgs{
+		CommonSubmitArgs: types.CommonSubmitArgs{
+			Namespace:   "default",
+			Shell:       "sh",
+			WorkingDir:  "/root",
+			WorkerCount: 1,
+		},
+		RuntimeKind: trainerv1alpha1.ClusterTrainingRuntimeKind,
+	}
+	return &TrainJobBuilder{
+		args:        args,
+		argValues:   map[string]interface{}{},
+		ArgsBuilder: argsbuilder.NewSubmitTrainJobArgsBuilder(args),
+	}
+}
+
+// Name is used to set job name,match option --name
+func (b *TrainJobBuilder) Name(name string) *TrainJobBuilder {
+	if name != "" {
+		b.args.Name = name
+	}
+	return b
+}
+
+// Runtime is used to set the training runtime,match option --runtime
+func (b *TrainJobBuilder) Runtime(runtime string) *TrainJobBuilder {
+	if runtime != "" {
+		b.args.Runtime = runtime
+	}
+	return b
+}
+
+// RuntimeKind is used to set the kind of training runtime,match option --runtime-kind
+func (b *TrainJobBuilder) RuntimeKind(kind string) *TrainJobBuilder {
+	if kind != "" {
+		b.args.RuntimeKind = kind
+	}
+	return b
+}
+
+// Shell is used to set bash or sh
+func (b *TrainJobBuilder) Shell(shell string) *TrainJobBuilder {
+	if shell != "" {
+		b.args.Shell = shell
+	}
+	return b
+}
+
+// Command is used to set job command
+func (b *TrainJobBuilder) Command(args []string) *TrainJobBuilder {
+	if b.args.Command == "" {
+		b.args.Command = strings.Join(args, " ")
+	}
+	return b
+}
+
+// WorkingDir is used to set working directory of job containers,default is '/root'
+// match option --working-dir
+func (b *TrainJobBuilder) WorkingDir(dir string) *TrainJobBuilder {
+	if dir != "" {
+		b.args.WorkingDir = dir
+	}
+	return b
+}
+
+// Envs is used to set env of job containers,match option --env
+func (b *TrainJobBuilder) Envs(envs map[string]string) *TrainJobBuilder {
+	if len(envs) != 0 {
+		envSlice := []string{}
+		for key, value := range envs {
+			envSlice = append(envSlice, fmt.Sprintf("%v=%v", key, value))
+		}
+		b.argValues["env"] = &envSlice
+	}
+	return b
+}
+
+// GPUCount is used to set count of gpu for each node,match the option --gpus
+func (b *TrainJobBuilder) GPUCount(count int) *TrainJobBuilder {
+	if count > 0 {
+		b.args.GPUCount = count
+	}
+	return b
+}
+
+// Image is used to override the trainer image of runtime,match the option --image
+func (b *TrainJobBuilder) Image(image string) *TrainJobBuilder {
+	if image != "" {
+		b.args.Image = image
+	}
+	return b
+}
+
+// Tolerations is used to set tolerations for tolerate nodes,match option --toleration
+func (b *TrainJobBuilder) Tolerations(tolerations []string) *TrainJobBuilder {
+	b.argValues["toleration"] = &tolerations
+	return b
+}
+
+// NodeSelectors is used to set node selectors for scheduling job,match option --selector
+func (b *TrainJobBuilder) NodeSelectors(selectors map[string]string) *TrainJobBuilder {
+	if len(selectors) != 0 {
+		selectorsSlice := []string{}
+		for key, value := range selectors {
+			selectorsSlice = append(selectorsSlice, fmt.Sprintf("%v=%v", key, value))
+		}
+		b.argValues["selector"] = &selectorsSlice
+	}
+	return b
+}
+
+// Annotations is used to add annotations for job,match option --annotation
+func (b *TrainJobBuilder) Annotations(annotations map[string]string) *TrainJobBuilder {
+	if len(annotations) != 0 {
+		s := []string{}
+		for key, value := range annotations {
+			s = append(s, fmt.Sprintf("%v=%v", key, value))
+		}
+		b.argValues["annotation"] = &s
+	}
+	return b
+}
+
+// Labels is used to add labels for job
+func (b *TrainJobBuilder) Labels(labels map[string]string) *TrainJobBuilder {
+	if len(labels) != 0 {
+		s := []string{}
+		for key, value := range labels {
+			s = append(s, fmt.Sprintf("%v=%v", key, value))
+		}
+		b.argValues["label"] = &s
+	}
+	return b
+}
+
+// QueueName submits the job to the kueue LocalQueue,match option --queue-name
+func (b *TrainJobBuilder) QueueName(name string) *TrainJobBuilder {
+	if name != "" {
+		b.args.QueueName = name
+	}
+	return b
+}
+
+// ExportLogsTo exports the logs to the absolute path or s3://bucket/prefix on arena wait or prune,match option --export-logs-to
+func (b *TrainJobBuilder) ExportLogsTo(to string) *TrainJobBuilder {
+	if to != "" {
+		b.args.ExportLogsTo = to
+	}
+	return b
+}
+
+// Template is used to set the job template whose options are used when they are not set,match option --template
+func (b *TrainJobBuilder) Template(name string) *TrainJobBuilder {
+	if name != "" {
+		b.args.Template = name
+	}
+	return b
+}
+
+// Datas is used to mount k8s pvc to job pods,match option --data
+func (b *TrainJobBuilder) Datas(volumes map[string]string) *TrainJobBuilder {
+	if len(volumes) != 0 {
+		s := []string{}
+		for key, value := range volumes {
+			s = append(s, fmt.Sprintf("%v:%v", key, value))
+		}
+		b.argValues["data"] = &s
+	}
+	return b
+}
+
+// DataDirs is used to mount host files to job containers,match option --data-dir
+func (b *TrainJobBuilder) DataDirs(volumes map[string]string) *TrainJobBuilder {
+	if len(volumes) != 0 {
+		s := []string{}
+		for key, value := range volumes {
+			s = append(s, fmt.Sprintf("%v:%v", key, value))
+		}
+		b.argValues["data-dir"] = &s
+	}
+	return b
+}
+
+// ImagePullSecrets is used to set image pull secrests,match option --image-pull-secret
+func (b *TrainJobBuilder) ImagePullSecrets(secrets []string) *TrainJobBuilder {
+	if secrets != nil {
+		b.argValues["image-pull-secret"] = &secrets
+	}
+	return b
+}
+
+// WorkerCount is used to set count of training nodes,match option --workers
+func (b *TrainJobBuilder) WorkerCount(count int) *TrainJobBuilder {
+	if count > 0 {
+		b.args.WorkerCount = count
+	}
+	return b
+}
+
+// CPU assign cpu limits of each node,match option --cpu
+func (b *TrainJobBuilder) CPU(cpu string) *TrainJobBuilder {
+	if cpu != "" {
+		b.args.Cpu = cpu
+	}
+	return b
+}
+
+// Memory assign memory limits of each node,match option --memory
+func (b *TrainJobBuilder) Memory(memory string) *TrainJobBuilder {
+	if memory != "" {
+		b.args.Memory = memory
+	}
+	return b
+}
+
+// NumProcPerNode is used to set the number of processes per node,match option --nproc-per-node
+func (b *TrainJobBuilder) NumProcPerNode(nproc string) *TrainJobBuilder {
+	if nproc != "" {
+		b.args.NumProcPerNode = nproc
+	}
+	return b
+}
+
+// DatasetURI is used to set the storage uri of dataset,match option --dataset-uri
+func (b *TrainJobBuilder) DatasetURI(uri string) *TrainJobBuilder {
+	if uri != "" {
+		b.args.DatasetURI = uri
+	}
+	return b
+}
+
+// ModelURI is used to set the storage uri of model,match option --model-uri
+func (b *TrainJobBuilder) ModelURI(uri string) *TrainJobBuilder {
+	if uri != "" {
+		b.args.ModelURI = uri
+	}
+	return b
+}
+
+// DryRun is used to only render or validate the job rather than submit it,match option --dry-run
+func (b *TrainJobBuilder) DryRun(strategy types.DryRunStrategy) *TrainJobBuilder {
+	if strategy != "" {
+		b.args.DryRun = strategy
+	}
+	return b
+}
+
+// Build is used to build the job
+func (b *TrainJobBuilder) Build() (*Job, error) {
+	for key, value := range b.argValues {
+		b.AddArgValue(key, value)
+	}
+	if err := b.PreBuild(); err != nil {
+		return nil, err
+	}
+	if err := b.ArgsBuilder.Build(); err != nil {
+		return nil, err
+	}
+	return NewJob(b.args.Name, types.TrainJob, b.args), nil
+}
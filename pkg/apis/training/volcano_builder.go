@@ -148,6 +148,14 @@ func (b *VolcanoJobBuilder) Annotations(annotations map[string]string) *VolcanoJ
 	return b
 }
 
+// DryRun is used to only render or validate the job rather than submit it,match option --dry-run
+func (b *VolcanoJobBuilder) DryRun(strategy types.DryRunStrategy) *VolcanoJobBuilder {
+	if strategy != "" {
+		b.args.DryRun = strategy
+	}
+	return b
+}
+
 // Build is used to build the job
 func (b *VolcanoJobBuilder) Build() (*Job, error) {
 	for key, value := range b.argValues {
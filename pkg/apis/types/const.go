@@ -45,4 +45,11 @@ const (
 	UserNameIdLabel           = "arena.kubeflow.org/uid"
 	UserNameNameLabel         = "arena.kubeflow.org/username"
 	SSHSecretName             = "arena.kubeflow.org/ssh-secret"
+	// ExportLogsToAnnotation stores the destination which the logs of training job are exported to when it finishes
+	ExportLogsToAnnotation = "arena.kubeflow.org/export-logs-to"
+)
+
+const (
+	// KueueQueueNameLabel submits the job to the kueue LocalQueue given by the label value
+	KueueQueueNameLabel = "kueue.x-k8s.io/queue-name"
 )
@@ -65,6 +65,8 @@ type EvaluateJobArgs struct {
 
 	// HelmOptions stores the helm options
 	HelmOptions []string `yaml:"-"`
+	// DryRun is the strategy of dry run,match option --dry-run
+	DryRun DryRunStrategy `yaml:"-"`
 
 	ModelName string `yaml:"modelName"` // --model-name
 
@@ -44,4 +44,12 @@ type LogArgs struct {
 	RetryCnt      int
 	RetryTimeout  time.Duration
 	WriterCloser  io.WriteCloser
+	// AllInstances streams the logs of all the instances of the job with prefixes
+	AllInstances bool
+	// AllContainers streams the logs of all the containers of each instance
+	AllContainers bool
+	// Role only streams the logs of the instances with the role, like master or worker
+	Role string
+	// Grep only prints the lines which match the regular expression
+	Grep string
 }
@@ -0,0 +1,80 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+// ManifestAPIVersion is the only supported apiVersion of job manifests
+const ManifestAPIVersion = "arena.kubeflow.org/v1alpha1"
+
+// JobManifest describes a training or serving job declaratively,
+// it is consumed by 'arena apply' and produced by 'arena export'
+type JobManifest struct {
+	// APIVersion must be ManifestAPIVersion
+	APIVersion string `json:"apiVersion" yaml:"apiVersion"`
+	// Kind is the job kind, like PyTorchJob or KServe
+	Kind string `json:"kind" yaml:"kind"`
+	// Metadata stores the identity of the job
+	Metadata JobManifestMetadata `json:"metadata" yaml:"metadata"`
+	// Spec maps the options of 'arena submit' or 'arena serve' (without the leading "--") to their values,
+	// the key "command" stores the job command
+	Spec map[string]interface{} `json:"spec" yaml:"spec"`
+}
+
+// JobManifestMetadata stores the identity of the job
+type JobManifestMetadata struct {
+	// Name is the job name,match option --name
+	Name string `json:"name" yaml:"name"`
+	// Namespace is the job namespace,match option --namespace
+	Namespace string `json:"namespace,omitempty" yaml:"namespace,omitempty"`
+	// Version is the serving version,match option --version
+	Version string `json:"version,omitempty" yaml:"version,omitempty"`
+}
+
+// ManifestTrainingKinds maps manifest kinds to training job types
+var ManifestTrainingKinds = map[string]TrainingJobType{
+	"TFJob":        TFTrainingJob,
+	"PyTorchJob":   PytorchTrainingJob,
+	"MPIJob":       MPITrainingJob,
+	"HorovodJob":   HorovodTrainingJob,
+	"VolcanoJob":   VolcanoTrainingJob,
+	"ETJob":        ETTrainingJob,
+	"SparkJob":     SparkTrainingJob,
+	"DeepSpeedJob": DeepSpeedTrainingJob,
+	"RayJob":       RayJob,
+	"TrainJob":     TrainJob,
+	"JobSet":       JobSetTrainingJob,
+}
+
+// ManifestServingKinds maps manifest kinds to serving job types
+var ManifestServingKinds = map[string]ServingJobType{
+	"TFServing":          TFServingJob,
+	"KFServing":          KFServingJob,
+	"KServe":             KServeJob,
+	"SeldonServing":      SeldonServingJob,
+	"TritonServing":      TritonServingJob,
+	"CustomServing":      CustomServingJob,
+	"DistributedServing": DistributedServingJob,
+	"VLLMServing":        VLLMServingJob,
+	"SGLangServing":      SGLangServingJob,
+}
+
+// ManifestDiffItem describes an option whose value differs between a manifest and the running job
+type ManifestDiffItem struct {
+	// Key is the option name
+	Key string `json:"key" yaml:"key"`
+	// Live is the value of the running job,empty if not set
+	Live string `json:"live" yaml:"live"`
+	// Desired is the value in manifest,empty if not set
+	Desired string `json:"desired" yaml:"desired"`
+}
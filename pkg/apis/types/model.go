@@ -190,6 +190,8 @@ type CommonModelArgs struct {
 	Type ModelJobType `yaml:"type"`
 	// HelmOptions stores the helm options
 	HelmOptions []string `yaml:"-"`
+	// DryRun is the strategy of dry run,match option --dry-run
+	DryRun DryRunStrategy `yaml:"-"`
 }
 
 type ModelProfileArgs struct {
@@ -0,0 +1,89 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "errors"
+
+var ErrQueueNotFound = errors.New("queue not found,please use 'arena queue list' to make sure queue is existed")
+
+// QueueWorkloadStatus defines the status of a workload in kueue
+type QueueWorkloadStatus string
+
+const (
+	// QueueWorkloadPending means the workload is waiting for quota
+	QueueWorkloadPending QueueWorkloadStatus = "PENDING"
+	// QueueWorkloadQuotaReserved means the workload has reserved quota but the admission checks are not satisfied
+	QueueWorkloadQuotaReserved QueueWorkloadStatus = "QUOTARESERVED"
+	// QueueWorkloadAdmitted means the workload is admitted and its pods can be created
+	QueueWorkloadAdmitted QueueWorkloadStatus = "ADMITTED"
+	// QueueWorkloadFinished means the job of workload is finished
+	QueueWorkloadFinished QueueWorkloadStatus = "FINISHED"
+)
+
+// QueueInfo stores the information of a kueue LocalQueue
+type QueueInfo struct {
+	// The name of the LocalQueue
+	Name string `json:"name" yaml:"name"`
+	// The namespace of the LocalQueue
+	Namespace string `json:"namespace" yaml:"namespace"`
+	// The ClusterQueue which backs the LocalQueue
+	ClusterQueue string `json:"clusterQueue" yaml:"clusterQueue"`
+	// The queueing strategy of the ClusterQueue, StrictFIFO or BestEffortFIFO
+	QueueingStrategy string `json:"queueingStrategy" yaml:"queueingStrategy"`
+	// The count of workloads which are waiting for quota
+	PendingWorkloads int32 `json:"pendingWorkloads" yaml:"pendingWorkloads"`
+	// The count of workloads which are admitted and not finished
+	AdmittedWorkloads int32 `json:"admittedWorkloads" yaml:"admittedWorkloads"`
+	// The quota usage of the queue
+	Resources []QueueResourceInfo `json:"resources" yaml:"resources"`
+	// The unfinished workloads of the queue, it is only filled when getting a queue
+	Workloads []QueueWorkloadInfo `json:"workloads,omitempty" yaml:"workloads,omitempty"`
+}
+
+// QueueResourceInfo stores the quota usage of a resource flavor
+type QueueResourceInfo struct {
+	// The resource flavor
+	Flavor string `json:"flavor" yaml:"flavor"`
+	// The resource name, like cpu or nvidia.com/gpu
+	Resource string `json:"resource" yaml:"resource"`
+	// The quota used by the workloads of the LocalQueue
+	Used string `json:"used" yaml:"used"`
+	// The quota used by the workloads of the ClusterQueue
+	ClusterQueueUsed string `json:"clusterQueueUsed" yaml:"clusterQueueUsed"`
+	// The nominal quota of the ClusterQueue
+	NominalQuota string `json:"nominalQuota" yaml:"nominalQuota"`
+}
+
+// QueueWorkloadInfo stores the information of a kueue workload
+type QueueWorkloadInfo struct {
+	// The name of the workload
+	Name string `json:"name" yaml:"name"`
+	// The name of the job which owns the workload
+	JobName string `json:"jobName" yaml:"jobName"`
+	// The kind of the job which owns the workload, like PyTorchJob
+	JobKind string `json:"jobKind" yaml:"jobKind"`
+	// The status of the workload
+	Status QueueWorkloadStatus `json:"status" yaml:"status"`
+	// The priority of the workload
+	Priority int32 `json:"priority" yaml:"priority"`
+	// The position of a pending workload in the LocalQueue, starting from 0
+	PositionInLocalQueue *int32 `json:"positionInLocalQueue,omitempty" yaml:"positionInLocalQueue,omitempty"`
+	// The position of a pending workload in the ClusterQueue, starting from 0
+	PositionInClusterQueue *int32 `json:"positionInClusterQueue,omitempty" yaml:"positionInClusterQueue,omitempty"`
+	// The reason why the workload is not admitted
+	Message string `json:"message,omitempty" yaml:"message,omitempty"`
+	// CreationTimestamp stores the creation timestamp of workload
+	CreationTimestamp int64 `json:"creationTimestamp" yaml:"creationTimestamp"`
+}
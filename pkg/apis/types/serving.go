@@ -35,6 +35,10 @@ const (
 	CustomServingJob ServingJobType = "custom-serving"
 	// DistributedServingJob defines the distributed serving job
 	DistributedServingJob ServingJobType = "distributed-serving"
+	// VLLMServingJob defines the vllm serving job
+	VLLMServingJob ServingJobType = "vllm-serving"
+	// SGLangServingJob defines the sglang serving job
+	SGLangServingJob ServingJobType = "sglang-serving"
 	// AllServingJob represents all serving job type
 	AllServingJob ServingJobType = ""
 	// UnknownServingJob defines the unknown serving job
@@ -89,6 +93,16 @@ var ServingTypeMap = map[ServingJobType]ServingTypeInfo{
 		Alias:     "Distributed",
 		Shorthand: "distributed",
 	},
+	VLLMServingJob: {
+		Name:      VLLMServingJob,
+		Alias:     "vLLM",
+		Shorthand: "vllm",
+	},
+	SGLangServingJob: {
+		Name:      SGLangServingJob,
+		Alias:     "SGLang",
+		Shorthand: "sglang",
+	},
 }
 
 // ServingJobInfo display serving job information
@@ -125,6 +139,12 @@ type ServingJobInfo struct {
 	RequestGPUCore int `json:"requestGPUCore" yaml:"requestGPUCore"`
 	// CreationTimestamp stores the creation timestamp of job
 	CreationTimestamp int64 `json:"creationTimestamp" yaml:"creationTimestamp"`
+	// Autoscaler specifies the autoscaler created by --max-replicas
+	Autoscaler *ServingAutoscalerInfo `json:"autoscaler,omitempty" yaml:"autoscaler,omitempty"`
+	// OpenAIBaseURL specifies the OpenAI-compatible base url,only for vllm and sglang serving
+	OpenAIBaseURL string `json:"openaiBaseURL,omitempty" yaml:"openaiBaseURL,omitempty"`
+	// Stage specifies whether the version is stable or candidate after promotion
+	Stage ServingStage `json:"stage,omitempty" yaml:"stage,omitempty"`
 }
 
 type Endpoint struct {
@@ -204,6 +224,8 @@ type CommonServingArgs struct {
 	ConfigFiles map[string]map[string]ConfigFileInfo `yaml:"configFiles"`
 	// HelmOptions stores the helm options
 	HelmOptions []string `yaml:"-"`
+	// DryRun is the strategy of dry run,match option --dry-run
+	DryRun DryRunStrategy `yaml:"-"`
 
 	ModelServiceExists bool `yaml:"modelServiceExists"` // --modelServiceExists
 
@@ -226,6 +248,7 @@ type CustomServingArgs struct {
 	StartupProbeAction         string   `yaml:"startupProbeAction"`         // --startup-probe-action
 	StartupProbeActionOption   []string `yaml:"startupProbeActionOption"`   // --startup-probe-action-option
 	StartupProbeOption         []string `yaml:"startupProbeOption"`         // --startup-probe-option
+	ServingAutoscaleArgs       `yaml:",inline"`
 	CommonServingArgs          `yaml:",inline"`
 }
 
@@ -236,6 +259,7 @@ type TensorFlowServingArgs struct {
 	ModelPath            string `yaml:"modelPath"`            // --model-path
 	Port                 int    `yaml:"port"`                 // --port
 	RestfulPort          int    `yaml:"restApiPort"`          // --restful-port
+	ServingAutoscaleArgs `yaml:",inline"`
 	CommonServingArgs    `yaml:",inline"`
 }
 
@@ -283,15 +307,16 @@ type SeldonServingArgs struct {
 }
 
 type TritonServingArgs struct {
-	Backend           string   `yaml:"backend"`         // --backend
-	ModelRepository   string   `yaml:"modelRepository"` // --model-repository
-	MetricsPort       int      `yaml:"metricsPort"`     // --metrics-port
-	HttpPort          int      `yaml:"httpPort"`        // --http-port
-	GrpcPort          int      `yaml:"grpcPort"`        // --grpc-port
-	AllowMetrics      bool     `yaml:"allowMetrics"`    // --allow-metrics
-	LoadModels        []string `yaml:"loadModels"`      // --load-model
-	ExtendCommand     string   `yaml:"extendCommand"`   // --extend-command
-	CommonServingArgs `yaml:",inline"`
+	Backend              string   `yaml:"backend"`         // --backend
+	ModelRepository      string   `yaml:"modelRepository"` // --model-repository
+	MetricsPort          int      `yaml:"metricsPort"`     // --metrics-port
+	HttpPort             int      `yaml:"httpPort"`        // --http-port
+	GrpcPort             int      `yaml:"grpcPort"`        // --grpc-port
+	AllowMetrics         bool     `yaml:"allowMetrics"`    // --allow-metrics
+	LoadModels           []string `yaml:"loadModels"`      // --load-model
+	ExtendCommand        string   `yaml:"extendCommand"`   // --extend-command
+	ServingAutoscaleArgs `yaml:",inline"`
+	CommonServingArgs    `yaml:",inline"`
 }
 
 type DistributedServingArgs struct {
@@ -313,6 +338,20 @@ type DistributedServingArgs struct {
 	CustomServingArgs `yaml:",inline"`
 }
 
+// LLMServingArgs is shared by vllm and sglang serving, the container command
+// is generated from the model options unless --command is given
+type LLMServingArgs struct {
+	ServingType          ServingJobType `yaml:"servingType"`          // set by the job type
+	ModelPath            string         `yaml:"modelPath"`            // --model-path
+	ServedModelName      string         `yaml:"servedModelName"`      // --served-model-name
+	TensorParallelSize   int            `yaml:"tensorParallelSize"`   // --tensor-parallel-size
+	PipelineParallelSize int            `yaml:"pipelineParallelSize"` // --pipeline-parallel-size
+	MaxModelLen          int            `yaml:"maxModelLen"`          // --max-model-len
+	Quantization         string         `yaml:"quantization"`         // --quantization
+	ExtraArgs            []string       `yaml:"-"`                    // the command args
+	CustomServingArgs    `yaml:",inline"`
+}
+
 type ModelFormat struct {
 	// Name of the model format.
 	// +required
@@ -0,0 +1,56 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+// ServingScaleMetric defines the metric watched by the autoscaler of serving job
+type ServingScaleMetric string
+
+const (
+	// CPUScaleMetric scales by the average cpu utilization with HorizontalPodAutoscaler
+	CPUScaleMetric ServingScaleMetric = "cpu"
+	// MemoryScaleMetric scales by the average memory utilization with HorizontalPodAutoscaler
+	MemoryScaleMetric ServingScaleMetric = "memory"
+	// GPUUtilScaleMetric scales by the average gpu utilization with KEDA ScaledObject
+	GPUUtilScaleMetric ServingScaleMetric = "gpu-util"
+	// QPSScaleMetric scales by the requests per second of each replica with KEDA ScaledObject
+	QPSScaleMetric ServingScaleMetric = "qps"
+)
+
+// ServingAutoscaleArgs configures the autoscaler owned by the serving deployment,
+// autoscaling is enabled when MaxReplicas is greater than 0
+type ServingAutoscaleArgs struct {
+	MinReplicas int                `yaml:"minReplicas"` // --min-replicas
+	MaxReplicas int                `yaml:"maxReplicas"` // --max-replicas
+	ScaleMetric ServingScaleMetric `yaml:"scaleMetric"` // --scale-metric
+	ScaleTarget int                `yaml:"scaleTarget"` // --scale-target
+}
+
+// ServingAutoscalerInfo displays the autoscaler of serving job
+type ServingAutoscalerInfo struct {
+	// Kind is HorizontalPodAutoscaler or ScaledObject
+	Kind string `json:"kind" yaml:"kind"`
+	// Name is the name of autoscaler
+	Name string `json:"name" yaml:"name"`
+	// MinReplicas is the minimum replicas
+	MinReplicas int `json:"minReplicas" yaml:"minReplicas"`
+	// MaxReplicas is the maximum replicas
+	MaxReplicas int `json:"maxReplicas" yaml:"maxReplicas"`
+	// ScaleMetric is the metric watched by autoscaler
+	ScaleMetric string `json:"scaleMetric" yaml:"scaleMetric"`
+	// ScaleTarget is the target value of the metric
+	ScaleTarget int `json:"scaleTarget" yaml:"scaleTarget"`
+	// CurrentReplicas is the replicas observed by autoscaler
+	CurrentReplicas int `json:"currentReplicas" yaml:"currentReplicas"`
+}
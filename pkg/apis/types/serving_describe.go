@@ -0,0 +1,95 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "time"
+
+// ServingJobDescription is the diagnosis report of a serving job, it explains why the instances are unavailable
+type ServingJobDescription struct {
+	// Name is the serving name
+	Name string `json:"name" yaml:"name"`
+	// Namespace is the namespace of serving job
+	Namespace string `json:"namespace" yaml:"namespace"`
+	// Type is the serving type
+	Type string `json:"type" yaml:"type"`
+	// Version is the serving version
+	Version string `json:"version" yaml:"version"`
+	// Desired is the desired instances
+	Desired int `json:"desiredInstances" yaml:"desiredInstances"`
+	// Available is the available instances
+	Available int `json:"availableInstances" yaml:"availableInstances"`
+	// Conditions are the conditions of the deployment, LeaderWorkerSet or InferenceService
+	Conditions []ServingCondition `json:"conditions" yaml:"conditions"`
+	// Problems are the diagnosed reasons of unavailable instances
+	Problems []ServingProblem `json:"problems" yaml:"problems"`
+	// Events are the kubernetes events of the serving job and its instances
+	Events []ServingEvent `json:"events" yaml:"events"`
+}
+
+// ServingCondition is a condition of the serving workload
+type ServingCondition struct {
+	// Object is the kind and name of the object, like deployment/mnist-v1-tensorflow-serving
+	Object string `json:"object" yaml:"object"`
+	// Type is the condition type
+	Type string `json:"type" yaml:"type"`
+	// Status is the condition status, True, False or Unknown
+	Status string `json:"status" yaml:"status"`
+	// Reason is the reason of the condition
+	Reason string `json:"reason,omitempty" yaml:"reason,omitempty"`
+	// Message is the message of the condition
+	Message string `json:"message,omitempty" yaml:"message,omitempty"`
+}
+
+// ServingProblemReason is the diagnosed reason of unavailable instances
+type ServingProblemReason string
+
+const (
+	InsufficientGPUProblem       ServingProblemReason = "InsufficientGPU"
+	InsufficientGPUMemoryProblem ServingProblemReason = "InsufficientGPUMemory"
+	UntoleratedTaintProblem      ServingProblemReason = "UntoleratedTaint"
+	UnschedulableProblem         ServingProblemReason = "Unschedulable"
+	ImagePullProblem             ServingProblemReason = "ImagePullFailed"
+	CrashLoopBackOffProblem      ServingProblemReason = "CrashLoopBackOff"
+	OOMKilledProblem             ServingProblemReason = "OOMKilled"
+	ContainerConfigProblem       ServingProblemReason = "ContainerConfigError"
+	ProbeFailedProblem           ServingProblemReason = "ProbeFailed"
+	WorkloadNotReadyProblem      ServingProblemReason = "NotReady"
+)
+
+// ServingProblem is a diagnosed reason shared by one or more objects
+type ServingProblem struct {
+	// Reason is the diagnosed reason
+	Reason ServingProblemReason `json:"reason" yaml:"reason"`
+	// Message is the evidence of the reason, like the message of scheduler or kubelet
+	Message string `json:"message" yaml:"message"`
+	// Objects are the instances or the workload which have the problem
+	Objects []string `json:"objects" yaml:"objects"`
+}
+
+// ServingEvent is a kubernetes event of the serving job
+type ServingEvent struct {
+	// Object is the kind and name of the object, like pod/mnist-v1-tensorflow-serving-7d9f-x2x4k
+	Object string `json:"object" yaml:"object"`
+	// Type is the event type, Normal or Warning
+	Type string `json:"type" yaml:"type"`
+	// Reason is the reason of event
+	Reason string `json:"reason" yaml:"reason"`
+	// Message is the message of event
+	Message string `json:"message" yaml:"message"`
+	// Count is the times the event occurred
+	Count int32 `json:"count" yaml:"count"`
+	// LastSeen is the last time the event occurred
+	LastSeen time.Time `json:"lastSeen" yaml:"lastSeen"`
+}
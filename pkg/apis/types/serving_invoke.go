@@ -0,0 +1,57 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "time"
+
+// ServingInvokeArgs describes a request sent to the inference api of serving job
+type ServingInvokeArgs struct {
+	// Name is the serving name
+	Name string
+	// Version is the serving version,it is required if the serving job has multiple versions
+	Version string
+	// Type is the serving type
+	Type ServingJobType
+	// Data is the request body, the request is sent with GET if it is empty
+	Data []byte
+	// ModelName is the model name in the request path,default is the serving name
+	ModelName string
+	// Path replaces the request path inferred by the serving type
+	Path string
+	// Protocol is the inference protocol of kserve, v1 or v2
+	Protocol string
+	// GRPC sends the request to the gRPC predict api of tf-serving or triton,
+	// the request and response are the protobuf messages in json
+	GRPC bool
+	// PortForward sends the request through a port forwarding to the serving pod,
+	// otherwise the request is sent to the address of serving job
+	PortForward bool
+	// Timeout is the timeout of the request
+	Timeout time.Duration
+}
+
+// ServingInvokeResult is the response of the inference api
+type ServingInvokeResult struct {
+	// URL is the request url
+	URL string `json:"url" yaml:"url"`
+	// StatusCode is the http status code
+	StatusCode int `json:"statusCode" yaml:"statusCode"`
+	// GRPCCode is the status code of the gRPC api, it is set instead of StatusCode
+	GRPCCode string `json:"grpcCode,omitempty" yaml:"grpcCode,omitempty"`
+	// Latency is the time from sending the request to reading the whole response
+	Latency time.Duration `json:"latency" yaml:"latency"`
+	// Body is the response body
+	Body []byte `json:"-" yaml:"-"`
+}
@@ -0,0 +1,74 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "time"
+
+// ServingLoadTestArgs describes a load test against the versions of serving job
+type ServingLoadTestArgs struct {
+	// Name is the serving name
+	Name string
+	// Versions are the serving versions to test one by one with the same load,
+	// it can be empty if the serving job has only one version
+	Versions []string
+	// Type is the serving type
+	Type ServingJobType
+	// Data is the request body, the requests are sent with GET if it is empty
+	Data []byte
+	// ModelName is the model name in the request path,default is the serving name
+	ModelName string
+	// Path replaces the request path inferred by the serving type
+	Path string
+	// QPS is the target requests per second of all connections, 0 means as fast as possible
+	QPS int
+	// Concurrency is the number of parallel connections
+	Concurrency int
+	// Duration is the duration of each load test
+	Duration time.Duration
+	// Image is the image of load generator
+	Image string
+	// ImagePullSecrets are the secrets to pull the image of load generator
+	ImagePullSecrets []string
+}
+
+// ServingLoadTestResult is the report of a load test against a serving version
+type ServingLoadTestResult struct {
+	// Name is the serving name
+	Name string `json:"name" yaml:"name"`
+	// Version is the serving version
+	Version string `json:"version" yaml:"version"`
+	// URL is the request url
+	URL string `json:"url" yaml:"url"`
+	// QPS is the target requests per second, 0 means as fast as possible
+	QPS int `json:"qps" yaml:"qps"`
+	// Concurrency is the number of parallel connections
+	Concurrency int `json:"concurrency" yaml:"concurrency"`
+	// Duration is the actual duration of load test
+	Duration time.Duration `json:"duration" yaml:"duration"`
+	// Requests is the number of sent requests
+	Requests int64 `json:"requests" yaml:"requests"`
+	// Errors is the number of requests failed or responded with a non 2xx status code
+	Errors int64 `json:"errors" yaml:"errors"`
+	// ErrorRate is Errors divided by Requests
+	ErrorRate float64 `json:"errorRate" yaml:"errorRate"`
+	// Throughput is the actual requests per second
+	Throughput float64 `json:"throughput" yaml:"throughput"`
+	// P50Latency is the 50th percentile latency in milliseconds
+	P50Latency float64 `json:"p50LatencyMs" yaml:"p50LatencyMs"`
+	// P90Latency is the 90th percentile latency in milliseconds
+	P90Latency float64 `json:"p90LatencyMs" yaml:"p90LatencyMs"`
+	// P99Latency is the 99th percentile latency in milliseconds
+	P99Latency float64 `json:"p99LatencyMs" yaml:"p99LatencyMs"`
+}
@@ -0,0 +1,53 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+// ServingModelArgs describes a model registered into a shared model server
+type ServingModelArgs struct {
+	// Server is the name of model server, it is a triton serving job or a ServingRuntime of KServe ModelMesh
+	Server string
+	// ServerVersion is the version of triton serving job
+	ServerVersion string
+	// ServerType is the type of model server, TritonServingJob or KServeJob
+	ServerType ServingJobType
+	// Name is the model name
+	Name string
+	// ModelPath is the path of model files in the triton container, or the storage uri of ModelMesh model
+	ModelPath string
+	// ModelVersion is the version directory of model in the triton model repository
+	ModelVersion int
+	// Platform is the platform or backend of triton model, like onnxruntime_onnx
+	Platform string
+	// MaxBatchSize is the max_batch_size of triton model config
+	MaxBatchSize int
+	// Config is the content of config.pbtxt, it replaces the generated config of triton model
+	Config []byte
+	// ModelFormat is the model format of ModelMesh model, like onnx or sklearn
+	ModelFormat string
+}
+
+// ServingModelInfo is the state of a model in the model server
+type ServingModelInfo struct {
+	// Name is the model name
+	Name string `json:"name" yaml:"name"`
+	// Version is the model version
+	Version string `json:"version,omitempty" yaml:"version,omitempty"`
+	// Server is the name of model server
+	Server string `json:"server" yaml:"server"`
+	// State is the state reported by the model server, like READY or Loaded
+	State string `json:"state" yaml:"state"`
+	// Reason is the reason of the state, like the error of loading
+	Reason string `json:"reason,omitempty" yaml:"reason,omitempty"`
+}
@@ -0,0 +1,59 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "time"
+
+// ServingStage is the stage of a serving version in blue/green promotion
+type ServingStage string
+
+const (
+	// StableServingStage is the promoted version which receives all traffic
+	StableServingStage ServingStage = "stable"
+	// CandidateServingStage is the version which is not promoted while another version is stable
+	CandidateServingStage ServingStage = "candidate"
+)
+
+// OldServingVersionPolicy defines what to do with the old versions after a version is promoted
+type OldServingVersionPolicy string
+
+const (
+	// KeepOldServingVersions keeps the old versions as they are
+	KeepOldServingVersions OldServingVersionPolicy = "keep"
+	// ScaleDownOldServingVersions scales the old versions to 0 instance
+	ScaleDownOldServingVersions OldServingVersionPolicy = "scale-down"
+	// DeleteOldServingVersions deletes the old versions
+	DeleteOldServingVersions OldServingVersionPolicy = "delete"
+)
+
+// ServingPromoteArgs defines the args of promoting a version of serving job to stable
+type ServingPromoteArgs struct {
+	// ServingName is the name of serving job
+	ServingName string `yaml:"servingName,omitempty"`
+	// Namespace is the namespace of serving job
+	Namespace string `yaml:"namespace,omitempty"`
+	// Type is the type of serving job, all types are searched if it is empty
+	Type ServingJobType `yaml:"type,omitempty"`
+	// Version is the version to promote
+	Version string `yaml:"version,omitempty"`
+	// OldVersions is what to do with the other versions after the promotion
+	OldVersions OldServingVersionPolicy `yaml:"oldVersions,omitempty"`
+	// GracePeriod is the time to wait before scaling down or deleting the old versions
+	GracePeriod time.Duration `yaml:"gracePeriod,omitempty"`
+	// ReadinessTimeout is the max time to wait for all instances of the version to be available
+	ReadinessTimeout time.Duration `yaml:"readinessTimeout,omitempty"`
+	// TrafficRouterOptions selects the traffic router which shifts the traffic
+	TrafficRouterOptions `yaml:",inline"`
+}
@@ -0,0 +1,50 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "time"
+
+// ServingRevision is a recorded spec of serving job, a new revision is recorded
+// when the serving job is updated or rolled back
+type ServingRevision struct {
+	// Revision is the number of revision, it starts from 1 which is the submitted spec
+	Revision int `json:"revision" yaml:"revision"`
+	// Image is the image of the serving container
+	Image string `json:"image,omitempty" yaml:"image,omitempty"`
+	// ModelPath is the model path in the arguments of the serving container
+	ModelPath string `json:"modelPath,omitempty" yaml:"modelPath,omitempty"`
+	// Envs are the environment variables of the serving container
+	Envs map[string]string `json:"envs,omitempty" yaml:"envs,omitempty"`
+	// Resources are the resource limits of the serving container
+	Resources map[string]string `json:"resources,omitempty" yaml:"resources,omitempty"`
+	// ChangedBy is the arena user who changed the serving job
+	ChangedBy string `json:"changedBy,omitempty" yaml:"changedBy,omitempty"`
+	// ChangedAt is the time when the serving job was changed
+	ChangedAt time.Time `json:"changedAt" yaml:"changedAt"`
+	// Cause is the reason of the change, like submit, update or rollback
+	Cause string `json:"cause,omitempty" yaml:"cause,omitempty"`
+}
+
+// ServingRollbackArgs describes which revision the serving job is rolled back to
+type ServingRollbackArgs struct {
+	// Name is the serving name
+	Name string
+	// Version is the serving version
+	Version string
+	// Type is the serving type
+	Type ServingJobType
+	// ToRevision is the revision to roll back to, 0 means the previous revision
+	ToRevision int
+}
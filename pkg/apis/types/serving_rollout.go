@@ -0,0 +1,52 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "time"
+
+// ServingRolloutArgs defines the args of progressive canary rollout between two versions of a serving job
+type ServingRolloutArgs struct {
+	// ServingName is the name of serving job
+	ServingName string `yaml:"servingName,omitempty"`
+	// Namespace is the namespace of serving job
+	Namespace string `yaml:"namespace,omitempty"`
+	// Type is the type of serving job, all types are searched if it is empty
+	Type ServingJobType `yaml:"type,omitempty"`
+	// FromVersion is the version which the traffic is shifted from
+	FromVersion string `yaml:"from,omitempty"`
+	// ToVersion is the version which the traffic is shifted to
+	ToVersion string `yaml:"to,omitempty"`
+	// Steps are the traffic percentages of ToVersion in order, like [10, 25, 50, 100]
+	Steps []int `yaml:"steps,omitempty"`
+	// Interval is the time to observe ToVersion after each step before the next one
+	Interval time.Duration `yaml:"interval,omitempty"`
+	// ReadinessTimeout is the max time to wait for all instances of ToVersion to be available
+	ReadinessTimeout time.Duration `yaml:"readinessTimeout,omitempty"`
+	// Analysis stores the prometheus queries checked after each step
+	Analysis []ServingRolloutAnalysis `yaml:"analysis,omitempty"`
+	// TrafficRouterOptions selects the traffic router which shifts the traffic
+	TrafficRouterOptions `yaml:",inline"`
+}
+
+// ServingRolloutAnalysis defines a prometheus query whose result must not exceed the threshold,
+// the query can refer to {{.Namespace}}, {{.Name}} and {{.Version}} of ToVersion
+type ServingRolloutAnalysis struct {
+	// Name is the name of analysis, like "error-rate"
+	Name string `yaml:"name"`
+	// Query is the prometheus query which returns a single value
+	Query string `yaml:"query"`
+	// Threshold is the max value of the query result
+	Threshold float64 `yaml:"threshold"`
+}
@@ -82,6 +82,15 @@ type CommonSubmitArgs struct {
 	// EnableQueue enables the feature to queue jobs after they are scheduled.
 	EnableQueue bool `yaml:"enableQueue"`
 
+	// QueueName is the kueue LocalQueue which the job is submitted to,match option --queue-name
+	QueueName string `yaml:"queueName"`
+
+	// ExportLogsTo is the absolute path or s3://bucket/prefix which the logs are exported to on arena wait or prune,match option --export-logs-to
+	ExportLogsTo string `yaml:"exportLogsTo"`
+
+	// Template is the job template whose options are used when they are not given,match option --template
+	Template string `yaml:"template"`
+
 	// UseENI defines using eni or not
 	UseENI bool `yaml:"useENI"`
 
@@ -91,6 +100,9 @@ type CommonSubmitArgs struct {
 	// Labels specify the job labels and it is work for pods
 	Labels map[string]string `yaml:"labels"`
 
+	// JobLabels specify the labels which are only set on the job object, not on its pods
+	JobLabels map[string]string `yaml:"jobLabels"`
+
 	// IsNonRoot is root user or not
 	IsNonRoot bool `yaml:"isNonRoot"`
 
@@ -114,6 +126,8 @@ type CommonSubmitArgs struct {
 
 	// HelmOptions stores the helm options
 	HelmOptions []string `yaml:"-"`
+	// DryRun is the strategy of dry run,match option --dry-run
+	DryRun DryRunStrategy `yaml:"-"`
 
 	// EnableSpotInstance enables the feature of SuperVisor manage spot instance training.
 	EnableSpotInstance bool `yaml:"enableSpotInstance"`
@@ -55,6 +55,8 @@ type ScaleETJobArgs struct {
 	Script string `yaml:"script"`
 	//-e, --env stringArray      the environment variables
 	Envs map[string]string `yaml:"envs"`
+	// DryRun is the strategy of dry run,match option --dry-run
+	DryRun DryRunStrategy `yaml:"-"`
 }
 
 type ScaleInETJobArgs struct {
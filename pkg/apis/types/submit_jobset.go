@@ -0,0 +1,45 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+type SubmitJobSetArgs struct {
+	// for common args
+	CommonSubmitArgs `yaml:",inline"`
+
+	// the default cpu and memory of the replicated jobs
+	Cpu    string `yaml:"cpu"`    // --cpu
+	Memory string `yaml:"memory"` // --memory
+
+	// ShareMemory Specifies the shared memory size
+	ShareMemory string `yaml:"shareMemory"` // --share-memory
+
+	// ReplicatedJobs are the groups of jobs in the jobset, the first one is the chief
+	ReplicatedJobs []JobSetReplicatedJob `yaml:"replicatedJobs"` // --replicated-job
+}
+
+// JobSetReplicatedJob describes a group of identical jobs in the jobset,
+// the empty fields are inherited from the common args
+type JobSetReplicatedJob struct {
+	Name    string `yaml:"name"`
+	Image   string `yaml:"image"`
+	Command string `yaml:"command"`
+	// Replicas is the number of jobs created from the replicated job
+	Replicas int `yaml:"replicas"`
+	// Pods is the number of pods of each job
+	Pods     int    `yaml:"pods"`
+	GPUCount int    `yaml:"gpuCount"`
+	Cpu      string `yaml:"cpu"`
+	Memory   string `yaml:"memory"`
+}
@@ -0,0 +1,39 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+type SubmitTrainJobArgs struct {
+	// for common args
+	CommonSubmitArgs `yaml:",inline"`
+
+	// Runtime is the name of the TrainingRuntime or ClusterTrainingRuntime
+	Runtime string `yaml:"runtime"` // --runtime
+
+	// RuntimeKind is the kind of the runtime, ClusterTrainingRuntime or TrainingRuntime
+	RuntimeKind string `yaml:"runtimeKind"` // --runtime-kind
+
+	Cpu    string `yaml:"cpu"`    // --cpu
+	Memory string `yaml:"memory"` // --memory
+
+	// NumProcPerNode is the number of processes on every training node,
+	// supported values: [auto, cpu, gpu, int]
+	NumProcPerNode string `yaml:"numProcPerNode,omitempty"` // --nproc-per-node
+
+	// DatasetURI is the storage uri of the dataset initializer, like hf://tatsu-lab/alpaca
+	DatasetURI string `yaml:"datasetUri,omitempty"` // --dataset-uri
+
+	// ModelURI is the storage uri of the model initializer, like hf://meta-llama/Llama-3.2-1B
+	ModelURI string `yaml:"modelUri,omitempty"` // --model-uri
+}
@@ -46,4 +46,7 @@ type SubmitVolcanoJobArgs struct {
 
 	// Labels specify the job labels and it is work for pods
 	Labels map[string]string `yaml:"labels"`
+
+	// DryRun is the strategy of dry run,match option --dry-run
+	DryRun DryRunStrategy `yaml:"-"`
 }
@@ -0,0 +1,37 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "errors"
+
+// ErrJobTemplateNotFound is returned when the job template does not exist
+var ErrJobTemplateNotFound = errors.New("not found the job template")
+
+// JobTemplatesConfigMapName is the name of configmap in the arena namespace which stores the job templates
+const JobTemplatesConfigMapName = "arena-job-templates"
+
+// JobTemplate stores the common options of 'arena submit' which are shared by jobs,
+// the options given when submitting the job override the options of template
+type JobTemplate struct {
+	// Name is the template name
+	Name string `json:"name" yaml:"name"`
+	// Options maps the common options of 'arena submit' (without the leading "--") to their values,
+	// the value is a string or a string list for the options which can be given multiple times
+	Options map[string]interface{} `json:"options" yaml:"options"`
+	// CreatedBy is the user who creates the template
+	CreatedBy string `json:"createdBy,omitempty" yaml:"createdBy,omitempty"`
+	// CreationTimestamp is the time when the template is created
+	CreationTimestamp string `json:"creationTimestamp,omitempty" yaml:"creationTimestamp,omitempty"`
+}
@@ -19,12 +19,32 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// TrafficRouterType defines the backend which routes the traffic to the versions of serving job
+type TrafficRouterType string
+
+const (
+	// IstioTrafficRouter routes the traffic by istio DestinationRule and VirtualService
+	IstioTrafficRouter TrafficRouterType = "istio"
+	// GatewayAPITrafficRouter routes the traffic by Gateway API HTTPRoute
+	GatewayAPITrafficRouter TrafficRouterType = "gateway-api"
+)
+
+// TrafficRouterOptions defines the options of traffic router
+type TrafficRouterOptions struct {
+	// Router is the traffic router type, default is istio
+	Router TrafficRouterType `yaml:"router,omitempty"` //--router
+	// Gateway is the parent gateway of HTTPRoute like "<namespace>/<name>" or "<name>",
+	// only for the gateway-api router
+	Gateway string `yaml:"gateway,omitempty"` //--gateway
+}
+
 type TrafficRouterSplitArgs struct {
-	ServingName    string `yaml:"servingName,omitempty"` //--name
-	Namespace      string `yaml:"namespace,omitempty"`   //--namespace
-	Versions       string `yaml:"versions,omitempty"`    //--versions
-	Weights        string `yaml:"weights,omitempty"`     //--weights
-	VersionWeights []ServingVersionWeight
+	ServingName          string `yaml:"servingName,omitempty"` //--name
+	Namespace            string `yaml:"namespace,omitempty"`   //--namespace
+	Versions             string `yaml:"versions,omitempty"`    //--versions
+	Weights              string `yaml:"weights,omitempty"`     //--weights
+	VersionWeights       []ServingVersionWeight
+	TrafficRouterOptions `yaml:",inline"`
 }
 
 type ServingVersionWeight struct {
@@ -111,3 +131,30 @@ type PortSelector struct {
 	*istiov1alpha3.PortSelector
 	Number uint32 `json:"number,omitempty" protobuf:"varint,1,opt,name=number,proto3,oneof"`
 }
+
+type HTTPRouteCRD struct {
+	Kind              string `json:"kind,omitempty"`
+	APIVersion        string `json:"apiVersion,omitempty"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              HTTPRouteSpec `json:"spec"`
+}
+
+type HTTPRouteSpec struct {
+	ParentRefs []GatewayParentReference `json:"parentRefs,omitempty"`
+	Rules      []HTTPRouteRule          `json:"rules,omitempty"`
+}
+
+type GatewayParentReference struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+}
+
+type HTTPRouteRule struct {
+	BackendRefs []HTTPBackendRef `json:"backendRefs,omitempty"`
+}
+
+type HTTPBackendRef struct {
+	Name   string `json:"name"`
+	Port   int32  `json:"port,omitempty"`
+	Weight int32  `json:"weight"`
+}
@@ -42,6 +42,10 @@ const (
 	UnknownTrainingJob TrainingJobType = "unknown"
 	// RayJob defines the ray job
 	RayJob TrainingJobType = "rayjob"
+	// TrainJob defines the trainjob of kubeflow trainer v2
+	TrainJob TrainingJobType = "trainjob"
+	// JobSetTrainingJob defines the jobset
+	JobSetTrainingJob TrainingJobType = "jobset"
 )
 
 type TrainingJobTypeInfo struct {
@@ -102,6 +106,16 @@ var TrainingTypeMap = map[TrainingJobType]TrainingJobTypeInfo{
 		Alias:     "RayJob",
 		Shorthand: "rj",
 	},
+	TrainJob: {
+		Name:      TrainJob,
+		Alias:     "TrainJob",
+		Shorthand: "tj",
+	},
+	JobSetTrainingJob: {
+		Name:      JobSetTrainingJob,
+		Alias:     "JobSet",
+		Shorthand: "js",
+	},
 }
 
 // TrainingJobInfo stores training job information
@@ -160,8 +174,57 @@ const (
 	TrainingJobSucceeded TrainingJobStatus = "SUCCEEDED"
 	// TrainingJobFailed means the job is failed
 	TrainingJobFailed TrainingJobStatus = "FAILED"
+	// TrainingJobSuspended means the job is suspended and its pods are deleted until it is resumed
+	TrainingJobSuspended TrainingJobStatus = "SUSPENDED"
 )
 
+// TrainingJobWaitCondition defines the condition which the training job is waited for
+type TrainingJobWaitCondition string
+
+const (
+	// WaitForSucceeded waits until the job is succeeded
+	WaitForSucceeded TrainingJobWaitCondition = "succeeded"
+	// WaitForFailed waits until the job is failed
+	WaitForFailed TrainingJobWaitCondition = "failed"
+	// WaitForRunning waits until the job is running
+	WaitForRunning TrainingJobWaitCondition = "running"
+)
+
+// TrainingJobWaitResult is returned when the waited training job meets the condition
+// or reaches a terminal state which can not meet the condition any more
+type TrainingJobWaitResult struct {
+	// The name of the training job
+	Name string `json:"name" yaml:"name"`
+	// The namespace of the training job
+	Namespace string `json:"namespace" yaml:"namespace"`
+	// The training type of the training job
+	Trainer TrainingJobType `json:"trainer" yaml:"trainer"`
+	// The condition which the training job is waited for
+	Condition TrainingJobWaitCondition `json:"condition" yaml:"condition"`
+	// ConditionMet is false if the job reaches a terminal state which can not meet the condition
+	ConditionMet bool `json:"conditionMet" yaml:"conditionMet"`
+	// The final status of the training job
+	Status TrainingJobStatus `json:"status" yaml:"status"`
+	// The exit codes of the terminated containers in chief pod
+	ChiefExitCodes []ContainerExitCode `json:"chiefExitCodes" yaml:"chiefExitCodes"`
+	// The duration of the training job
+	Duration string `json:"duration" yaml:"duration"`
+	// The location of the exported logs if the job is submitted with --export-logs-to
+	LogsExport string `json:"logsExport,omitempty" yaml:"logsExport,omitempty"`
+}
+
+// ContainerExitCode stores the exit code of a terminated container
+type ContainerExitCode struct {
+	// The name of the pod
+	Pod string `json:"pod" yaml:"pod"`
+	// The name of the container
+	Container string `json:"container" yaml:"container"`
+	// The exit code of the container
+	ExitCode int32 `json:"exitCode" yaml:"exitCode"`
+	// The reason of termination, like Completed or OOMKilled
+	Reason string `json:"reason" yaml:"reason"`
+}
+
 // TrainingJobInstance defines the instance of training job
 type TrainingJobInstance struct {
 	// IP defines the instance ip
@@ -0,0 +1,59 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+// TrainingJobFailureReason is the likely cause of the failure of training job
+type TrainingJobFailureReason string
+
+const (
+	OOMKilledFailure             TrainingJobFailureReason = "OOMKilled"
+	CUDAOutOfMemoryFailure       TrainingJobFailureReason = "CUDAOutOfMemory"
+	ImagePullFailure             TrainingJobFailureReason = "ImagePullFailed"
+	InsufficientGPUFailure       TrainingJobFailureReason = "InsufficientGPU"
+	InsufficientGPUMemoryFailure TrainingJobFailureReason = "InsufficientGPUMemory"
+	EvictedFailure               TrainingJobFailureReason = "Evicted"
+	NCCLFailure                  TrainingJobFailureReason = "NCCLError"
+	CUDAFailure                  TrainingJobFailureReason = "CUDAError"
+	UnschedulableFailure         TrainingJobFailureReason = "Unschedulable"
+	NonZeroExitCodeFailure       TrainingJobFailureReason = "NonZeroExitCode"
+)
+
+// TrainingJobDiagnosis is the diagnosis report of training job
+type TrainingJobDiagnosis struct {
+	// Name is the name of training job
+	Name string `json:"name" yaml:"name"`
+	// Namespace is the namespace of training job
+	Namespace string `json:"namespace" yaml:"namespace"`
+	// Trainer is the type of training job
+	Trainer TrainingJobType `json:"trainer" yaml:"trainer"`
+	// Status is the status of training job
+	Status TrainingJobStatus `json:"status" yaml:"status"`
+	// Causes are the likely causes ordered by their scores
+	Causes []TrainingJobFailureCause `json:"causes" yaml:"causes"`
+}
+
+// TrainingJobFailureCause is a likely cause with its evidence
+type TrainingJobFailureCause struct {
+	// Reason is the likely cause
+	Reason TrainingJobFailureReason `json:"reason" yaml:"reason"`
+	// Score ranks the likely causes, the higher is the more likely
+	Score int `json:"score" yaml:"score"`
+	// Suggestion explains the cause and how to fix it
+	Suggestion string `json:"suggestion" yaml:"suggestion"`
+	// Instances are the pods which have the cause
+	Instances []string `json:"instances" yaml:"instances"`
+	// Evidence are the status, events or log lines which indicate the cause
+	Evidence []string `json:"evidence" yaml:"evidence"`
+}
@@ -0,0 +1,67 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "time"
+
+// PruneKeepLastByUser groups the training jobs by their owner when keeping the last jobs
+const PruneKeepLastByUser = "user"
+
+// DefaultPruneArchiveImage is the default image of the pod which writes the archives into pvc
+const DefaultPruneArchiveImage = "busybox:1.36"
+
+// PruneArgs defines the retention policy of pruning the finished training jobs,
+// a job is pruned if any of the policies selects it
+type PruneArgs struct {
+	// AllNamespaces prunes the training jobs in all namespaces
+	AllNamespaces bool `json:"allNamespaces,omitempty"`
+	// Type prunes the training jobs of the type only
+	Type TrainingJobType `json:"type,omitempty"`
+	// Selector is the label selector to filter the training jobs, like team=nlp,env!=prod
+	Selector string `json:"selector,omitempty"`
+	// Since prunes the not running training jobs older than the duration, the policy is not used if it is nil
+	Since *time.Duration `json:"since,omitempty"`
+	// SucceededTTL prunes the succeeded training jobs older than the duration
+	SucceededTTL time.Duration `json:"succeededTTL,omitempty"`
+	// FailedTTL prunes the failed training jobs older than the duration
+	FailedTTL time.Duration `json:"failedTTL,omitempty"`
+	// KeepLast keeps the latest N finished training jobs per group and prunes the others
+	KeepLast int `json:"keepLast,omitempty"`
+	// KeepLastBy groups the training jobs by "user" or a label key for KeepLast
+	KeepLastBy string `json:"keepLastBy,omitempty"`
+	// DryRun lists the training jobs which would be pruned without deleting them
+	DryRun bool `json:"dryRun,omitempty"`
+	// ArchiveDir is the local directory to archive the training jobs before deletion
+	ArchiveDir string `json:"archiveDir,omitempty"`
+	// ArchivePVC is the pvc in the namespace of training job to archive the training jobs before deletion
+	ArchivePVC string `json:"archivePVC,omitempty"`
+	// ArchiveImage is the image of the pod which writes the archives into the pvc, DefaultPruneArchiveImage is used if it is not set
+	ArchiveImage string `json:"archiveImage,omitempty"`
+}
+
+// PrunedTrainingJob is a training job selected by the retention policy
+type PrunedTrainingJob struct {
+	Name      string            `json:"name" yaml:"name"`
+	Namespace string            `json:"namespace" yaml:"namespace"`
+	Type      TrainingJobType   `json:"type" yaml:"type"`
+	Status    TrainingJobStatus `json:"status" yaml:"status"`
+	Age       string            `json:"age" yaml:"age"`
+	// Reason is the policy which selects the training job
+	Reason string `json:"reason" yaml:"reason"`
+	// Archive is the path of the archived tarball
+	Archive string `json:"archive,omitempty" yaml:"archive,omitempty"`
+	// Deleted is true if the training job has been deleted
+	Deleted bool `json:"deleted" yaml:"deleted"`
+}
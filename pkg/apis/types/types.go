@@ -33,6 +33,18 @@ const (
 	UnknownFormat FormatStyle = "unknown"
 )
 
+// DryRunStrategy defines how the job is submitted when option --dry-run is given
+type DryRunStrategy string
+
+const (
+	// DryRunNone submits the job
+	DryRunNone DryRunStrategy = "none"
+	// DryRunClient only prints the rendered manifests of the job
+	DryRunClient DryRunStrategy = "client"
+	// DryRunServer validates the rendered manifests by the api server without persisting them
+	DryRunServer DryRunStrategy = "server"
+)
+
 type ArenaClientArgs struct {
 	Kubeconfig     string
 	Namespace      string
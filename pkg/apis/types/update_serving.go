@@ -41,16 +41,19 @@ type UpdateTensorFlowServingArgs struct {
 	MonitoringConfigFile    string `yaml:"monitoringConfigFile"` // --monitoring-config-file
 	ModelName               string `yaml:"modelName"`            // --model-name
 	ModelPath               string `yaml:"modelPath"`            // --model-path
+	ServingAutoscaleArgs    `yaml:",inline"`
 	CommonUpdateServingArgs `yaml:",inline"`
 }
 
 type UpdateTritonServingArgs struct {
 	ModelRepository         string `yaml:"modelRepository"` // --model-repository
 	AllowMetrics            bool   `yaml:"allowMetrics"`    // --allow-metrics
+	ServingAutoscaleArgs    `yaml:",inline"`
 	CommonUpdateServingArgs `yaml:",inline"`
 }
 
 type UpdateCustomServingArgs struct {
+	ServingAutoscaleArgs    `yaml:",inline"`
 	CommonUpdateServingArgs `yaml:",inline"`
 }
 
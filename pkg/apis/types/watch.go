@@ -0,0 +1,43 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+// JobEventType defines the type of job lifecycle events emitted in watch mode
+type JobEventType string
+
+const (
+	// JobAdded means the job is found for the first time
+	JobAdded JobEventType = "ADDED"
+	// JobModified means the status or instances of the job are changed
+	JobModified JobEventType = "MODIFIED"
+	// JobDeleted means the job is not found any more
+	JobDeleted JobEventType = "DELETED"
+)
+
+// TrainingJobEvent describes a change of training job
+type TrainingJobEvent struct {
+	// Type is the event type
+	Type JobEventType `json:"type" yaml:"type"`
+	// Job is the latest information of the job, it is the last seen information for DELETED events
+	Job *TrainingJobInfo `json:"job" yaml:"job"`
+}
+
+// ServingJobEvent describes a change of serving job
+type ServingJobEvent struct {
+	// Type is the event type
+	Type JobEventType `json:"type" yaml:"type"`
+	// Job is the latest information of the job, it is the last seen information for DELETED events
+	Job *ServingJobInfo `json:"job" yaml:"job"`
+}
@@ -181,3 +181,30 @@ func IsDeepSpeedPod(name, ns string, pod *corev1.Pod) bool {
 	}
 	return true
 }
+
+func IsTrainJobPod(name, ns string, pod *corev1.Pod) bool {
+	// the pods of trainjob are created by the jobset which has the same name
+	if pod.Labels[JobSetNameLabel] != name {
+		return false
+	}
+	if pod.Namespace != ns {
+		return false
+	}
+	return true
+}
+
+func IsJobSetPod(name, ns string, pod *corev1.Pod) bool {
+	if pod.Labels["release"] != name {
+		return false
+	}
+	if pod.Labels["app"] != string(types.JobSetTrainingJob) {
+		return false
+	}
+	if pod.Labels[JobSetNameLabel] != name {
+		return false
+	}
+	if pod.Namespace != ns {
+		return false
+	}
+	return true
+}
@@ -44,6 +44,12 @@ const (
 
 	// training-operator
 	OperatorNameLabel = "training.kubeflow.org/operator-name"
+
+	// jobset, the pods of trainjob are created by the jobset
+	JobSetNameLabel          = "jobset.sigs.k8s.io/jobset-name"
+	JobSetReplicatedJobLabel = "jobset.sigs.k8s.io/replicatedjob-name"
+	JobSetJobIndexLabel      = "jobset.sigs.k8s.io/job-index"
+	JobCompletionIndexLabel  = "batch.kubernetes.io/job-completion-index"
 )
 
 // GetTrainingJobTypes returns the supported training job types
@@ -70,6 +70,7 @@ func (e *EvaluateJobArgsBuilder) AddCommandFlags(command *cobra.Command) {
 	for name := range e.subBuilders {
 		e.subBuilders[name].AddCommandFlags(command)
 	}
+	AddDryRunFlag(command, &e.args.DryRun)
 
 	var (
 		envs             []string
@@ -0,0 +1,244 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package argsbuilder
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/jobtemplate"
+)
+
+// jobTemplateExcludedOptions stores the common options which can not be stored in job templates
+var jobTemplateExcludedOptions = map[string]bool{
+	"name":     true,
+	"template": true,
+	"dry-run":  true,
+}
+
+// JobTemplateArgsBuilder builds the job template from the common options of 'arena submit'
+type JobTemplateArgsBuilder struct {
+	args        *types.JobTemplate
+	submitArgs  *types.CommonSubmitArgs
+	flags       *pflag.FlagSet
+	argValues   map[string]interface{}
+	subBuilders map[string]ArgsBuilder
+}
+
+func NewJobTemplateArgsBuilder(args *types.JobTemplate) ArgsBuilder {
+	j := &JobTemplateArgsBuilder{
+		args:        args,
+		submitArgs:  &types.CommonSubmitArgs{},
+		argValues:   map[string]interface{}{},
+		subBuilders: map[string]ArgsBuilder{},
+	}
+	j.AddSubBuilder(
+		NewSubmitArgsBuilder(j.submitArgs),
+	)
+	return j
+}
+
+func (j *JobTemplateArgsBuilder) GetName() string {
+	items := strings.Split(fmt.Sprintf("%v", reflect.TypeOf(*j)), ".")
+	return items[len(items)-1]
+}
+
+func (j *JobTemplateArgsBuilder) AddSubBuilder(builders ...ArgsBuilder) ArgsBuilder {
+	for _, b := range builders {
+		j.subBuilders[b.GetName()] = b
+	}
+	return j
+}
+
+func (j *JobTemplateArgsBuilder) AddArgValue(key string, value interface{}) ArgsBuilder {
+	for name := range j.subBuilders {
+		j.subBuilders[name].AddArgValue(key, value)
+	}
+	j.argValues[key] = value
+	return j
+}
+
+func (j *JobTemplateArgsBuilder) AddCommandFlags(command *cobra.Command) {
+	for name := range j.subBuilders {
+		j.subBuilders[name].AddCommandFlags(command)
+	}
+	// the template name is given by the command args rather than --name
+	nameFlag := command.Flags().Lookup("name")
+	delete(nameFlag.Annotations, cobra.BashCompOneRequiredFlag)
+	for option := range jobTemplateExcludedOptions {
+		_ = command.Flags().MarkHidden(option)
+	}
+	j.flags = command.Flags()
+}
+
+func (j *JobTemplateArgsBuilder) PreBuild() error {
+	if j.args.Name == "" {
+		return fmt.Errorf("the template name must be set")
+	}
+	// the options are set by api
+	if j.flags == nil {
+		return ValidateJobTemplate(j.args)
+	}
+	// the options are collected before building, which may change the values of options
+	j.args.Options = map[string]interface{}{}
+	j.flags.Visit(func(flag *pflag.Flag) {
+		if jobTemplateExcludedOptions[flag.Name] {
+			return
+		}
+		if sliceValue, ok := flag.Value.(pflag.SliceValue); ok {
+			j.args.Options[flag.Name] = sliceValue.GetSlice()
+			return
+		}
+		j.args.Options[flag.Name] = flag.Value.String()
+	})
+	if len(j.args.Options) == 0 {
+		return fmt.Errorf("no options are given, the template must have at least one option of 'arena submit'")
+	}
+	// validate the options like submitting a job whose name is the template name
+	j.submitArgs.Name = j.args.Name
+	for name := range j.subBuilders {
+		if err := j.subBuilders[name].PreBuild(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (j *JobTemplateArgsBuilder) Build() error {
+	if j.flags == nil {
+		return nil
+	}
+	for name := range j.subBuilders {
+		if err := j.subBuilders[name].Build(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateJobTemplate checks the options of job template are the valid common options of 'arena submit'
+func ValidateJobTemplate(template *types.JobTemplate) error {
+	if len(template.Options) == 0 {
+		return fmt.Errorf("the template must have at least one option of 'arena submit'")
+	}
+	for name := range template.Options {
+		if jobTemplateExcludedOptions[name] {
+			return fmt.Errorf("the option --%v can not be stored in job template", name)
+		}
+	}
+	builder := NewSubmitArgsBuilder(&types.CommonSubmitArgs{}).(*SubmitArgsBuilder)
+	builder.AddCommandFlags(&cobra.Command{})
+	return applyJobTemplateToFlags(builder.flags, template.Options)
+}
+
+// setJobTemplate merges the options of job template given by --template,
+// the given options win and the list options of template are placed before the given ones
+func (s *SubmitArgsBuilder) setJobTemplate() error {
+	if s.args.Template == "" {
+		return nil
+	}
+	template, err := jobtemplate.GetJobTemplate(s.args.Template)
+	if err != nil {
+		return err
+	}
+	log.Debugf("merge the options of job template %v: %v", template.Name, template.Options)
+	if s.flags != nil {
+		return applyJobTemplateToFlags(s.flags, template.Options)
+	}
+	return s.applyJobTemplateToArgs(template.Options)
+}
+
+// applyJobTemplateToArgs merges the template options when the args are set by api rather than flags,
+// the args which are not equal to their default values are considered as given
+func (s *SubmitArgsBuilder) applyJobTemplateToArgs(options map[string]interface{}) error {
+	templateArgs := types.CommonSubmitArgs{}
+	templateBuilder := NewSubmitArgsBuilder(&templateArgs).(*SubmitArgsBuilder)
+	templateBuilder.AddCommandFlags(&cobra.Command{})
+	defaultArgs := templateArgs
+	if err := applyJobTemplateToFlags(templateBuilder.flags, options); err != nil {
+		return err
+	}
+	current := reflect.ValueOf(s.args).Elem()
+	fromTemplate := reflect.ValueOf(templateArgs)
+	defaults := reflect.ValueOf(defaultArgs)
+	for i := 0; i < current.NumField(); i++ {
+		field := current.Field(i)
+		if !field.CanSet() || reflect.DeepEqual(fromTemplate.Field(i).Interface(), defaults.Field(i).Interface()) {
+			continue
+		}
+		if field.IsZero() || reflect.DeepEqual(field.Interface(), defaults.Field(i).Interface()) {
+			field.Set(fromTemplate.Field(i))
+		}
+	}
+	for key, value := range templateBuilder.argValues {
+		items, ok := value.(*[]string)
+		if !ok || len(*items) == 0 {
+			continue
+		}
+		if given, ok := s.argValues[key].(*[]string); ok && given != nil {
+			*given = append(append([]string{}, *items...), *given...)
+			continue
+		}
+		merged := append([]string{}, *items...)
+		s.argValues[key] = &merged
+	}
+	return nil
+}
+
+// applyJobTemplateToFlags sets the flags which are not changed to the template options,
+// the items of template are placed before the given items for the list options
+func applyJobTemplateToFlags(flags *pflag.FlagSet, options map[string]interface{}) error {
+	names := []string{}
+	for name := range options {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if jobTemplateExcludedOptions[name] {
+			continue
+		}
+		flag := flags.Lookup(name)
+		if flag == nil {
+			return fmt.Errorf("the option --%v of job template is not supported", name)
+		}
+		values := jobtemplate.TemplateOptionValues(options[name])
+		if sliceValue, ok := flag.Value.(pflag.SliceValue); ok {
+			if flag.Changed {
+				values = append(values, sliceValue.GetSlice()...)
+			}
+			if err := sliceValue.Replace(values); err != nil {
+				return fmt.Errorf("invalid value of option --%v in job template: %v", name, err)
+			}
+			continue
+		}
+		if flag.Changed {
+			continue
+		}
+		if len(values) != 1 {
+			return fmt.Errorf("the option --%v of job template should have only one value", name)
+		}
+		if err := flags.Set(name, values[0]); err != nil {
+			return fmt.Errorf("invalid value of option --%v in job template: %v", name, err)
+		}
+	}
+	return nil
+}
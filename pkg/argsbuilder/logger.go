@@ -105,6 +105,69 @@ func (l *LogArgsBuilder) AddCommandFlags(command *cobra.Command) {
 		AddArgValue("tail", &tail)
 }
 
+// TrainingLogArgsBuilder adds the options which are only supported by the logs of training jobs
+type TrainingLogArgsBuilder struct {
+	args        *types.LogArgs
+	argValues   map[string]interface{}
+	subBuilders map[string]ArgsBuilder
+}
+
+func NewTrainingLogArgsBuilder(args *types.LogArgs) ArgsBuilder {
+	return &TrainingLogArgsBuilder{
+		args:        args,
+		argValues:   map[string]interface{}{},
+		subBuilders: map[string]ArgsBuilder{},
+	}
+}
+
+func (t *TrainingLogArgsBuilder) GetName() string {
+	items := strings.Split(fmt.Sprintf("%v", reflect.TypeOf(*t)), ".")
+	return items[len(items)-1]
+}
+
+func (t *TrainingLogArgsBuilder) AddSubBuilder(builders ...ArgsBuilder) ArgsBuilder {
+	for _, b := range builders {
+		t.subBuilders[b.GetName()] = b
+	}
+	return t
+}
+
+func (t *TrainingLogArgsBuilder) AddArgValue(key string, value interface{}) ArgsBuilder {
+	for name := range t.subBuilders {
+		t.subBuilders[name].AddArgValue(key, value)
+	}
+	t.argValues[key] = value
+	return t
+}
+
+func (t *TrainingLogArgsBuilder) PreBuild() error {
+	for name := range t.subBuilders {
+		if err := t.subBuilders[name].PreBuild(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *TrainingLogArgsBuilder) Build() error {
+	for name := range t.subBuilders {
+		if err := t.subBuilders[name].Build(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *TrainingLogArgsBuilder) AddCommandFlags(command *cobra.Command) {
+	for name := range t.subBuilders {
+		t.subBuilders[name].AddCommandFlags(command)
+	}
+	command.Flags().BoolVar(&t.args.AllInstances, "all", false, "Stream the logs of all the instances concurrently, each line is prefixed with the instance like [worker-3]")
+	command.Flags().BoolVar(&t.args.AllContainers, "all-containers", false, "Stream the logs of all the containers of each instance")
+	command.Flags().StringVar(&t.args.Role, "role", "", "Only stream the logs of the instances with the role, like master, worker or launcher")
+	command.Flags().StringVar(&t.args.Grep, "grep", "", "Only print the lines which match the regular expression")
+}
+
 func (l *LogArgsBuilder) transfer() error {
 	// parse tail lines
 	if value, ok := l.argValues["tail"]; ok {
@@ -68,6 +68,7 @@ func (m *ModelArgsBuilder) AddCommandFlags(command *cobra.Command) {
 	for name := range m.subBuilders {
 		m.subBuilders[name].AddCommandFlags(command)
 	}
+	AddDryRunFlag(command, &m.args.DryRun)
 
 	var (
 		envs        []string
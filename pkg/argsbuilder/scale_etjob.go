@@ -62,6 +62,7 @@ func (s *ScaleETJobArgsBuilder) AddCommandFlags(command *cobra.Command) {
 	for name := range s.subBuilders {
 		s.subBuilders[name].AddCommandFlags(command)
 	}
+	AddDryRunFlag(command, &s.args.DryRun)
 	var (
 		scaleDuration time.Duration
 		scaleinEnvs   []string
@@ -75,6 +75,7 @@ func (s *ServingArgsBuilder) AddCommandFlags(command *cobra.Command) {
 	for name := range s.subBuilders {
 		s.subBuilders[name].AddCommandFlags(command)
 	}
+	AddDryRunFlag(command, &s.args.DryRun)
 	var (
 		envs               []string
 		envsFromSecret     []string
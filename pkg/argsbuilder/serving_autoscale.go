@@ -0,0 +1,79 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package argsbuilder
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/prometheus"
+)
+
+const (
+	defaultScaleUtilizationTarget = 80
+	defaultScaleQPSTarget         = 10
+)
+
+// addServingAutoscaleFlags adds the flags to create the autoscaler of serving deployment
+func addServingAutoscaleFlags(command *cobra.Command, args *types.ServingAutoscaleArgs) {
+	command.Flags().IntVar(&args.MinReplicas, "min-replicas", 0, "the minimum replicas of the autoscaler, default is 1")
+	command.Flags().IntVar(&args.MaxReplicas, "max-replicas", 0, "the maximum replicas of the autoscaler, autoscaling is enabled if it is greater than 0")
+	command.Flags().StringVar((*string)(&args.ScaleMetric), "scale-metric", "", fmt.Sprintf("the metric watched by the autoscaler, the possible option is %v, %v, %v or %v, default is %v. %v and %v need KEDA and the prometheus address of arena config",
+		types.CPUScaleMetric, types.MemoryScaleMetric, types.GPUUtilScaleMetric, types.QPSScaleMetric, types.CPUScaleMetric, types.GPUUtilScaleMetric, types.QPSScaleMetric))
+	command.Flags().IntVar(&args.ScaleTarget, "scale-target", 0, fmt.Sprintf("the target value of the scale metric, the average utilization percent for cpu, memory and gpu-util (default %v), the requests per second of each replica for qps (default %v)", defaultScaleUtilizationTarget, defaultScaleQPSTarget))
+}
+
+// setServingAutoscaleArgs sets the default values of autoscaler and checks them
+func setServingAutoscaleArgs(args *types.ServingAutoscaleArgs) error {
+	if args.MaxReplicas == 0 {
+		if args.MinReplicas != 0 || args.ScaleMetric != "" || args.ScaleTarget != 0 {
+			return fmt.Errorf("--max-replicas must be specified to enable autoscaling")
+		}
+		return nil
+	}
+	if args.ScaleMetric == "" {
+		args.ScaleMetric = types.CPUScaleMetric
+	}
+	switch args.ScaleMetric {
+	case types.CPUScaleMetric, types.MemoryScaleMetric:
+		if args.ScaleTarget == 0 {
+			args.ScaleTarget = defaultScaleUtilizationTarget
+		}
+	case types.GPUUtilScaleMetric, types.QPSScaleMetric:
+		if args.ScaleTarget == 0 {
+			args.ScaleTarget = defaultScaleUtilizationTarget
+			if args.ScaleMetric == types.QPSScaleMetric {
+				args.ScaleTarget = defaultScaleQPSTarget
+			}
+		}
+		if prometheus.GetPrometheusAddress() == "" {
+			return fmt.Errorf("--scale-metric=%v needs the prometheus address, please set prometheus_address in the arena config or env PROMETHEUS_ADDRESS", args.ScaleMetric)
+		}
+	default:
+		return fmt.Errorf("unknown scale metric %v, the possible option is %v, %v, %v or %v", args.ScaleMetric, types.CPUScaleMetric, types.MemoryScaleMetric, types.GPUUtilScaleMetric, types.QPSScaleMetric)
+	}
+	if args.MinReplicas == 0 {
+		args.MinReplicas = 1
+	}
+	if args.MinReplicas < 1 || args.MinReplicas > args.MaxReplicas {
+		return fmt.Errorf("--min-replicas must be between 1 and --max-replicas(%v)", args.MaxReplicas)
+	}
+	if args.ScaleTarget < 0 {
+		return fmt.Errorf("--scale-target is invalid")
+	}
+	return nil
+}
@@ -93,6 +93,7 @@ func (s *CustomServingArgsBuilder) AddCommandFlags(command *cobra.Command) {
 	command.Flags().StringVar(&s.args.StartupProbeAction, "startup-probe-action", "", "the startup probe action, support httpGet,exec,grpc,tcpSocket")
 	command.Flags().StringArrayVar(&startupProbeActionOption, "startup-probe-action-option", []string{}, `the startup probe action option, usage: --startup-probe-action-option="path: /healthz" or --startup-probe-action-option="command=cat /tmp/healthy"`)
 	command.Flags().StringArrayVar(&s.args.StartupProbeOption, "startup-probe-option", []string{}, `the startup probe option, usage: --startup-probe-option="initialDelaySeconds: 3" or --startup-probe-option="periodSeconds: 3"`)
+	addServingAutoscaleFlags(command, &s.args.ServingAutoscaleArgs)
 
 	s.AddArgValue(LivenessProbeActionOption, &livenessProbeActionOption).
 		AddArgValue(ReadinessProbeActionOption, &readinessProbeActionOption).
@@ -129,6 +130,9 @@ func (s *CustomServingArgsBuilder) Build() error {
 	if err := s.setStartupProbeActionOption(); err != nil {
 		return err
 	}
+	if err := setServingAutoscaleArgs(&s.args.ServingAutoscaleArgs); err != nil {
+		return err
+	}
 	return nil
 }
 
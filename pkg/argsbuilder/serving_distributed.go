@@ -89,6 +89,10 @@ func (s *DistributedServingArgsBuilder) AddCommandFlags(command *cobra.Command)
 	_ = command.Flags().MarkHidden("gpus")
 	_ = command.Flags().MarkHidden("gpumemory")
 	_ = command.Flags().MarkHidden("gpucore")
+	_ = command.Flags().MarkHidden("min-replicas")
+	_ = command.Flags().MarkHidden("max-replicas")
+	_ = command.Flags().MarkHidden("scale-metric")
+	_ = command.Flags().MarkHidden("scale-target")
 }
 
 func (s *DistributedServingArgsBuilder) PreBuild() error {
@@ -149,6 +153,9 @@ func (s *DistributedServingArgsBuilder) check() error {
 	if s.args.Masters != 1 {
 		return fmt.Errorf("can not change leader number, only support 1 leader currently")
 	}
+	if s.args.MaxReplicas != 0 {
+		return fmt.Errorf("autoscaling is not supported by distributed serving")
+	}
 	if s.args.Command != "" {
 		if s.args.MasterCommand != "" || s.args.WorkerCommand != "" {
 			return fmt.Errorf("--command and --leader-command/--worker-command can not be set at the same time")
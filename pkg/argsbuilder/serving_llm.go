@@ -0,0 +1,244 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package argsbuilder
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+const (
+	DefaultVLLMServingImage   = "vllm/vllm-openai:latest"
+	DefaultSGLangServingImage = "lmsysorg/sglang:latest"
+	DefaultVLLMServingPort    = 8000
+	DefaultSGLangServingPort  = 30000
+)
+
+// LLMServingArgsBuilder builds the args of vllm and sglang serving,
+// both of them serve an OpenAI-compatible api on the restful port
+type LLMServingArgsBuilder struct {
+	args        *types.LLMServingArgs
+	argValues   map[string]interface{}
+	subBuilders map[string]ArgsBuilder
+}
+
+func NewVLLMServingArgsBuilder(args *types.LLMServingArgs) ArgsBuilder {
+	return newLLMServingArgsBuilder(args, types.VLLMServingJob, DefaultVLLMServingImage)
+}
+
+func NewSGLangServingArgsBuilder(args *types.LLMServingArgs) ArgsBuilder {
+	return newLLMServingArgsBuilder(args, types.SGLangServingJob, DefaultSGLangServingImage)
+}
+
+func newLLMServingArgsBuilder(args *types.LLMServingArgs, servingType types.ServingJobType, defaultImage string) ArgsBuilder {
+	args.ServingType = servingType
+	s := &LLMServingArgsBuilder{
+		args:        args,
+		argValues:   map[string]interface{}{},
+		subBuilders: map[string]ArgsBuilder{},
+	}
+	s.AddSubBuilder(
+		NewCustomServingArgsBuilder(&s.args.CustomServingArgs),
+	)
+	args.Type = servingType
+	s.AddArgValue("default-image", defaultImage)
+	return s
+}
+
+func (s *LLMServingArgsBuilder) GetName() string {
+	items := strings.Split(fmt.Sprintf("%v", reflect.TypeOf(*s)), ".")
+	return items[len(items)-1]
+}
+
+func (s *LLMServingArgsBuilder) AddSubBuilder(builders ...ArgsBuilder) ArgsBuilder {
+	for _, b := range builders {
+		s.subBuilders[b.GetName()] = b
+	}
+	return s
+}
+
+func (s *LLMServingArgsBuilder) AddArgValue(key string, value interface{}) ArgsBuilder {
+	for name := range s.subBuilders {
+		s.subBuilders[name].AddArgValue(key, value)
+	}
+	s.argValues[key] = value
+	return s
+}
+
+func (s *LLMServingArgsBuilder) AddCommandFlags(command *cobra.Command) {
+	for name := range s.subBuilders {
+		s.subBuilders[name].AddCommandFlags(command)
+	}
+	command.Flags().StringVar(&s.args.ModelPath, "model-path", "", "the model to serve, a huggingface model id or a local path like /models/llama")
+	command.Flags().StringVar(&s.args.ServedModelName, "served-model-name", "", "the model name used in the OpenAI api requests, default is the serving name")
+	command.Flags().IntVar(&s.args.TensorParallelSize, "tensor-parallel-size", 0, "the tensor parallel size, default is the value of --gpus")
+	command.Flags().IntVar(&s.args.PipelineParallelSize, "pipeline-parallel-size", 0, "the pipeline parallel size")
+	command.Flags().IntVar(&s.args.MaxModelLen, "max-model-len", 0, "the max context length of the model, default is decided by the model config")
+	command.Flags().StringVar(&s.args.Quantization, "quantization", "", "the quantization method, like awq, gptq or fp8")
+}
+
+func (s *LLMServingArgsBuilder) PreBuild() error {
+	for name := range s.subBuilders {
+		if err := s.subBuilders[name].PreBuild(); err != nil {
+			return err
+		}
+	}
+	if err := s.setDefaults(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *LLMServingArgsBuilder) Build() error {
+	for name := range s.subBuilders {
+		if err := s.subBuilders[name].Build(); err != nil {
+			return err
+		}
+	}
+	if err := s.check(); err != nil {
+		return err
+	}
+	if err := s.setType(); err != nil {
+		return err
+	}
+	if err := s.setCommand(); err != nil {
+		return err
+	}
+	if err := s.setProbes(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// setDefaults must run before the custom serving args builder checks the image and ports
+func (s *LLMServingArgsBuilder) setDefaults() error {
+	if s.args.Image == "" {
+		s.args.Image = s.argValues["default-image"].(string)
+	}
+	if s.args.Port == 0 && s.args.RestfulPort == 0 {
+		s.args.RestfulPort = DefaultVLLMServingPort
+		if s.args.ServingType == types.SGLangServingJob {
+			s.args.RestfulPort = DefaultSGLangServingPort
+		}
+	}
+	if s.args.ServedModelName == "" {
+		s.args.ServedModelName = s.args.Name
+	}
+	if s.args.TensorParallelSize == 0 && s.args.PipelineParallelSize <= 1 && s.args.GPUCount > 1 {
+		s.args.TensorParallelSize = s.args.GPUCount
+	}
+	return nil
+}
+
+func (s *LLMServingArgsBuilder) check() error {
+	if s.args.Command == "" && s.args.ModelPath == "" {
+		return fmt.Errorf("--model-path must be specified")
+	}
+	if s.args.RestfulPort == 0 {
+		return fmt.Errorf("--restful-port must be specified, it serves the OpenAI-compatible api")
+	}
+	if s.args.TensorParallelSize < 0 || s.args.PipelineParallelSize < 0 {
+		return fmt.Errorf("--tensor-parallel-size/--pipeline-parallel-size is invalid")
+	}
+	if s.args.MaxModelLen < 0 {
+		return fmt.Errorf("--max-model-len is invalid")
+	}
+	parallelSize := max(s.args.TensorParallelSize, 1) * max(s.args.PipelineParallelSize, 1)
+	if s.args.GPUCount > 0 && parallelSize > s.args.GPUCount {
+		return fmt.Errorf("tensor parallel size * pipeline parallel size (%v) is greater than --gpus (%v)", parallelSize, s.args.GPUCount)
+	}
+	return nil
+}
+
+func (s *LLMServingArgsBuilder) setType() error {
+	s.args.Type = s.args.ServingType
+	return nil
+}
+
+// setCommand generates the server command from the model options,
+// the command given by --command is used as it is
+func (s *LLMServingArgsBuilder) setCommand() error {
+	if s.args.Command != "" {
+		return nil
+	}
+	s.args.Command = strings.Join(LLMServingCommand(s.args), " ")
+	return nil
+}
+
+// LLMServingCommand returns the command line to start vllm or sglang server
+func LLMServingCommand(args *types.LLMServingArgs) []string {
+	var command []string
+	var optionNames map[string]string
+	switch args.ServingType {
+	case types.SGLangServingJob:
+		command = []string{"python3", "-m", "sglang.launch_server", "--model-path", args.ModelPath}
+		optionNames = map[string]string{
+			"tp":  "--tp-size",
+			"pp":  "--pp-size",
+			"len": "--context-length",
+		}
+	default:
+		command = []string{"vllm", "serve", args.ModelPath}
+		optionNames = map[string]string{
+			"tp":  "--tensor-parallel-size",
+			"pp":  "--pipeline-parallel-size",
+			"len": "--max-model-len",
+		}
+	}
+	command = append(command, "--host", "0.0.0.0", "--port", fmt.Sprintf("%v", args.RestfulPort))
+	if args.ServedModelName != "" {
+		command = append(command, "--served-model-name", args.ServedModelName)
+	}
+	if args.TensorParallelSize > 0 {
+		command = append(command, optionNames["tp"], fmt.Sprintf("%v", args.TensorParallelSize))
+	}
+	if args.PipelineParallelSize > 0 {
+		command = append(command, optionNames["pp"], fmt.Sprintf("%v", args.PipelineParallelSize))
+	}
+	if args.MaxModelLen > 0 {
+		command = append(command, optionNames["len"], fmt.Sprintf("%v", args.MaxModelLen))
+	}
+	if args.Quantization != "" {
+		command = append(command, "--quantization", args.Quantization)
+	}
+	return append(command, args.ExtraArgs...)
+}
+
+// setProbes sets the probes which are not given by user, both vllm and sglang
+// serve /health on the restful port, and loading a large model may take a long time
+func (s *LLMServingArgsBuilder) setProbes() error {
+	healthCheck := []string{"path: /health", "port: restful"}
+	if s.args.StartupProbeAction == "" {
+		s.args.StartupProbeAction = "httpGet"
+		s.args.StartupProbeActionOption = healthCheck
+		s.args.StartupProbeOption = []string{"periodSeconds: 10", "failureThreshold: 180"}
+	}
+	if s.args.ReadinessProbeAction == "" {
+		s.args.ReadinessProbeAction = "httpGet"
+		s.args.ReadinessProbeActionOption = healthCheck
+		s.args.ReadinessProbeOption = []string{"periodSeconds: 5", "failureThreshold: 3"}
+	}
+	if s.args.LivenessProbeAction == "" {
+		s.args.LivenessProbeAction = "httpGet"
+		s.args.LivenessProbeActionOption = healthCheck
+		s.args.LivenessProbeOption = []string{"periodSeconds: 10", "failureThreshold: 6"}
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package argsbuilder
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+type ServingPromoteArgsBuilder struct {
+	args        *types.ServingPromoteArgs
+	argValues   map[string]interface{}
+	subBuilders map[string]ArgsBuilder
+}
+
+func NewServingPromoteArgsBuilder(args *types.ServingPromoteArgs) ArgsBuilder {
+	s := &ServingPromoteArgsBuilder{
+		args:        args,
+		argValues:   map[string]interface{}{},
+		subBuilders: map[string]ArgsBuilder{},
+	}
+	return s
+}
+
+func (s *ServingPromoteArgsBuilder) GetName() string {
+	items := strings.Split(fmt.Sprintf("%v", reflect.TypeOf(*s)), ".")
+	return items[len(items)-1]
+}
+
+func (s *ServingPromoteArgsBuilder) AddSubBuilder(builders ...ArgsBuilder) ArgsBuilder {
+	for _, b := range builders {
+		s.subBuilders[b.GetName()] = b
+	}
+	return s
+}
+
+func (s *ServingPromoteArgsBuilder) AddArgValue(key string, value interface{}) ArgsBuilder {
+	for name := range s.subBuilders {
+		s.subBuilders[name].AddArgValue(key, value)
+	}
+	s.argValues[key] = value
+	return s
+}
+
+func (s *ServingPromoteArgsBuilder) AddCommandFlags(command *cobra.Command) {
+	for name := range s.subBuilders {
+		s.subBuilders[name].AddCommandFlags(command)
+	}
+	var oldVersions string
+	command.Flags().StringVarP(&s.args.Version, "version", "v", "", "the version to promote")
+	command.Flags().StringVar(&oldVersions, "old-versions", string(types.KeepOldServingVersions), "what to do with the other versions after the promotion, one of: keep|scale-down|delete")
+	command.Flags().DurationVar(&s.args.GracePeriod, "grace-period", 5*time.Minute, "the time to wait before scaling down or deleting the other versions")
+	command.Flags().DurationVar(&s.args.ReadinessTimeout, "readiness-timeout", 10*time.Minute, "the max time to wait for all instances of the version to be available")
+	addTrafficRouterFlags(command, &s.args.TrafficRouterOptions)
+	_ = command.MarkFlagRequired("version")
+	s.AddArgValue("old-versions", &oldVersions)
+}
+
+func (s *ServingPromoteArgsBuilder) PreBuild() error {
+	for name := range s.subBuilders {
+		if err := s.subBuilders[name].PreBuild(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *ServingPromoteArgsBuilder) Build() error {
+	for name := range s.subBuilders {
+		if err := s.subBuilders[name].Build(); err != nil {
+			return err
+		}
+	}
+	if err := s.setOldVersions(); err != nil {
+		return err
+	}
+	if err := s.check(); err != nil {
+		return err
+	}
+	if err := setTrafficRouterOptions(&s.args.TrafficRouterOptions); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *ServingPromoteArgsBuilder) setOldVersions() error {
+	value, ok := s.argValues["old-versions"].(*string)
+	if !ok || *value == "" {
+		return nil
+	}
+	policy := types.OldServingVersionPolicy(*value)
+	switch policy {
+	case types.KeepOldServingVersions, types.ScaleDownOldServingVersions, types.DeleteOldServingVersions:
+		s.args.OldVersions = policy
+		return nil
+	}
+	return fmt.Errorf("invalid --old-versions %v, the possible option is %v, %v or %v", *value,
+		types.KeepOldServingVersions, types.ScaleDownOldServingVersions, types.DeleteOldServingVersions)
+}
+
+func (s *ServingPromoteArgsBuilder) check() error {
+	if s.args.ServingName == "" {
+		return fmt.Errorf("not set serving name, please set it")
+	}
+	if s.args.Version == "" {
+		return fmt.Errorf("not set the version to promote, please set it by --version")
+	}
+	if s.args.GracePeriod < 0 || s.args.ReadinessTimeout < 0 {
+		return fmt.Errorf("--grace-period and --readiness-timeout must not be negative")
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package argsbuilder
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+type ServingRolloutArgsBuilder struct {
+	args        *types.ServingRolloutArgs
+	argValues   map[string]interface{}
+	subBuilders map[string]ArgsBuilder
+}
+
+func NewServingRolloutArgsBuilder(args *types.ServingRolloutArgs) ArgsBuilder {
+	s := &ServingRolloutArgsBuilder{
+		args:        args,
+		argValues:   map[string]interface{}{},
+		subBuilders: map[string]ArgsBuilder{},
+	}
+	return s
+}
+
+func (s *ServingRolloutArgsBuilder) GetName() string {
+	items := strings.Split(fmt.Sprintf("%v", reflect.TypeOf(*s)), ".")
+	return items[len(items)-1]
+}
+
+func (s *ServingRolloutArgsBuilder) AddSubBuilder(builders ...ArgsBuilder) ArgsBuilder {
+	for _, b := range builders {
+		s.subBuilders[b.GetName()] = b
+	}
+	return s
+}
+
+func (s *ServingRolloutArgsBuilder) AddArgValue(key string, value interface{}) ArgsBuilder {
+	for name := range s.subBuilders {
+		s.subBuilders[name].AddArgValue(key, value)
+	}
+	s.argValues[key] = value
+	return s
+}
+
+func (s *ServingRolloutArgsBuilder) AddCommandFlags(command *cobra.Command) {
+	for name := range s.subBuilders {
+		s.subBuilders[name].AddCommandFlags(command)
+	}
+	var (
+		errorRateQuery string
+		maxErrorRate   float64
+		latencyQuery   string
+		maxLatency     float64
+	)
+	command.Flags().StringVar(&s.args.FromVersion, "from", "", "the version which the traffic is shifted from")
+	command.Flags().StringVar(&s.args.ToVersion, "to", "", "the version which the traffic is shifted to")
+	command.Flags().IntSliceVar(&s.args.Steps, "steps", []int{10, 25, 50, 100}, "the traffic percentages of the new version in order, e.g. --steps 10,25,50,100")
+	command.Flags().DurationVar(&s.args.Interval, "interval", 5*time.Minute, "the time to observe the new version after each step")
+	command.Flags().DurationVar(&s.args.ReadinessTimeout, "readiness-timeout", 10*time.Minute, "the max time to wait for all instances of the new version to be available before each step")
+	command.Flags().StringVar(&errorRateQuery, "error-rate-query", "", "the prometheus query of the error rate of the new version, it can refer to {{.Namespace}}, {{.Name}} and {{.Version}}. (optional)")
+	command.Flags().Float64Var(&maxErrorRate, "max-error-rate", 0.05, "the max error rate of the new version, the rollout is rolled back if it is exceeded")
+	command.Flags().StringVar(&latencyQuery, "latency-query", "", "the prometheus query of the latency of the new version, it can refer to {{.Namespace}}, {{.Name}} and {{.Version}}. (optional)")
+	command.Flags().Float64Var(&maxLatency, "max-latency", 0, "the max latency of the new version in the unit of latency query, the rollout is rolled back if it is exceeded")
+	addTrafficRouterFlags(command, &s.args.TrafficRouterOptions)
+	_ = command.MarkFlagRequired("from")
+	_ = command.MarkFlagRequired("to")
+	s.AddArgValue("error-rate-query", &errorRateQuery).
+		AddArgValue("max-error-rate", &maxErrorRate).
+		AddArgValue("latency-query", &latencyQuery).
+		AddArgValue("max-latency", &maxLatency)
+}
+
+func (s *ServingRolloutArgsBuilder) PreBuild() error {
+	for name := range s.subBuilders {
+		if err := s.subBuilders[name].PreBuild(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *ServingRolloutArgsBuilder) Build() error {
+	for name := range s.subBuilders {
+		if err := s.subBuilders[name].Build(); err != nil {
+			return err
+		}
+	}
+	if err := s.check(); err != nil {
+		return err
+	}
+	if err := s.setAnalysis(); err != nil {
+		return err
+	}
+	if err := setTrafficRouterOptions(&s.args.TrafficRouterOptions); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *ServingRolloutArgsBuilder) check() error {
+	if s.args.ServingName == "" {
+		return fmt.Errorf("not set serving name, please set it")
+	}
+	if s.args.FromVersion == "" || s.args.ToVersion == "" {
+		return fmt.Errorf("both --from and --to must be set")
+	}
+	if s.args.FromVersion == s.args.ToVersion {
+		return fmt.Errorf("the versions given by --from and --to must be different")
+	}
+	if len(s.args.Steps) == 0 {
+		return fmt.Errorf("at least one step must be set by --steps")
+	}
+	previous := 0
+	for _, step := range s.args.Steps {
+		if step <= previous || step > 100 {
+			return fmt.Errorf("invalid steps %v, the steps must be increasing and in the range of (0, 100]", s.args.Steps)
+		}
+		previous = step
+	}
+	if s.args.Interval < 0 || s.args.ReadinessTimeout < 0 {
+		return fmt.Errorf("--interval and --readiness-timeout must not be negative")
+	}
+	return nil
+}
+
+func (s *ServingRolloutArgsBuilder) setAnalysis() error {
+	s.args.Analysis = []types.ServingRolloutAnalysis{}
+	if query, ok := s.argValues["error-rate-query"].(*string); ok && *query != "" {
+		threshold := *s.argValues["max-error-rate"].(*float64)
+		if threshold < 0 {
+			return fmt.Errorf("--max-error-rate must not be negative")
+		}
+		s.args.Analysis = append(s.args.Analysis, types.ServingRolloutAnalysis{
+			Name:      "error-rate",
+			Query:     *query,
+			Threshold: threshold,
+		})
+	}
+	if query, ok := s.argValues["latency-query"].(*string); ok && *query != "" {
+		threshold := *s.argValues["max-latency"].(*float64)
+		if threshold <= 0 {
+			return fmt.Errorf("--max-latency must be set when --latency-query is set")
+		}
+		s.args.Analysis = append(s.args.Analysis, types.ServingRolloutAnalysis{
+			Name:      "latency",
+			Query:     *query,
+			Threshold: threshold,
+		})
+	}
+	return nil
+}
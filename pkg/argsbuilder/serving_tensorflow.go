@@ -95,7 +95,7 @@ func (s *TensorflowServingArgsBuilder) AddCommandFlags(command *cobra.Command) {
 	_ = command.Flags().MarkDeprecated("versionPolicy", "please use --version-policy instead")
 	command.Flags().StringVar(&s.args.VersionPolicy, "version-policy", "", "support latest, latest:N, specific:N, all")
 	_ = command.Flags().MarkDeprecated("version-policy", "please use --model-config-file instead")
-
+	addServingAutoscaleFlags(command, &s.args.ServingAutoscaleArgs)
 }
 
 func (s *TensorflowServingArgsBuilder) PreBuild() error {
@@ -119,6 +119,9 @@ func (s *TensorflowServingArgsBuilder) Build() error {
 	if err := s.preprocess(); err != nil {
 		return err
 	}
+	if err := setServingAutoscaleArgs(&s.args.ServingAutoscaleArgs); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -76,6 +76,7 @@ func (s *TritonServingArgsBuilder) AddCommandFlags(command *cobra.Command) {
 	command.Flags().BoolVar(&s.args.AllowMetrics, "allow-metrics", false, "open metrics")
 	command.Flags().StringVar(&s.args.ExtendCommand, "extend-command", "", "the command will attach to server's command.")
 	command.Flags().StringArrayVar(&loadModels, "load-model", []string{}, `giving names of model to load, usage:"--load-model <model-name>"`)
+	addServingAutoscaleFlags(command, &s.args.ServingAutoscaleArgs)
 
 	s.AddArgValue("load-model", &loadModels)
 }
@@ -104,6 +105,9 @@ func (s *TritonServingArgsBuilder) Build() error {
 	if err := s.validate(); err != nil {
 		return err
 	}
+	if err := setServingAutoscaleArgs(&s.args.ServingAutoscaleArgs); err != nil {
+		return err
+	}
 	return nil
 }
 
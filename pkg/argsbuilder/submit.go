@@ -16,6 +16,7 @@ package argsbuilder
 import (
 	"errors"
 	"fmt"
+	"net/url"
 	"os"
 	"path"
 	"path/filepath"
@@ -25,6 +26,8 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/util/validation"
 
 	"github.com/kubeflow/arena/pkg/apis/config"
 	"github.com/kubeflow/arena/pkg/apis/types"
@@ -36,6 +39,8 @@ type SubmitArgsBuilder struct {
 	args        *types.CommonSubmitArgs
 	argValues   map[string]interface{}
 	subBuilders map[string]ArgsBuilder
+	// flags are the options of command, they are nil when the args are set by api
+	flags *pflag.FlagSet
 }
 
 func NewSubmitArgsBuilder(args *types.CommonSubmitArgs) ArgsBuilder {
@@ -70,6 +75,7 @@ func (s *SubmitArgsBuilder) AddCommandFlags(command *cobra.Command) {
 	for name := range s.subBuilders {
 		s.subBuilders[name].AddCommandFlags(command)
 	}
+	AddDryRunFlag(command, &s.args.DryRun)
 	var (
 		envs             []string
 		dataSet          []string
@@ -129,6 +135,10 @@ func (s *SubmitArgsBuilder) AddCommandFlags(command *cobra.Command) {
 	command.Flags().StringVarP(&s.args.PriorityClassName, "priority", "p", "", "priority class name")
 	// enable Queue
 	command.Flags().BoolVar(&s.args.EnableQueue, "queue", false, "enables the feature to queue jobs after they are scheduled (Kube-queue needs to be pre-installed https://github.com/kube-queue/kube-queue)")
+	// add option --queue-name
+	command.Flags().StringVar(&s.args.QueueName, "queue-name", "", "the kueue LocalQueue which the job is submitted to (Kueue needs to be pre-installed https://kueue.sigs.k8s.io)")
+	// add option --export-logs-to
+	command.Flags().StringVar(&s.args.ExportLogsTo, "export-logs-to", "", "export the logs to the absolute path or s3://bucket/prefix when 'arena wait' or 'arena prune' sees the job finished, it can not be used with --ttl-after-finished")
 	// add option --toleration,its' value will be get from viper
 	command.Flags().StringArrayVar(&tolerations, "toleration", []string{}, `tolerate some k8s nodes with taints,usage: "--toleration key=value:effect,operator" or "--toleration all" `)
 	// add option --selector,its' value will be get from viper
@@ -153,6 +163,9 @@ func (s *SubmitArgsBuilder) AddCommandFlags(command *cobra.Command) {
 	command.Flags().StringVar(&s.args.ModelName, "model-name", "", "model name")
 	// add option --model-source
 	command.Flags().StringVar(&s.args.ModelSource, "model-source", "", "model source is a URI indicating the location of the model e.g. s3://my-bucket/path/to/model, pvc://namespace/pvc-name/path/to/model")
+	// add option --template
+	command.Flags().StringVar(&s.args.Template, "template", "", "the job template created by 'arena template create', the given options override the options of template")
+	s.flags = command.Flags()
 
 	s.AddArgValue("image-pull-secret", &imagePullSecrets).
 		AddArgValue("config-file", &configFiles).
@@ -167,6 +180,10 @@ func (s *SubmitArgsBuilder) AddCommandFlags(command *cobra.Command) {
 }
 
 func (s *SubmitArgsBuilder) PreBuild() error {
+	// merge the job template before handling the args
+	if err := s.setJobTemplate(); err != nil {
+		return err
+	}
 	for name := range s.subBuilders {
 		if err := s.subBuilders[name].PreBuild(); err != nil {
 			return err
@@ -205,6 +222,9 @@ func (s *SubmitArgsBuilder) Build() error {
 	if err := s.setQueue(); err != nil {
 		return err
 	}
+	if err := s.setExportLogsTo(); err != nil {
+		return err
+	}
 	if err := s.setLabels(); err != nil {
 		return err
 	}
@@ -355,8 +375,22 @@ func (s *SubmitArgsBuilder) setAnnotations() error {
 	return nil
 }
 
-// setQueue is used to add annotation for suspend status
+// setQueue is used to add annotation for suspend status of kube-queue,
+// or the label of kueue LocalQueue
 func (s *SubmitArgsBuilder) setQueue() error {
+	if s.args.EnableQueue && s.args.QueueName != "" {
+		return fmt.Errorf("--queue and --queue-name can not be used together, --queue is for kube-queue and --queue-name is for kueue")
+	}
+	if s.args.QueueName != "" {
+		if errs := validation.IsValidLabelValue(s.args.QueueName); len(errs) != 0 {
+			return fmt.Errorf("invalid queue name %v: %v", s.args.QueueName, strings.Join(errs, ","))
+		}
+		// kueue reads the queue name from the job object, it is not propagated to the pods
+		if s.args.JobLabels == nil {
+			s.args.JobLabels = map[string]string{}
+		}
+		s.args.JobLabels[types.KueueQueueNameLabel] = s.args.QueueName
+	}
 	if s.args.EnableQueue {
 		if s.args.Annotations == nil {
 			s.args.Annotations = map[string]string{}
@@ -366,6 +400,32 @@ func (s *SubmitArgsBuilder) setQueue() error {
 	return nil
 }
 
+// setExportLogsTo is used to add the annotation of option --export-logs-to,
+// the logs are exported to the destination when the finished job is observed
+func (s *SubmitArgsBuilder) setExportLogsTo() error {
+	if s.args.ExportLogsTo == "" {
+		return nil
+	}
+	// the logs are exported on the machine running 'arena wait' or 'arena prune',
+	// so a relative path which is resolved on the submitter's machine is rejected
+	if strings.Contains(s.args.ExportLogsTo, "://") {
+		u, err := url.Parse(s.args.ExportLogsTo)
+		if err != nil {
+			return fmt.Errorf("invalid --export-logs-to %v: %v", s.args.ExportLogsTo, err)
+		}
+		if u.Scheme != "s3" || u.Host == "" {
+			return fmt.Errorf("invalid --export-logs-to %v, only support an absolute path or s3://bucket/prefix", s.args.ExportLogsTo)
+		}
+	} else if !filepath.IsAbs(s.args.ExportLogsTo) {
+		return fmt.Errorf("invalid --export-logs-to %v, only support an absolute path or s3://bucket/prefix", s.args.ExportLogsTo)
+	}
+	if s.args.Annotations == nil {
+		s.args.Annotations = map[string]string{}
+	}
+	s.args.Annotations[types.ExportLogsToAnnotation] = s.args.ExportLogsTo
+	return nil
+}
+
 // setDevices is used to handle option --device
 func (s *SubmitArgsBuilder) setDevices() error {
 	if s.args.Devices == nil {
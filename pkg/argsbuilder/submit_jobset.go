@@ -0,0 +1,269 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package argsbuilder
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/common"
+)
+
+// defaultReplicatedJobName is the name of replicated job if no one is specified by --replicated-job
+const defaultReplicatedJobName = "worker"
+
+type SubmitJobSetArgsBuilder struct {
+	args        *types.SubmitJobSetArgs
+	argValues   map[string]interface{}
+	subBuilders map[string]ArgsBuilder
+}
+
+func NewSubmitJobSetArgsBuilder(args *types.SubmitJobSetArgs) ArgsBuilder {
+	args.TrainingType = types.JobSetTrainingJob
+	s := &SubmitJobSetArgsBuilder{
+		args:        args,
+		argValues:   map[string]interface{}{},
+		subBuilders: map[string]ArgsBuilder{},
+	}
+	s.AddSubBuilder(
+		NewSubmitArgsBuilder(&s.args.CommonSubmitArgs),
+	)
+	return s
+}
+
+func (s *SubmitJobSetArgsBuilder) GetName() string {
+	items := strings.Split(fmt.Sprintf("%v", reflect.TypeOf(*s)), ".")
+	return items[len(items)-1]
+}
+
+func (s *SubmitJobSetArgsBuilder) AddSubBuilder(builders ...ArgsBuilder) ArgsBuilder {
+	for _, b := range builders {
+		s.subBuilders[b.GetName()] = b
+	}
+	return s
+}
+
+func (s *SubmitJobSetArgsBuilder) AddArgValue(key string, value interface{}) ArgsBuilder {
+	for name := range s.subBuilders {
+		s.subBuilders[name].AddArgValue(key, value)
+	}
+	s.argValues[key] = value
+	return s
+}
+
+func (s *SubmitJobSetArgsBuilder) AddCommandFlags(command *cobra.Command) {
+	for name := range s.subBuilders {
+		s.subBuilders[name].AddCommandFlags(command)
+	}
+
+	var (
+		replicatedJobs        []string
+		replicatedJobCommands []string
+	)
+
+	command.Flags().StringVar(&s.args.Cpu, "cpu", "", "the default cpu resource of each pod, like 1 for 1 core.")
+	command.Flags().StringVar(&s.args.Memory, "memory", "", "the default memory resource of each pod, like 1Gi.")
+	command.Flags().StringVar(&s.args.ShareMemory, "share-memory", "", "the shared memory of each pod to run the job, like 2Gi.")
+	command.Flags().StringArrayVar(&replicatedJobs, "replicated-job", []string{}, `the replicated job of jobset, the first one is the chief, usage: "--replicated-job name=worker,replicas=2,pods=1,image=<image>,gpus=1,cpu=4,memory=8Gi", `+
+		`the image, gpus, cpu and memory default to the values of --image, --gpus, --cpu and --memory`)
+	command.Flags().StringArrayVar(&replicatedJobCommands, "replicated-job-command", []string{}, `the command of replicated job, usage: "--replicated-job-command <name>=<command>", the command of job args is used if it is not set`)
+
+	s.AddArgValue("replicated-job", &replicatedJobs).
+		AddArgValue("replicated-job-command", &replicatedJobCommands)
+}
+
+func (s *SubmitJobSetArgsBuilder) PreBuild() error {
+	for name := range s.subBuilders {
+		if err := s.subBuilders[name].PreBuild(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *SubmitJobSetArgsBuilder) Build() error {
+	for name := range s.subBuilders {
+		if err := s.subBuilders[name].Build(); err != nil {
+			return err
+		}
+	}
+	if err := s.setReplicatedJobs(); err != nil {
+		return err
+	}
+	if err := s.check(); err != nil {
+		return err
+	}
+	s.addRequestGPUsToAnnotation()
+	return nil
+}
+
+// setReplicatedJobs parses the options --replicated-job and --replicated-job-command,
+// a replicated job named worker is created with --workers if no one is specified
+func (s *SubmitJobSetArgsBuilder) setReplicatedJobs() error {
+	if value, ok := s.argValues["replicated-job"]; ok {
+		for _, item := range *value.(*[]string) {
+			job, err := parseReplicatedJob(item)
+			if err != nil {
+				return err
+			}
+			s.args.ReplicatedJobs = append(s.args.ReplicatedJobs, job)
+		}
+	}
+	if len(s.args.ReplicatedJobs) == 0 {
+		s.args.ReplicatedJobs = []types.JobSetReplicatedJob{{Name: defaultReplicatedJobName, Replicas: s.args.WorkerCount, Pods: 1}}
+	}
+	commands := map[string]string{}
+	if value, ok := s.argValues["replicated-job-command"]; ok {
+		for _, item := range *value.(*[]string) {
+			name, command, found := strings.Cut(item, "=")
+			if !found || name == "" || command == "" {
+				return fmt.Errorf("invalid option --replicated-job-command %v, it should be <name>=<command>", item)
+			}
+			commands[name] = command
+		}
+	}
+	for i := range s.args.ReplicatedJobs {
+		job := &s.args.ReplicatedJobs[i]
+		if command, ok := commands[job.Name]; ok {
+			job.Command = command
+			delete(commands, job.Name)
+		}
+		if job.Command == "" {
+			job.Command = s.args.Command
+		}
+		if job.Image == "" {
+			job.Image = s.args.Image
+		}
+		if job.GPUCount == 0 {
+			job.GPUCount = s.args.GPUCount
+		}
+		if job.Cpu == "" {
+			job.Cpu = s.args.Cpu
+		}
+		if job.Memory == "" {
+			job.Memory = s.args.Memory
+		}
+	}
+	for name := range commands {
+		return fmt.Errorf("the replicated job %v of option --replicated-job-command is not found", name)
+	}
+	// the gpus are set for each replicated job, so the devices are hidden by the chart
+	// only for the replicated jobs which request no gpus
+	if s.args.Envs[common.ENV_NVIDIA_VISIBLE_DEVICES] == "void" {
+		delete(s.args.Envs, common.ENV_NVIDIA_VISIBLE_DEVICES)
+	}
+	return nil
+}
+
+// parseReplicatedJob parses the replicated job like "name=worker,replicas=2,image=<image>"
+func parseReplicatedJob(value string) (types.JobSetReplicatedJob, error) {
+	job := types.JobSetReplicatedJob{Replicas: 1, Pods: 1}
+	for _, item := range strings.Split(value, ",") {
+		key, val, found := strings.Cut(strings.TrimSpace(item), "=")
+		if !found {
+			return job, fmt.Errorf("invalid option --replicated-job %v, %v should be key=value", value, item)
+		}
+		var err error
+		switch key {
+		case "name":
+			job.Name = val
+		case "image":
+			job.Image = val
+		case "replicas":
+			job.Replicas, err = strconv.Atoi(val)
+		case "pods":
+			job.Pods, err = strconv.Atoi(val)
+		case "gpus":
+			job.GPUCount, err = strconv.Atoi(val)
+		case "cpu":
+			job.Cpu = val
+		case "memory":
+			job.Memory = val
+		default:
+			return job, fmt.Errorf("unknown key %v of option --replicated-job, only support: [name,image,replicas,pods,gpus,cpu,memory]", key)
+		}
+		if err != nil {
+			return job, fmt.Errorf("invalid %v of option --replicated-job %v: %v", key, value, err)
+		}
+	}
+	if job.Name == "" {
+		return job, fmt.Errorf("the name of replicated job must be set in option --replicated-job %v", value)
+	}
+	return job, nil
+}
+
+func (s *SubmitJobSetArgsBuilder) check() error {
+	if s.args.ShareMemory != "" {
+		if _, err := resource.ParseQuantity(s.args.ShareMemory); err != nil {
+			return fmt.Errorf("--share-memory is invalid")
+		}
+	}
+	names := map[string]bool{}
+	for _, job := range s.args.ReplicatedJobs {
+		if errs := validation.IsDNS1123Label(job.Name); len(errs) != 0 {
+			return fmt.Errorf("invalid name of replicated job %v: %v", job.Name, strings.Join(errs, ","))
+		}
+		if names[job.Name] {
+			return fmt.Errorf("the replicated job %v is duplicated", job.Name)
+		}
+		names[job.Name] = true
+		if job.Image == "" {
+			return fmt.Errorf("the image of replicated job %v must be set by --image or --replicated-job", job.Name)
+		}
+		if job.Command == "" {
+			return fmt.Errorf("the command of replicated job %v must be set by job args or --replicated-job-command", job.Name)
+		}
+		if job.Replicas <= 0 {
+			return fmt.Errorf("the replicas of replicated job %v must be greater than 0", job.Name)
+		}
+		if job.Pods <= 0 {
+			return fmt.Errorf("the pods of replicated job %v must be greater than 0", job.Name)
+		}
+		if job.GPUCount < 0 {
+			return fmt.Errorf("the gpus of replicated job %v is invalid", job.Name)
+		}
+		if job.Cpu != "" {
+			if _, err := resource.ParseQuantity(job.Cpu); err != nil {
+				return fmt.Errorf("the cpu of replicated job %v is invalid", job.Name)
+			}
+		}
+		if job.Memory != "" {
+			if _, err := resource.ParseQuantity(job.Memory); err != nil {
+				return fmt.Errorf("the memory of replicated job %v is invalid", job.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// addRequestGPUsToAnnotation counts the gpus of all the replicated jobs
+// instead of multiplying --workers and --gpus
+func (s *SubmitJobSetArgsBuilder) addRequestGPUsToAnnotation() {
+	if s.args.Annotations == nil {
+		s.args.Annotations = map[string]string{}
+	}
+	gpus := 0
+	for _, job := range s.args.ReplicatedJobs {
+		gpus += job.Replicas * job.Pods * job.GPUCount
+	}
+	s.args.Annotations[types.RequestGPUsOfJobAnnoKey] = fmt.Sprintf("%v", gpus)
+}
@@ -166,6 +166,10 @@ func (s *SubmitPytorchJobArgsBuilder) check() error {
 	if s.args.TTLSecondsAfterFinished < 0 {
 		return fmt.Errorf("--ttl-after-finished is invalid")
 	}
+	// the logs are exported by 'arena wait' or 'arena prune', they may be deleted with the job before that
+	if s.args.TTLSecondsAfterFinished > 0 && s.args.ExportLogsTo != "" {
+		return fmt.Errorf("--export-logs-to can not be used with --ttl-after-finished")
+	}
 	if s.args.ShareMemory != "" {
 		_, err := resource.ParseQuantity(s.args.ShareMemory)
 		if err != nil {
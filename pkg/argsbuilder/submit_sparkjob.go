@@ -76,6 +76,7 @@ func (s *SubmitSparkJobArgsBuilder) AddCommandFlags(command *cobra.Command) {
 	command.Flags().StringVar(&s.args.MainClass, "main-class", "org.apache.spark.examples.SparkPi", "main class of your jar")
 	command.Flags().StringVar(&s.args.Jar, "jar", "local:///opt/spark/examples/jars/spark-examples_2.12-3.5.0.jar", "jar path in image")
 	command.Flags().StringVar(&s.args.SparkVersion, "spark-version", "3.5.0", "the spark version of spark job")
+	AddDryRunFlag(command, &s.args.DryRun)
 
 	command.Flags().IntVar(&s.args.Driver.CPURequest, "driver-cpu-request", 1, "cpu request for driver pod")
 	command.Flags().StringVar(&s.args.Driver.MemoryRequest, "driver-memory-request", "500m", "memory request for driver pod (min is 500m)")
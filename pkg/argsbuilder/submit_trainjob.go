@@ -0,0 +1,147 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package argsbuilder
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+	trainerv1alpha1 "github.com/kubeflow/arena/pkg/operators/trainer-operator/apis/trainer/v1alpha1"
+)
+
+type SubmitTrainJobArgsBuilder struct {
+	args        *types.SubmitTrainJobArgs
+	argValues   map[string]interface{}
+	subBuilders map[string]ArgsBuilder
+}
+
+func NewSubmitTrainJobArgsBuilder(args *types.SubmitTrainJobArgs) ArgsBuilder {
+	args.TrainingType = types.TrainJob
+	s := &SubmitTrainJobArgsBuilder{
+		args:        args,
+		argValues:   map[string]interface{}{},
+		subBuilders: map[string]ArgsBuilder{},
+	}
+	s.AddSubBuilder(
+		NewSubmitArgsBuilder(&s.args.CommonSubmitArgs),
+	)
+	return s
+}
+
+func (s *SubmitTrainJobArgsBuilder) GetName() string {
+	items := strings.Split(fmt.Sprintf("%v", reflect.TypeOf(*s)), ".")
+	return items[len(items)-1]
+}
+
+func (s *SubmitTrainJobArgsBuilder) AddSubBuilder(builders ...ArgsBuilder) ArgsBuilder {
+	for _, b := range builders {
+		s.subBuilders[b.GetName()] = b
+	}
+	return s
+}
+
+func (s *SubmitTrainJobArgsBuilder) AddArgValue(key string, value interface{}) ArgsBuilder {
+	for name := range s.subBuilders {
+		s.subBuilders[name].AddArgValue(key, value)
+	}
+	s.argValues[key] = value
+	return s
+}
+
+func (s *SubmitTrainJobArgsBuilder) AddCommandFlags(command *cobra.Command) {
+	for name := range s.subBuilders {
+		s.subBuilders[name].AddCommandFlags(command)
+	}
+
+	command.Flags().StringVar(&s.args.Runtime, "runtime", "", "the name of the training runtime used by the job, like torch-distributed.")
+	command.Flags().StringVar(&s.args.RuntimeKind, "runtime-kind", trainerv1alpha1.ClusterTrainingRuntimeKind, "the kind of the training runtime, support ClusterTrainingRuntime and TrainingRuntime.")
+	command.Flags().StringVar(&s.args.Cpu, "cpu", "", "the cpu resource of each node to use for the training, like 1 for 1 core.")
+	command.Flags().StringVar(&s.args.Memory, "memory", "", "the memory resource of each node to use for the training, like 1Gi.")
+	command.Flags().StringVar(&s.args.NumProcPerNode, "nproc-per-node", "", "The number of processes per node, available values are \"auto\", \"cpu\", \"gpu\" and a number (e.g. 4).")
+	command.Flags().StringVar(&s.args.DatasetURI, "dataset-uri", "", "the storage uri of the dataset which is downloaded by the dataset initializer of runtime, like hf://tatsu-lab/alpaca.")
+	command.Flags().StringVar(&s.args.ModelURI, "model-uri", "", "the storage uri of the model which is downloaded by the model initializer of runtime, like hf://meta-llama/Llama-3.2-1B.")
+}
+
+func (s *SubmitTrainJobArgsBuilder) PreBuild() error {
+	for name := range s.subBuilders {
+		if err := s.subBuilders[name].PreBuild(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *SubmitTrainJobArgsBuilder) Build() error {
+	for name := range s.subBuilders {
+		if err := s.subBuilders[name].Build(); err != nil {
+			return err
+		}
+	}
+	if err := s.check(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *SubmitTrainJobArgsBuilder) check() error {
+	if s.args.Runtime == "" {
+		return fmt.Errorf("--runtime must be set")
+	}
+	switch s.args.RuntimeKind {
+	case trainerv1alpha1.ClusterTrainingRuntimeKind, trainerv1alpha1.TrainingRuntimeKind:
+		log.Debugf("Supported runtime kind: %s", s.args.RuntimeKind)
+	default:
+		return fmt.Errorf("unsupported runtime kind %s, only support %v and %v", s.args.RuntimeKind,
+			trainerv1alpha1.ClusterTrainingRuntimeKind, trainerv1alpha1.TrainingRuntimeKind)
+	}
+	if s.args.WorkerCount <= 0 {
+		return fmt.Errorf("--workers must be greater than 0")
+	}
+	if s.args.GPUCount < 0 {
+		return fmt.Errorf("--gpus is invalid")
+	}
+	if s.args.Cpu != "" {
+		_, err := resource.ParseQuantity(s.args.Cpu)
+		if err != nil {
+			return fmt.Errorf("--cpu is invalid")
+		}
+	}
+	if s.args.Memory != "" {
+		_, err := resource.ParseQuantity(s.args.Memory)
+		if err != nil {
+			return fmt.Errorf("--memory is invalid")
+		}
+	}
+	// Check whether numProcPerNode is valid
+	switch s.args.NumProcPerNode {
+	case "auto", "cpu", "gpu":
+		log.Debugf("Supported nprocPerNode: %s", s.args.NumProcPerNode)
+	case "":
+		log.Debugf("--nproc-per-node is not set")
+	default:
+		nprocPerNode, err := strconv.Atoi(s.args.NumProcPerNode)
+		if err != nil || nprocPerNode <= 0 {
+			return fmt.Errorf("--nproc-per-node is invalid")
+		}
+	}
+	return nil
+}
@@ -69,6 +69,7 @@ func (s *SubmitVolcanoJobArgsBuilder) AddCommandFlags(command *cobra.Command) {
 	)
 	command.Flags().StringVar(&s.args.Name, "name", "", "assign the job name")
 	_ = command.MarkFlagRequired("name")
+	AddDryRunFlag(command, &s.args.DryRun)
 	command.Flags().IntVar(&(s.args.MinAvailable), "minAvailable", 1, "The minimal available pods to run for this Job. default value is 1")
 	_ = command.Flags().MarkDeprecated("minAvailable", "please use --min-available instead")
 	command.Flags().IntVar(&(s.args.MinAvailable), "min-available", 1, "The minimal available pods to run for this Job. default value is 1")
@@ -20,8 +20,11 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/kubeflow/arena/pkg/apis/types"
+	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+
+	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/types"
 )
 
 type TrafficRouterArgsBuilder struct {
@@ -70,6 +73,7 @@ func (s *TrafficRouterArgsBuilder) AddCommandFlags(command *cobra.Command) {
 	command.Flags().StringArrayVarP(&versions, "version-weight", "v", []string{}, "set the version and weight,format is: version:weight, e.g. --version-weight version1:20 --version-weight version2:40")
 	//command.Flags().StringVar(&s.args.Versions, "versions", "", "Model versions which the traffic will be routed to, e.g. 1,2,3")
 	//command.Flags().StringVar(&s.args.Weights, "weights", "", "Weight percentage values for each model version which the traffic will be routed to,e.g. 70,20,10")
+	addTrafficRouterFlags(command, &s.args.TrafficRouterOptions)
 	_ = command.MarkFlagRequired("name")
 	_ = command.MarkFlagRequired("version-weight")
 	s.AddArgValue("version-weight", &versions)
@@ -96,6 +100,9 @@ func (s *TrafficRouterArgsBuilder) Build() error {
 	if err := s.setVersionWeights(); err != nil {
 		return err
 	}
+	if err := setTrafficRouterOptions(&s.args.TrafficRouterOptions); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -142,3 +149,31 @@ func (s *TrafficRouterArgsBuilder) setVersionWeights() error {
 	}
 	return nil
 }
+
+// addTrafficRouterFlags adds the options to select the traffic router
+func addTrafficRouterFlags(command *cobra.Command, options *types.TrafficRouterOptions) {
+	command.Flags().StringVar((*string)(&options.Router), "router", "", fmt.Sprintf("the traffic router, the possible option is %v or %v, default is trafficRouter of arena config or %v", types.IstioTrafficRouter, types.GatewayAPITrafficRouter, types.IstioTrafficRouter))
+	command.Flags().StringVar(&options.Gateway, "gateway", "", "the parent gateway of HTTPRoute like <namespace>/<name>, it is required to create HTTPRoute for the gateway-api router, default is trafficRouterGateway of arena config")
+}
+
+// setTrafficRouterOptions sets the traffic router options which are not given to the arena config
+func setTrafficRouterOptions(options *types.TrafficRouterOptions) error {
+	arenaConfig := config.GetArenaConfiger().GetConfigsFromConfigFile()
+	if options.Router == "" {
+		options.Router = types.IstioTrafficRouter
+		if router, found := arenaConfig["trafficRouter"]; found {
+			log.Debugf("traffic router load from arenaConfigs: %v", router)
+			options.Router = types.TrafficRouterType(router)
+		}
+	}
+	if options.Router != types.IstioTrafficRouter && options.Router != types.GatewayAPITrafficRouter {
+		return fmt.Errorf("unknown traffic router %v, the possible option is %v or %v", options.Router, types.IstioTrafficRouter, types.GatewayAPITrafficRouter)
+	}
+	if options.Gateway == "" && options.Router == types.GatewayAPITrafficRouter {
+		if gateway, found := arenaConfig["trafficRouterGateway"]; found {
+			log.Debugf("traffic router gateway load from arenaConfigs: %v", gateway)
+			options.Gateway = gateway
+		}
+	}
+	return nil
+}
@@ -66,6 +66,7 @@ func (s *UpdateCustomServingArgsBuilder) AddCommandFlags(command *cobra.Command)
 	for name := range s.subBuilders {
 		s.subBuilders[name].AddCommandFlags(command)
 	}
+	addServingAutoscaleFlags(command, &s.args.ServingAutoscaleArgs)
 }
 
 func (s *UpdateCustomServingArgsBuilder) PreBuild() error {
@@ -84,6 +85,8 @@ func (s *UpdateCustomServingArgsBuilder) Build() error {
 			return err
 		}
 	}
-
+	if err := setServingAutoscaleArgs(&s.args.ServingAutoscaleArgs); err != nil {
+		return err
+	}
 	return nil
 }
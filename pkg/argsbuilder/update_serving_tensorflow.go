@@ -73,6 +73,7 @@ func (s *UpdateTensorflowServingArgsBuilder) AddCommandFlags(command *cobra.Comm
 	command.Flags().StringVar(&s.args.ModelPath, "model-path", "", "the model path for serving in the container, ignored if --model-config-file flag is set, otherwise required")
 	command.Flags().StringVar(&s.args.ModelConfigFile, "model-config-file", "", "corresponding with --model_config_file in tensorflow serving")
 	command.Flags().StringVar(&s.args.MonitoringConfigFile, "monitoring-config-file", "", "corresponding with --monitoring_config_file in tensorflow serving")
+	addServingAutoscaleFlags(command, &s.args.ServingAutoscaleArgs)
 }
 
 func (s *UpdateTensorflowServingArgsBuilder) PreBuild() error {
@@ -95,6 +96,9 @@ func (s *UpdateTensorflowServingArgsBuilder) Build() error {
 	if err := s.preprocess(); err != nil {
 		return err
 	}
+	if err := setServingAutoscaleArgs(&s.args.ServingAutoscaleArgs); err != nil {
+		return err
+	}
 	return nil
 }
 
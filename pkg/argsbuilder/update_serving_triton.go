@@ -68,6 +68,7 @@ func (s *UpdateTritonServingArgsBuilder) AddCommandFlags(command *cobra.Command)
 	}
 	command.Flags().StringVar(&s.args.ModelRepository, "model-repository", "", "the path of triton model path")
 	command.Flags().BoolVar(&s.args.AllowMetrics, "allow-metrics", true, "open metrics")
+	addServingAutoscaleFlags(command, &s.args.ServingAutoscaleArgs)
 }
 
 func (s *UpdateTritonServingArgsBuilder) PreBuild() error {
@@ -86,6 +87,8 @@ func (s *UpdateTritonServingArgsBuilder) Build() error {
 			return err
 		}
 	}
-
+	if err := setServingAutoscaleArgs(&s.args.ServingAutoscaleArgs); err != nil {
+		return err
+	}
 	return nil
 }
@@ -16,11 +16,20 @@ package argsbuilder
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 
+	"github.com/spf13/cobra"
+
 	"github.com/kubeflow/arena/pkg/apis/types"
 )
 
+// AddDryRunFlag adds the option --dry-run which sets the dry run strategy of the job
+func AddDryRunFlag(command *cobra.Command, strategy *types.DryRunStrategy) {
+	command.Flags().StringVar((*string)(strategy), "dry-run", string(types.DryRunNone), fmt.Sprintf(`must be "%v", "%v", or "%v". If client, only print the rendered manifests of the job without sending them. If server, validate the manifests by the api server without persisting them`, types.DryRunNone, types.DryRunClient, types.DryRunServer))
+	command.Flags().Lookup("dry-run").NoOptDefVal = string(types.DryRunClient)
+}
+
 func transformSliceToMap(sets []string, split string) (valuesMap map[string]string) {
 	valuesMap = map[string]string{}
 	for _, member := range sets {
@@ -0,0 +1,76 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kubeflow/arena/pkg/apis/arenaclient"
+	apimanifest "github.com/kubeflow/arena/pkg/apis/manifest"
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+// NewApplyCommand creates the command to submit jobs from manifests
+func NewApplyCommand() *cobra.Command {
+	var filename string
+	var command = &cobra.Command{
+		Use:   "apply -f FILE",
+		Short: "Submit training or serving jobs described by a YAML manifest",
+		Long: `Submit training or serving jobs described by a YAML manifest.
+The manifest can contain multiple jobs separated by "---", the keys of spec are the options of
+'arena submit' and 'arena serve' without the leading "--". The jobs which are already running with
+the same spec are unchanged, use '-f -' to read the manifest from stdin.`,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			_ = viper.BindPFlags(cmd.Flags())
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if filename == "" {
+				cmd.HelpFunc()(cmd, args)
+				return fmt.Errorf("not set manifest file,please set it with '-f'")
+			}
+			manifests, err := apimanifest.Load(filename)
+			if err != nil {
+				return err
+			}
+			client, err := arenaclient.NewArenaClient(types.ArenaClientArgs{
+				Kubeconfig:     viper.GetString("config"),
+				LogLevel:       viper.GetString("loglevel"),
+				Namespace:      viper.GetString("namespace"),
+				ArenaNamespace: viper.GetString("arena-namespace"),
+				IsDaemonMode:   false,
+			})
+			if err != nil {
+				return err
+			}
+			for _, m := range manifests {
+				created, err := client.Manifest().Apply(m)
+				if err != nil {
+					return err
+				}
+				if created {
+					fmt.Printf("%v/%v created\n", m.Kind, m.Metadata.Name)
+				} else {
+					fmt.Printf("%v/%v unchanged\n", m.Kind, m.Metadata.Name)
+				}
+			}
+			return nil
+		},
+	}
+	command.Flags().StringVarP(&filename, "filename", "f", "", "the manifest file, '-' means stdin")
+	return command
+}
@@ -0,0 +1,88 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kubeflow/arena/pkg/apis/arenaclient"
+	apimanifest "github.com/kubeflow/arena/pkg/apis/manifest"
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+// NewDiffCommand creates the command to compare manifests with the running jobs
+func NewDiffCommand() *cobra.Command {
+	var filename string
+	var command = &cobra.Command{
+		Use:   "diff -f FILE",
+		Short: "Show the differences between a YAML manifest and the running jobs",
+		PreRun: func(cmd *cobra.Command, args []string) {
+			_ = viper.BindPFlags(cmd.Flags())
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if filename == "" {
+				cmd.HelpFunc()(cmd, args)
+				return fmt.Errorf("not set manifest file,please set it with '-f'")
+			}
+			manifests, err := apimanifest.Load(filename)
+			if err != nil {
+				return err
+			}
+			client, err := arenaclient.NewArenaClient(types.ArenaClientArgs{
+				Kubeconfig:     viper.GetString("config"),
+				LogLevel:       viper.GetString("loglevel"),
+				Namespace:      viper.GetString("namespace"),
+				ArenaNamespace: viper.GetString("arena-namespace"),
+				IsDaemonMode:   false,
+			})
+			if err != nil {
+				return err
+			}
+			for _, m := range manifests {
+				live, err := client.Manifest().Live(m)
+				if err != nil {
+					return err
+				}
+				if live == nil {
+					fmt.Printf("%v/%v not found, it will be created\n", m.Kind, m.Metadata.Name)
+					continue
+				}
+				items, err := apimanifest.Diff(m, live)
+				if err != nil {
+					return err
+				}
+				if len(items) == 0 {
+					fmt.Printf("%v/%v unchanged\n", m.Kind, m.Metadata.Name)
+					continue
+				}
+				fmt.Printf("%v/%v\n", m.Kind, m.Metadata.Name)
+				for _, item := range items {
+					if item.Live != "" {
+						fmt.Printf("- %v: %v\n", item.Key, item.Live)
+					}
+					if item.Desired != "" {
+						fmt.Printf("+ %v: %v\n", item.Key, item.Desired)
+					}
+				}
+			}
+			return nil
+		},
+	}
+	command.Flags().StringVarP(&filename, "filename", "f", "", "the manifest file, '-' means stdin")
+	return command
+}
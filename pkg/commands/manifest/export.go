@@ -0,0 +1,82 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	yaml "gopkg.in/yaml.v3"
+
+	"github.com/kubeflow/arena/pkg/apis/arenaclient"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/apis/utils"
+)
+
+// NewExportCommand creates the command to export the manifest of a running job
+func NewExportCommand() *cobra.Command {
+	var jobType string
+	var version string
+	var output string
+	var command = &cobra.Command{
+		Use:   "export JOB [-T JOB_TYPE] [--version VERSION]",
+		Short: "Export a running training or serving job as a YAML manifest",
+		PreRun: func(cmd *cobra.Command, args []string) {
+			_ = viper.BindPFlags(cmd.Flags())
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				cmd.HelpFunc()(cmd, args)
+				return fmt.Errorf("not set job name,please set it")
+			}
+			format := utils.TransferPrintFormat(output)
+			if format != types.YamlFormat && format != types.JsonFormat {
+				return fmt.Errorf("unknown output format,only support:[yaml,json]")
+			}
+			client, err := arenaclient.NewArenaClient(types.ArenaClientArgs{
+				Kubeconfig:     viper.GetString("config"),
+				LogLevel:       viper.GetString("loglevel"),
+				Namespace:      viper.GetString("namespace"),
+				ArenaNamespace: viper.GetString("arena-namespace"),
+				IsDaemonMode:   false,
+			})
+			if err != nil {
+				return err
+			}
+			m, err := client.Manifest().Export(args[0], jobType, version)
+			if err != nil {
+				return err
+			}
+			var data []byte
+			if format == types.JsonFormat {
+				data, err = json.MarshalIndent(m, "", "    ")
+				data = append(data, '\n')
+			} else {
+				data, err = yaml.Marshal(m)
+			}
+			if err != nil {
+				return err
+			}
+			fmt.Print(string(data))
+			return nil
+		},
+	}
+	command.Flags().StringVarP(&jobType, "type", "T", "", fmt.Sprintf("The job type, training types are matched firstly, the possible option is %v,%v. (optional)", utils.GetSupportTrainingJobTypesInfo(), utils.GetSupportServingJobTypesInfo()))
+	command.Flags().StringVar(&version, "version", "", "The version of serving job. (optional)")
+	command.Flags().StringVarP(&output, "output", "o", "yaml", "Output format. One of: yaml|json")
+	return command
+}
@@ -0,0 +1,45 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queue
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var (
+	queueLong = `manage kueue queues.
+
+Available Commands:
+  list,ls              List the local queues.
+  get                  Get the local queue and its workloads by name.
+    `
+)
+
+// NewQueueCommand manages the kueue queues
+func NewQueueCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "queue",
+		Short: "manage kueue queues.",
+		Long:  queueLong,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.HelpFunc()(cmd, args)
+		},
+	}
+
+	command.AddCommand(NewQueueListCommand())
+	command.AddCommand(NewQueueGetCommand())
+
+	return command
+}
@@ -22,8 +22,11 @@ import (
 	"github.com/kubeflow/arena/pkg/commands/data"
 	"github.com/kubeflow/arena/pkg/commands/evaluate"
 	"github.com/kubeflow/arena/pkg/commands/llm"
+	"github.com/kubeflow/arena/pkg/commands/manifest"
 	"github.com/kubeflow/arena/pkg/commands/model"
+	"github.com/kubeflow/arena/pkg/commands/queue"
 	"github.com/kubeflow/arena/pkg/commands/serving"
+	"github.com/kubeflow/arena/pkg/commands/template"
 	"github.com/kubeflow/arena/pkg/commands/top"
 	"github.com/kubeflow/arena/pkg/commands/training"
 )
@@ -52,6 +55,7 @@ func NewCommand() *cobra.Command {
 	command.PersistentFlags().String("config", "", "Path to a kube config. Only required if out-of-cluster")
 	command.PersistentFlags().StringP("namespace", "n", "", "the namespace of the job")
 	command.PersistentFlags().Bool("helm-binary", false, "use helm binary to submit job")
+	command.PersistentFlags().Bool("kubectl-binary", false, "use kubectl binary to create and delete the resources of job")
 	command.AddCommand(training.NewSubmitCommand())
 	command.AddCommand(training.NewScaleOutCommand())
 	command.AddCommand(training.NewScaleInCommand())
@@ -59,18 +63,28 @@ func NewCommand() *cobra.Command {
 	command.AddCommand(training.NewListCommand())
 	command.AddCommand(training.NewPruneCommand())
 	command.AddCommand(training.NewGetCommand())
+	command.AddCommand(training.NewDiagnoseCommand())
 	command.AddCommand(training.NewAttachCommand())
 	command.AddCommand(training.NewLogViewerCommand())
 	command.AddCommand(training.NewLogsCommand())
 	command.AddCommand(training.NewDeleteCommand())
+	command.AddCommand(training.NewWaitCommand())
+	command.AddCommand(training.NewSuspendCommand())
+	command.AddCommand(training.NewResumeCommand())
+	command.AddCommand(training.NewResubmitCommand())
 	command.AddCommand(top.NewTopCommand())
 	command.AddCommand(NewVersionCmd(CLIName))
 	command.AddCommand(data.NewDataCommand())
 	command.AddCommand(cron.NewCronCommand())
+	command.AddCommand(queue.NewQueueCommand())
+	command.AddCommand(template.NewTemplateCommand())
 	command.AddCommand(NewCompletionCommand())
 	command.AddCommand(evaluate.NewEvaluateCommand())
 	command.AddCommand(NewWhoamiCommand())
 	command.AddCommand(model.NewModelCommand())
 	command.AddCommand(llm.NewLLMCommand())
+	command.AddCommand(manifest.NewApplyCommand())
+	command.AddCommand(manifest.NewDiffCommand())
+	command.AddCommand(manifest.NewExportCommand())
 	return command
 }
@@ -0,0 +1,81 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kubeflow/arena/pkg/apis/arenaclient"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/apis/utils"
+)
+
+// NewDescribeCommand creates the command to diagnose the serving job
+func NewDescribeCommand() *cobra.Command {
+	var servingType string
+	var version string
+	var output string
+	var bashCompletionFlags = map[string]string{
+		"version": "__arena_serve_all_version",
+		"type":    "__arena_serve_all_type",
+	}
+	var command = &cobra.Command{
+		Use:     "describe SERVING_NAME [-T JOB_TYPE] [-v JOB_VERSION]",
+		Aliases: []string{"diagnose"},
+		Short:   "Describe the serving job and diagnose why it is not available",
+		Long: `Describe the serving job and diagnose why it is not available. The report contains the conditions
+of the deployment, LeaderWorkerSet or InferenceService, the events of the serving job and its instances,
+and the diagnosed problems such as insufficient gpu, untolerated taints, image pull failures, crash loops,
+OOM kills and probe failures.`,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			_ = viper.BindPFlags(cmd.Flags())
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				cmd.HelpFunc()(cmd, args)
+				return fmt.Errorf("not set serving name,please set it")
+			}
+			client, err := arenaclient.NewArenaClient(types.ArenaClientArgs{
+				Kubeconfig:     viper.GetString("config"),
+				LogLevel:       viper.GetString("loglevel"),
+				Namespace:      viper.GetString("namespace"),
+				ArenaNamespace: viper.GetString("arena-namespace"),
+				IsDaemonMode:   false,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create arena client: %v", err)
+			}
+			return client.Serving().DescribeAndPrint(args[0], version, utils.TransferServingJobType(servingType), output)
+		},
+	}
+	command.Flags().StringVarP(&version, "version", "v", "", "Set the serving job version")
+	command.Flags().StringVarP(&servingType, "type", "T", "", fmt.Sprintf("The serving type, the possible option is [%v]. (optional)", utils.GetSupportServingJobTypesInfo()))
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	for name, completion := range bashCompletionFlags {
+		if command.Flag(name) != nil {
+			if command.Flag(name).Annotations == nil {
+				command.Flag(name).Annotations = map[string][]string{}
+			}
+			command.Flag(name).Annotations[cobra.BashCompCustom] = append(
+				command.Flag(name).Annotations[cobra.BashCompCustom],
+				completion,
+			)
+		}
+	}
+	return command
+}
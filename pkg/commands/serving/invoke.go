@@ -0,0 +1,137 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kubeflow/arena/pkg/apis/arenaclient"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/apis/utils"
+)
+
+// NewInvokeCommand creates the command to send a request to the inference api of serving job
+func NewInvokeCommand() *cobra.Command {
+	var servingType string
+	var version string
+	var data string
+	invokeArgs := &types.ServingInvokeArgs{}
+	var bashCompletionFlags = map[string]string{
+		"version": "__arena_serve_all_version",
+		"type":    "__arena_serve_all_type",
+	}
+	var command = &cobra.Command{
+		Use:   "invoke SERVING_NAME [-v VERSION] [--data @payload.json]",
+		Short: "Send a request to the inference api of serving job",
+		Long: `Send a request to the inference api of serving job and print the response and latency.
+The request path is inferred by the serving type:
+  tf-serving:          /v1/models/<model>:predict
+  triton-serving:      /v2/models/<model>/infer
+  kserve:              /v1/models/<model>:predict or /v2/models/<model>/infer
+  seldon-serving:      /api/v1.0/predictions
+  vllm/sglang-serving: /v1/chat/completions if the request has messages, otherwise /v1/completions
+With --grpc the request is sent to the gRPC predict api of tf-serving or triton, it is the json of
+PredictRequest or ModelInferRequest and the model name is the serving name if it is not set.
+By default the request is sent through a port forwarding to a ready instance of the serving job.`,
+		Example: `  # predict with a tensorflow serving job
+  arena serve invoke mnist --data @payload.json
+
+  # chat with a vllm serving job
+  arena serve invoke qwen -T vllm --data '{"model":"qwen","messages":[{"role":"user","content":"hello"}]}'
+
+  # predict with the grpc api of a triton serving job
+  arena serve invoke triton-mnist --grpc --data @infer_request.json
+
+  # send the request to the cluster ip of serving job
+  arena serve invoke mnist -v v1 --data @payload.json --port-forward=false`,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			_ = viper.BindPFlags(cmd.Flags())
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				cmd.HelpFunc()(cmd, args)
+				return fmt.Errorf("not set serving name,please set it")
+			}
+			client, err := arenaclient.NewArenaClient(types.ArenaClientArgs{
+				Kubeconfig:     viper.GetString("config"),
+				LogLevel:       viper.GetString("loglevel"),
+				Namespace:      viper.GetString("namespace"),
+				ArenaNamespace: viper.GetString("arena-namespace"),
+				IsDaemonMode:   false,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create arena client: %v", err)
+			}
+			body, err := readInvokeData(data)
+			if err != nil {
+				return err
+			}
+			invokeArgs.Name = args[0]
+			invokeArgs.Version = version
+			invokeArgs.Type = utils.TransferServingJobType(servingType)
+			invokeArgs.Data = body
+			ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+			return client.Serving().InvokeAndPrint(ctx, invokeArgs)
+		},
+	}
+	command.Flags().StringVarP(&version, "version", "v", "", "Set the serving job version")
+	command.Flags().StringVarP(&servingType, "type", "T", "", fmt.Sprintf("The serving type, the possible option is [%v]. (optional)", utils.GetSupportServingJobTypesInfo()))
+	command.Flags().StringVarP(&data, "data", "d", "", "The request body, use @FILE to read it from a file or @- from stdin, the request is sent with GET if it is not set")
+	command.Flags().StringVar(&invokeArgs.ModelName, "model-name", "", "The model name in the request path, default is the serving name")
+	command.Flags().StringVar(&invokeArgs.Path, "path", "", "The request path, it replaces the path inferred by the serving type")
+	command.Flags().StringVar(&invokeArgs.Protocol, "protocol", "", "The inference protocol of kserve, v1 or v2, default is the protocol of the predictor")
+	command.Flags().BoolVar(&invokeArgs.GRPC, "grpc", false, "Send the request to the gRPC predict api of tf-serving or triton, the request is the protobuf message in json")
+	command.Flags().BoolVar(&invokeArgs.PortForward, "port-forward", true, "Send the request through a port forwarding to the serving instance, otherwise send it to the address of serving job")
+	command.Flags().DurationVar(&invokeArgs.Timeout, "timeout", 30*time.Second, "The timeout of the request")
+	for name, completion := range bashCompletionFlags {
+		if command.Flag(name) != nil {
+			if command.Flag(name).Annotations == nil {
+				command.Flag(name).Annotations = map[string][]string{}
+			}
+			command.Flag(name).Annotations[cobra.BashCompCustom] = append(
+				command.Flag(name).Annotations[cobra.BashCompCustom],
+				completion,
+			)
+		}
+	}
+	return command
+}
+
+// readInvokeData reads the request body from a file if it starts with @
+func readInvokeData(data string) ([]byte, error) {
+	if !strings.HasPrefix(data, "@") {
+		return []byte(data), nil
+	}
+	file := strings.TrimPrefix(data, "@")
+	if file == "-" {
+		return io.ReadAll(os.Stdin)
+	}
+	body, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the request body: %v", err)
+	}
+	return body, nil
+}
@@ -15,7 +15,11 @@
 package serving
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/kubeflow/arena/pkg/apis/arenaclient"
 	"github.com/kubeflow/arena/pkg/apis/types"
@@ -26,6 +30,7 @@ import (
 
 func NewListCommand() *cobra.Command {
 	var allNamespaces bool
+	var watch bool
 	var format string
 	var servingType string
 	var command = &cobra.Command{
@@ -41,11 +46,16 @@ func NewListCommand() *cobra.Command {
 				LogLevel:       viper.GetString("loglevel"),
 				Namespace:      viper.GetString("namespace"),
 				ArenaNamespace: viper.GetString("arena-namespace"),
-				IsDaemonMode:   false,
+				IsDaemonMode:   watch,
 			})
 			if err != nil {
 				return fmt.Errorf("failed to create arena client: %v", err)
 			}
+			if watch {
+				ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+				defer cancel()
+				return client.Serving().WatchAndPrint(ctx, allNamespaces, utils.TransferServingJobType(servingType), format)
+			}
 			return client.Serving().ListAndPrint(allNamespaces, utils.TransferServingJobType(servingType), format)
 		},
 	}
@@ -53,6 +63,7 @@ func NewListCommand() *cobra.Command {
 	_ = command.Flags().MarkDeprecated("allNamespaces", "please use --all-namespaces instead")
 	command.Flags().BoolVarP(&allNamespaces, "all-namespaces", "A", false, "show all the namespaces")
 	command.Flags().StringVarP(&format, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	command.Flags().BoolVarP(&watch, "watch", "w", false, "After listing the jobs, watch for changes and print ADDED/MODIFIED/DELETED events, only support output format wide and json(newline-delimited)")
 	command.Flags().StringVarP(&servingType, "type", "T", "", fmt.Sprintf("The serving type, the possible option is [%v]. (optional)", utils.GetSupportServingJobTypesInfo()))
 	return command
 }
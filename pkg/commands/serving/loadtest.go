@@ -0,0 +1,107 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kubeflow/arena/pkg/apis/arenaclient"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/apis/utils"
+)
+
+// NewLoadTestCommand creates the command to run a load test against the versions of serving job
+func NewLoadTestCommand() *cobra.Command {
+	var servingType string
+	var data string
+	var output string
+	loadTestArgs := &types.ServingLoadTestArgs{}
+	var bashCompletionFlags = map[string]string{
+		"version": "__arena_serve_all_version",
+		"type":    "__arena_serve_all_type",
+	}
+	var command = &cobra.Command{
+		Use:   "loadtest SERVING_NAME [-v VERSION]... [--qps QPS] [--concurrency N] [--duration 1m] [--data @payload.json]",
+		Short: "Run a load test against the serving job",
+		Long: `Run a load generator job in the cluster against the serving job and report the latency percentiles,
+throughput and error rate. If multiple versions are given, they are tested one by one with the same load
+so the results are comparable. The request path is inferred by the serving type as 'arena serve invoke'.`,
+		Example: `  # send 50 requests per second with 8 connections for 2 minutes
+  arena serve loadtest mnist --qps 50 --concurrency 8 --duration 2m --data @payload.json
+
+  # compare the versions v1 and v2 as fast as possible
+  arena serve loadtest mnist -v v1 -v v2 --concurrency 16 --duration 1m --data @payload.json -o json`,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			_ = viper.BindPFlags(cmd.Flags())
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				cmd.HelpFunc()(cmd, args)
+				return fmt.Errorf("not set serving name,please set it")
+			}
+			client, err := arenaclient.NewArenaClient(types.ArenaClientArgs{
+				Kubeconfig:     viper.GetString("config"),
+				LogLevel:       viper.GetString("loglevel"),
+				Namespace:      viper.GetString("namespace"),
+				ArenaNamespace: viper.GetString("arena-namespace"),
+				IsDaemonMode:   false,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create arena client: %v", err)
+			}
+			body, err := readInvokeData(data)
+			if err != nil {
+				return err
+			}
+			loadTestArgs.Name = args[0]
+			loadTestArgs.Type = utils.TransferServingJobType(servingType)
+			loadTestArgs.Data = body
+			ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+			return client.Serving().LoadTestAndPrint(ctx, loadTestArgs, output)
+		},
+	}
+	command.Flags().StringSliceVarP(&loadTestArgs.Versions, "version", "v", []string{}, "The serving job versions to test, they are tested one by one with the same load")
+	command.Flags().StringVarP(&servingType, "type", "T", "", fmt.Sprintf("The serving type, the possible option is [%v]. (optional)", utils.GetSupportServingJobTypesInfo()))
+	command.Flags().StringVarP(&data, "data", "d", "", "The request body, use @FILE to read it from a file or @- from stdin, the requests are sent with GET if it is not set")
+	command.Flags().StringVar(&loadTestArgs.ModelName, "model-name", "", "The model name in the request path, default is the serving name")
+	command.Flags().StringVar(&loadTestArgs.Path, "path", "", "The request path, it replaces the path inferred by the serving type")
+	command.Flags().IntVar(&loadTestArgs.QPS, "qps", 0, "The target requests per second of all connections, 0 means as fast as possible")
+	command.Flags().IntVar(&loadTestArgs.Concurrency, "concurrency", 4, "The number of parallel connections")
+	command.Flags().DurationVar(&loadTestArgs.Duration, "duration", time.Minute, "The duration of the load test of each version")
+	command.Flags().StringVar(&loadTestArgs.Image, "image", "", "The image of load generator, it must provide the fortio command, default is fortio/fortio:1.75.3")
+	command.Flags().StringArrayVar(&loadTestArgs.ImagePullSecrets, "image-pull-secret", []string{}, `giving names of imagePullSecret when you want to use a private registry, usage:"--image-pull-secret <name1>"`)
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	for name, completion := range bashCompletionFlags {
+		if command.Flag(name) != nil {
+			if command.Flag(name).Annotations == nil {
+				command.Flag(name).Annotations = map[string][]string{}
+			}
+			command.Flag(name).Annotations[cobra.BashCompCustom] = append(
+				command.Flag(name).Annotations[cobra.BashCompCustom],
+				completion,
+			)
+		}
+	}
+	return command
+}
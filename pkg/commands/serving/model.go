@@ -0,0 +1,82 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kubeflow/arena/pkg/apis/arenaclient"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/apis/utils"
+)
+
+var (
+	serveModelLong = `manage the models of a shared model server.
+
+The model server is a triton serving job or a ServingRuntime of KServe ModelMesh.
+
+Available Commands:
+  add            Add a model to the model server
+  remove,rm      Remove a model from the model server
+  list,ls        List the models of the model server`
+)
+
+func NewModelCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "model",
+		Short: "Manage the models of a shared model server.",
+		Long:  serveModelLong,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.HelpFunc()(cmd, args)
+		},
+	}
+	command.AddCommand(NewModelAddCommand())
+	command.AddCommand(NewModelRemoveCommand())
+	command.AddCommand(NewModelListCommand())
+
+	return command
+}
+
+// addModelServerFlags adds the flags which select the model server
+func addModelServerFlags(command *cobra.Command, args *types.ServingModelArgs, serverType *string) {
+	command.Flags().StringVar(&args.Server, "server", "", "the model server, it is the name of a triton serving job or a ServingRuntime of KServe ModelMesh")
+	command.Flags().StringVar(&args.ServerVersion, "server-version", "", "the version of triton serving job")
+	command.Flags().StringVarP(serverType, "type", "T", "", "the type of model server, triton or kserve, a triton serving job is searched first if it is not set")
+	_ = command.MarkFlagRequired("server")
+}
+
+// newModelServerClient creates the arena client and sets the type of model server
+func newModelServerClient(args *types.ServingModelArgs, serverType string) (*arenaclient.ArenaClient, error) {
+	if serverType != "" {
+		args.ServerType = utils.TransferServingJobType(serverType)
+		if args.ServerType != types.TritonServingJob && args.ServerType != types.KServeJob {
+			return nil, fmt.Errorf("the type of model server must be triton or kserve, but got %v", serverType)
+		}
+	}
+	client, err := arenaclient.NewArenaClient(types.ArenaClientArgs{
+		Kubeconfig:     viper.GetString("config"),
+		LogLevel:       viper.GetString("loglevel"),
+		Namespace:      viper.GetString("namespace"),
+		ArenaNamespace: viper.GetString("arena-namespace"),
+		IsDaemonMode:   false,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create arena client: %v", err)
+	}
+	return client, nil
+}
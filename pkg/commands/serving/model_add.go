@@ -0,0 +1,79 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+// NewModelAddCommand creates the command to add a model to a model server
+func NewModelAddCommand() *cobra.Command {
+	var serverType string
+	var configFile string
+	modelArgs := &types.ServingModelArgs{}
+	var command = &cobra.Command{
+		Use:   "add MODEL_NAME --server SERVER --model-path PATH [--platform PLATFORM | --model-format FORMAT]",
+		Short: "Add a model to the model server",
+		Long: `Add a model to the model server.
+For triton, the model is copied from --model-path in the triton container to <model-repository>/<model>/<model-version>,
+a config.pbtxt is generated by --platform and --max-batch-size unless --config-file is set, then the model is loaded
+by the model repository api. For KServe ModelMesh, an InferenceService in ModelMesh mode is created with --model-path
+as its storage uri and served by the ServingRuntime.`,
+		Example: `  # add an onnx model to the triton serving job triton-shared
+  arena serve model add mnist --server triton-shared --model-path /data/models/mnist.onnx --platform onnxruntime_onnx --max-batch-size 8
+
+  # add a sklearn model to the ModelMesh runtime mlserver-1.x
+  arena serve model add iris --server mlserver-1.x -T kserve --model-format sklearn --model-path s3://models/sklearn/iris`,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			_ = viper.BindPFlags(cmd.Flags())
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				cmd.HelpFunc()(cmd, args)
+				return fmt.Errorf("not set model name,please set it")
+			}
+			if modelArgs.ModelVersion <= 0 {
+				return fmt.Errorf("--model-version must be greater than 0")
+			}
+			if configFile != "" {
+				config, err := os.ReadFile(configFile)
+				if err != nil {
+					return fmt.Errorf("failed to read config file: %v", err)
+				}
+				modelArgs.Config = config
+			}
+			client, err := newModelServerClient(modelArgs, serverType)
+			if err != nil {
+				return err
+			}
+			modelArgs.Name = args[0]
+			return client.Serving().AddModel(modelArgs)
+		},
+	}
+	addModelServerFlags(command, modelArgs, &serverType)
+	command.Flags().StringVar(&modelArgs.ModelPath, "model-path", "", "the path of model in the triton container, or the storage uri of ModelMesh model")
+	command.Flags().IntVar(&modelArgs.ModelVersion, "model-version", 1, "the version directory of triton model")
+	command.Flags().StringVar(&modelArgs.Platform, "platform", "onnxruntime_onnx", "the platform or backend of triton model, one of: onnxruntime_onnx|tensorrt_plan|pytorch_libtorch|tensorflow_savedmodel|tensorflow_graphdef|python")
+	command.Flags().IntVar(&modelArgs.MaxBatchSize, "max-batch-size", 0, "the max_batch_size of triton model config")
+	command.Flags().StringVar(&configFile, "config-file", "", "the local config.pbtxt of triton model, it replaces the generated config")
+	command.Flags().StringVar(&modelArgs.ModelFormat, "model-format", "", "the model format of ModelMesh model, like onnx, sklearn or xgboost")
+	return command
+}
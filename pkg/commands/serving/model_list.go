@@ -0,0 +1,48 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+// NewModelListCommand creates the command to list the models of a model server
+func NewModelListCommand() *cobra.Command {
+	var serverType string
+	var output string
+	modelArgs := &types.ServingModelArgs{}
+	var command = &cobra.Command{
+		Use:     "list --server SERVER",
+		Short:   "List the models of the model server",
+		Long:    "List the models of the model server with their states, the states come from the repository index api of triton or the model status of ModelMesh.",
+		Aliases: []string{"ls"},
+		PreRun: func(cmd *cobra.Command, args []string) {
+			_ = viper.BindPFlags(cmd.Flags())
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := newModelServerClient(modelArgs, serverType)
+			if err != nil {
+				return err
+			}
+			return client.Serving().ListModelsAndPrint(modelArgs, output)
+		},
+	}
+	addModelServerFlags(command, modelArgs, &serverType)
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return command
+}
@@ -0,0 +1,53 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+// NewModelRemoveCommand creates the command to remove a model from a model server
+func NewModelRemoveCommand() *cobra.Command {
+	var serverType string
+	modelArgs := &types.ServingModelArgs{}
+	var command = &cobra.Command{
+		Use:     "remove MODEL_NAME --server SERVER",
+		Short:   "Remove a model from the model server",
+		Long:    "Remove a model from the model server. For triton, the model is unloaded and deleted from the model repository.",
+		Aliases: []string{"rm"},
+		PreRun: func(cmd *cobra.Command, args []string) {
+			_ = viper.BindPFlags(cmd.Flags())
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				cmd.HelpFunc()(cmd, args)
+				return fmt.Errorf("not set model name,please set it")
+			}
+			client, err := newModelServerClient(modelArgs, serverType)
+			if err != nil {
+				return err
+			}
+			modelArgs.Name = args[0]
+			return client.Serving().RemoveModel(modelArgs)
+		},
+	}
+	addModelServerFlags(command, modelArgs, &serverType)
+	return command
+}
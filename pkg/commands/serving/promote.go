@@ -0,0 +1,83 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kubeflow/arena/pkg/apis/arenaclient"
+	"github.com/kubeflow/arena/pkg/apis/serving"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/apis/utils"
+)
+
+// NewPromoteCommand creates the command to promote a serving version to stable
+func NewPromoteCommand() *cobra.Command {
+	var servingType string
+	builder := serving.NewServingPromoteBuilder()
+	var command = &cobra.Command{
+		Use:   "promote SERVING_NAME --version VERSION [--old-versions keep|scale-down|delete] [--grace-period 5m]",
+		Short: "Promote a version of serving job to stable and route all traffic to it",
+		Long: `Promote a version of serving job to stable for blue/green deployment.
+After all instances of the version are available, all traffic is routed to it and it is labeled as stable,
+the other versions are shown as candidates in 'arena serve list'. The other versions can be scaled down
+or deleted after the grace period.`,
+		Example: `  # route all traffic to v2 and keep v1 for a quick switch back
+  arena serve promote mnist --version v2
+
+  # route all traffic to v2 and delete the other versions 10 minutes later
+  arena serve promote mnist --version v2 --old-versions delete --grace-period 10m`,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			_ = viper.BindPFlags(cmd.Flags())
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				cmd.HelpFunc()(cmd, args)
+				return fmt.Errorf("not set serving name,please set it")
+			}
+			client, err := arenaclient.NewArenaClient(types.ArenaClientArgs{
+				Kubeconfig:     viper.GetString("config"),
+				LogLevel:       viper.GetString("loglevel"),
+				Namespace:      viper.GetString("namespace"),
+				ArenaNamespace: viper.GetString("arena-namespace"),
+				IsDaemonMode:   false,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create arena client: %v", err)
+			}
+			jobType := utils.TransferServingJobType(servingType)
+			if jobType == types.UnknownServingJob {
+				return fmt.Errorf("unknown serving job type,arena only supports: [%s]", utils.GetSupportServingJobTypesInfo())
+			}
+			promoteArgs, err := builder.Name(args[0]).Type(jobType).Build()
+			if err != nil {
+				return fmt.Errorf("failed to validate args: %v", err)
+			}
+			ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+			return client.Serving().Promote(ctx, promoteArgs)
+		},
+	}
+	command.Flags().StringVarP(&servingType, "type", "T", "", fmt.Sprintf("The serving type, the possible option is %v. (optional)", utils.GetSupportServingJobTypesInfo()))
+	builder.AddCommandFlags(command)
+	return command
+}
@@ -0,0 +1,87 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kubeflow/arena/pkg/apis/arenaclient"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/apis/utils"
+)
+
+// NewRollbackCommand creates the command to roll back serving job to a recorded revision
+func NewRollbackCommand() *cobra.Command {
+	var servingType string
+	rollbackArgs := &types.ServingRollbackArgs{}
+	var bashCompletionFlags = map[string]string{
+		"version": "__arena_serve_all_version",
+		"type":    "__arena_serve_all_type",
+	}
+	var command = &cobra.Command{
+		Use:   "rollback SERVING_NAME [-T JOB_TYPE] [-v JOB_VERSION] [--to-revision N]",
+		Short: "Roll back serving job to a recorded revision",
+		Long: `Roll back serving job to a revision listed by 'arena serve history'. The rollback is
+recorded as a new revision, and the serving job is rolled back to the previous revision if --to-revision is not set.`,
+		Example: `  # list the revisions of serving job
+  arena serve history mnist -v v1
+
+  # roll back to revision 2
+  arena serve rollback mnist -v v1 --to-revision 2`,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			_ = viper.BindPFlags(cmd.Flags())
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				cmd.HelpFunc()(cmd, args)
+				return fmt.Errorf("not set serving name,please set it")
+			}
+			if rollbackArgs.ToRevision < 0 {
+				return fmt.Errorf("--to-revision must not be negative")
+			}
+			client, err := arenaclient.NewArenaClient(types.ArenaClientArgs{
+				Kubeconfig:     viper.GetString("config"),
+				LogLevel:       viper.GetString("loglevel"),
+				Namespace:      viper.GetString("namespace"),
+				ArenaNamespace: viper.GetString("arena-namespace"),
+				IsDaemonMode:   false,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create arena client: %v", err)
+			}
+			rollbackArgs.Name = args[0]
+			rollbackArgs.Type = utils.TransferServingJobType(servingType)
+			return client.Serving().Rollback(rollbackArgs)
+		},
+	}
+	command.Flags().StringVarP(&rollbackArgs.Version, "version", "v", "", "Set the serving job version")
+	command.Flags().StringVarP(&servingType, "type", "T", "", fmt.Sprintf("The serving type, the possible option is [%v]. (optional)", utils.GetSupportServingJobTypesInfo()))
+	command.Flags().IntVar(&rollbackArgs.ToRevision, "to-revision", 0, "The revision to roll back to, default is the previous revision")
+	for name, completion := range bashCompletionFlags {
+		if command.Flag(name) != nil {
+			if command.Flag(name).Annotations == nil {
+				command.Flag(name).Annotations = map[string][]string{}
+			}
+			command.Flag(name).Annotations[cobra.BashCompCustom] = append(
+				command.Flag(name).Annotations[cobra.BashCompCustom],
+				completion,
+			)
+		}
+	}
+	return command
+}
@@ -0,0 +1,85 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kubeflow/arena/pkg/apis/arenaclient"
+	"github.com/kubeflow/arena/pkg/apis/serving"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/apis/utils"
+)
+
+// NewRolloutCommand creates the command to roll out a new serving version progressively
+func NewRolloutCommand() *cobra.Command {
+	var servingType string
+	builder := serving.NewServingRolloutBuilder()
+	var command = &cobra.Command{
+		Use:   "rollout SERVING_NAME --from VERSION --to VERSION [--steps 10,25,50,100] [--interval 5m]",
+		Short: "Shift the traffic of serving job to a new version step by step",
+		Long: `Shift the traffic of serving job to a new version step by step.
+Before each step all instances of the new version must be available, and after each step
+the prometheus queries of the new version are checked if they are given. The traffic weights
+before rollout are restored if any check fails or the command is interrupted.`,
+		Example: `  # shift the traffic from v1 to v2 in 4 steps, 5 minutes per step
+  arena serve rollout mnist --from v1 --to v2 --steps 10,25,50,100 --interval 5m
+
+  # roll back if the error rate of v2 exceeds 1%
+  arena serve rollout mnist --from v1 --to v2 \
+    --error-rate-query 'sum(rate(istio_requests_total{destination_workload_namespace="{{.Namespace}}",destination_version="{{.Version}}",response_code=~"5.."}[1m])) / sum(rate(istio_requests_total{destination_workload_namespace="{{.Namespace}}",destination_version="{{.Version}}"}[1m]))' \
+    --max-error-rate 0.01`,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			_ = viper.BindPFlags(cmd.Flags())
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				cmd.HelpFunc()(cmd, args)
+				return fmt.Errorf("not set serving name,please set it")
+			}
+			client, err := arenaclient.NewArenaClient(types.ArenaClientArgs{
+				Kubeconfig:     viper.GetString("config"),
+				LogLevel:       viper.GetString("loglevel"),
+				Namespace:      viper.GetString("namespace"),
+				ArenaNamespace: viper.GetString("arena-namespace"),
+				IsDaemonMode:   false,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create arena client: %v", err)
+			}
+			jobType := utils.TransferServingJobType(servingType)
+			if jobType == types.UnknownServingJob {
+				return fmt.Errorf("unknown serving job type,arena only supports: [%s]", utils.GetSupportServingJobTypesInfo())
+			}
+			rolloutArgs, err := builder.Name(args[0]).Type(jobType).Build()
+			if err != nil {
+				return fmt.Errorf("failed to validate args: %v", err)
+			}
+			ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+			return client.Serving().Rollout(ctx, rolloutArgs)
+		},
+	}
+	command.Flags().StringVarP(&servingType, "type", "T", "", fmt.Sprintf("The serving type, the possible option is %v. (optional)", utils.GetSupportServingJobTypesInfo()))
+	builder.AddCommandFlags(command)
+	return command
+}
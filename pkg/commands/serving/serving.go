@@ -26,7 +26,9 @@ Available Commands:
   kfserving,kfs  Submit a kubeflow Serving Job
   kserve         Submit a KServe Serving Job
   seldon         Submit a Seldon Serving Job
-  distributed    Submit a Distributed Serving Job`
+  distributed    Submit a Distributed Serving Job
+  vllm           Submit a vLLM Serving Job
+  sglang         Submit a SGLang Serving Job`
 )
 
 func NewServeCommand() *cobra.Command {
@@ -46,13 +48,23 @@ func NewServeCommand() *cobra.Command {
 	command.AddCommand(NewSubmitSeldonServingJobCommand())
 	command.AddCommand(NewSubmitTritonServingJobCommand())
 	command.AddCommand(NewSubmitDistributedServingJobCommand())
+	command.AddCommand(NewSubmitVLLMServingJobCommand())
+	command.AddCommand(NewSubmitSGLangServingJobCommand())
 	command.AddCommand(NewListCommand())
 	command.AddCommand(NewDeleteCommand())
 	command.AddCommand(NewGetCommand())
+	command.AddCommand(NewDescribeCommand())
 	command.AddCommand(NewAttachCommand())
 	command.AddCommand(NewLogsCommand())
 	command.AddCommand(NewTrafficRouterSplitCommand())
+	command.AddCommand(NewRolloutCommand())
+	command.AddCommand(NewPromoteCommand())
+	command.AddCommand(NewInvokeCommand())
+	command.AddCommand(NewLoadTestCommand())
+	command.AddCommand(NewModelCommand())
 	command.AddCommand(NewUpdateCommand())
+	command.AddCommand(NewHistoryCommand())
+	command.AddCommand(NewRollbackCommand())
 
 	return command
 }
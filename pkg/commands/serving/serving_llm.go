@@ -0,0 +1,79 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"fmt"
+
+	"github.com/kubeflow/arena/pkg/apis/arenaclient"
+	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/serving"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	vllmServingExample = `
+  # serve a model from huggingface with 2 gpus, the tensor parallel size is 2 by default
+  arena serve vllm --name=qwen --gpus=2 --model-path=Qwen/Qwen2.5-7B-Instruct
+
+  # serve a model from pvc, the args after "--" are appended to the vllm command
+  arena serve vllm --name=llama --gpus=1 --data=models:/models --model-path=/models/llama \
+    --max-model-len=8192 -- --enable-prefix-caching`
+
+	sglangServingExample = `
+  # serve a model from huggingface with 2 gpus, the tensor parallel size is 2 by default
+  arena serve sglang --name=qwen --gpus=2 --model-path=Qwen/Qwen2.5-7B-Instruct`
+)
+
+func NewSubmitVLLMServingJobCommand() *cobra.Command {
+	return newSubmitLLMServingJobCommand(serving.NewVLLMServingJobBuilder(), "vllm", "vLLM", vllmServingExample)
+}
+
+func NewSubmitSGLangServingJobCommand() *cobra.Command {
+	return newSubmitLLMServingJobCommand(serving.NewSGLangServingJobBuilder(), "sglang", "SGLang", sglangServingExample)
+}
+
+func newSubmitLLMServingJobCommand(builder *serving.LLMServingJobBuilder, use, server, example string) *cobra.Command {
+	var command = &cobra.Command{
+		Use:     use,
+		Short:   fmt.Sprintf("Submit %v serving to deploy large language models with an OpenAI-compatible api.", server),
+		Example: example,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			_ = viper.BindPFlags(cmd.Flags())
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := arenaclient.NewArenaClient(types.ArenaClientArgs{
+				Kubeconfig:     viper.GetString("config"),
+				LogLevel:       viper.GetString("loglevel"),
+				Namespace:      viper.GetString("namespace"),
+				ArenaNamespace: viper.GetString("arena-namespace"),
+				IsDaemonMode:   false,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create arena client: %v", err)
+			}
+
+			job, err := builder.Namespace(config.GetArenaConfiger().GetNamespace()).ExtraArgs(args).Build()
+			if err != nil {
+				return fmt.Errorf("failed to validate command args: %v", err)
+			}
+			return client.Serving().Submit(job)
+		},
+	}
+	builder.AddCommandFlags(command)
+	return command
+}
@@ -0,0 +1,71 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package template
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kubeflow/arena/pkg/apis/arenaclient"
+	"github.com/kubeflow/arena/pkg/apis/training"
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+func NewTemplateCreateCommand() *cobra.Command {
+	builder := training.NewJobTemplateBuilder()
+	var command = &cobra.Command{
+		Use:   "create TEMPLATE",
+		Short: "create a job template from the common options of 'arena submit'.",
+		Example: `  arena template create gpu-team-a \
+    --image=kubeflow/pytorch-dist-mnist:latest \
+    --data=training-data:/data \
+    --toleration=all \
+    --selector=accelerator=nvidia-a100`,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			_ = viper.BindPFlags(cmd.Flags())
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				cmd.HelpFunc()(cmd, args)
+				return fmt.Errorf("not set template name, please set it")
+			}
+			client, err := arenaclient.NewArenaClient(types.ArenaClientArgs{
+				Kubeconfig:     viper.GetString("config"),
+				LogLevel:       viper.GetString("loglevel"),
+				Namespace:      viper.GetString("namespace"),
+				ArenaNamespace: viper.GetString("arena-namespace"),
+				IsDaemonMode:   false,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create arena client: %v", err)
+			}
+			template, err := builder.Name(args[0]).Build()
+			if err != nil {
+				return fmt.Errorf("failed to validate command args: %v", err)
+			}
+			if err := client.Template().Create(template); err != nil {
+				return err
+			}
+			log.Infof("The job template %s has been created successfully", template.Name)
+			log.Infof("You can run `arena submit <type> --template %s` to use it", template.Name)
+			return nil
+		},
+	}
+	builder.AddCommandFlags(command)
+	return command
+}
@@ -0,0 +1,49 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package template
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var (
+	templateLong = `manage the job templates which store the common options of 'arena submit'.
+
+Available Commands:
+  create               Create a job template.
+  list,ls              List the job templates.
+  get                  Get the job template by name.
+  delete,del           Delete the job templates.
+    `
+)
+
+// NewTemplateCommand manages the job templates
+func NewTemplateCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "template",
+		Short: "manage the job templates.",
+		Long:  templateLong,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.HelpFunc()(cmd, args)
+		},
+	}
+
+	command.AddCommand(NewTemplateCreateCommand())
+	command.AddCommand(NewTemplateListCommand())
+	command.AddCommand(NewTemplateGetCommand())
+	command.AddCommand(NewTemplateDeleteCommand())
+
+	return command
+}
@@ -0,0 +1,64 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kubeflow/arena/pkg/apis/arenaclient"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/apis/utils"
+)
+
+// NewDiagnoseCommand creates the command to find the likely causes of the failure of training job
+func NewDiagnoseCommand() *cobra.Command {
+	var trainingType string
+	var tailLines int64
+	var output string
+	var command = &cobra.Command{
+		Use:   "diagnose JOB_NAME [-T JOB_TYPE]",
+		Short: "Diagnose the likely causes of the failure of a training job",
+		Long: `Diagnose the likely causes of the failure of a training job. All the instances of the job are inspected
+for OOMKilled, non-zero exit codes, image pull errors, eviction, unschedulable events and the NCCL/CUDA
+errors in the log tail. The causes are ranked with their evidence.`,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			_ = viper.BindPFlags(cmd.Flags())
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				cmd.HelpFunc()(cmd, args)
+				return fmt.Errorf("not set job name,please set it")
+			}
+			client, err := arenaclient.NewArenaClient(types.ArenaClientArgs{
+				Kubeconfig:     viper.GetString("config"),
+				LogLevel:       viper.GetString("loglevel"),
+				Namespace:      viper.GetString("namespace"),
+				ArenaNamespace: viper.GetString("arena-namespace"),
+				IsDaemonMode:   false,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create arena client: %v", err)
+			}
+			return client.Training().DiagnoseAndPrint(args[0], utils.TransferTrainingJobType(trainingType), tailLines, output)
+		},
+	}
+	command.Flags().StringVarP(&trainingType, "type", "T", "", fmt.Sprintf("The training type to diagnose, the possible option is %v. (optional)", utils.GetSupportTrainingJobTypesInfo()))
+	command.Flags().Int64Var(&tailLines, "tail", 200, "The number of lines from the end of the logs of each container to inspect")
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return command
+}
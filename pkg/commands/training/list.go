@@ -15,7 +15,11 @@
 package training
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/kubeflow/arena/pkg/apis/arenaclient"
@@ -28,6 +32,7 @@ import (
 
 func NewListCommand() *cobra.Command {
 	var allNamespaces bool
+	var watch bool
 	var format string
 	var jobType string
 	var command = &cobra.Command{
@@ -47,11 +52,16 @@ func NewListCommand() *cobra.Command {
 				LogLevel:       viper.GetString("loglevel"),
 				Namespace:      viper.GetString("namespace"),
 				ArenaNamespace: viper.GetString("arena-namespace"),
-				IsDaemonMode:   false,
+				IsDaemonMode:   watch,
 			})
 			if err != nil {
 				return fmt.Errorf("failed to create arena client: %v", err)
 			}
+			if watch {
+				ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+				defer cancel()
+				return client.Training().WatchAndPrint(ctx, allNamespaces, format, utils.TransferTrainingJobType(jobType))
+			}
 			return client.Training().ListAndPrint(allNamespaces, format, utils.TransferTrainingJobType(jobType))
 		},
 	}
@@ -60,5 +70,6 @@ func NewListCommand() *cobra.Command {
 	_ = command.Flags().MarkDeprecated("allNamespaces", "please use --all-namespaces instead")
 	command.Flags().BoolVarP(&allNamespaces, "all-namespaces", "A", false, "show all the namespaces")
 	command.Flags().StringVarP(&format, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	command.Flags().BoolVarP(&watch, "watch", "w", false, "After listing the jobs, watch for changes and print ADDED/MODIFIED/DELETED events, only support output format wide and json(newline-delimited)")
 	return command
 }
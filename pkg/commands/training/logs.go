@@ -26,7 +26,7 @@ import (
 )
 
 func NewLogsCommand() *cobra.Command {
-	loggerBuilder := logger.NewLoggerBuilder()
+	loggerBuilder := logger.NewTrainingLoggerBuilder()
 	var jobType string
 	var command = &cobra.Command{
 		Use:     "logs JOB [-T JOB_TYPE]",
@@ -58,6 +58,7 @@ func NewLogsCommand() *cobra.Command {
 			return client.Training().Logs(name, utils.TransferTrainingJobType(jobType), logArgs)
 		},
 	}
+	command.AddCommand(NewLogsExportCommand())
 	loggerBuilder.AddCommandFlags(command)
 	command.Flags().StringVarP(&jobType, "type", "T", "", fmt.Sprintf("The training type to show logging, the possible option is %v. (optional)", utils.GetSupportTrainingJobTypesInfo()))
 	return command
@@ -0,0 +1,67 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"fmt"
+
+	"github.com/kubeflow/arena/pkg/apis/arenaclient"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/apis/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// NewLogsExportCommand
+func NewLogsExportCommand() *cobra.Command {
+	var jobType string
+	var to string
+	var command = &cobra.Command{
+		Use:   "export JOB --to DIR|S3_URL [-T JOB_TYPE]",
+		Short: "Export the logs of all the instances of a training job into a tarball",
+		Long: `Export the job info and the logs of all the instances and containers of a training job,
+including the previous instances of the restarted containers, into a tarball.
+The tarball is stored into a local directory, or an s3 url like s3://bucket/prefix, the credentials are read
+from AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY, and the endpoint of storages like minio is read from AWS_ENDPOINT_URL.`,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			_ = viper.BindPFlags(cmd.Flags())
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				cmd.HelpFunc()(cmd, args)
+				return fmt.Errorf("not set job name,please set it")
+			}
+			client, err := arenaclient.NewArenaClient(types.ArenaClientArgs{
+				Kubeconfig:     viper.GetString("config"),
+				LogLevel:       viper.GetString("loglevel"),
+				Namespace:      viper.GetString("namespace"),
+				ArenaNamespace: viper.GetString("arena-namespace"),
+				IsDaemonMode:   false,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create arena client: %v", err)
+			}
+			location, err := client.Training().ExportLogs(args[0], utils.TransferTrainingJobType(jobType), to)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("The logs of job %v are exported to %v\n", args[0], location)
+			return nil
+		},
+	}
+	command.Flags().StringVar(&to, "to", "", "The local directory or the s3 url like s3://bucket/prefix which the logs are exported to")
+	command.Flags().StringVarP(&jobType, "type", "T", "", fmt.Sprintf("The training type to export logs, the possible option is %v. (optional)", utils.GetSupportTrainingJobTypesInfo()))
+	return command
+}
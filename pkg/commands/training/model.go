@@ -21,6 +21,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
 
 	"github.com/kubeflow/arena/pkg/apis/arenaclient"
 	"github.com/kubeflow/arena/pkg/apis/training"
@@ -29,6 +30,10 @@ import (
 
 // Model registration
 func createRegisteredModelAndModelVersion(client *arenaclient.ArenaClient, job *training.Job, versionDescription string) (*types.RegisteredModel, *types.ModelVersion, error) {
+	// the job is not created when dry run
+	if dryRun := types.DryRunStrategy(viper.GetString("dry-run")); dryRun != "" && dryRun != types.DryRunNone {
+		return nil, nil, nil
+	}
 	var (
 		name        string
 		description string
@@ -142,6 +147,32 @@ func createRegisteredModelAndModelVersion(client *arenaclient.ArenaClient, job *
 			})
 		}
 		source = args.ModelSource
+	case types.TrainJob:
+		args := job.Args().(*types.SubmitTrainJobArgs)
+		name = args.ModelName
+		if name == "" {
+			return nil, nil, nil
+		}
+		for key, value := range args.Labels {
+			versionTags = append(versionTags, &types.ModelVersionTag{
+				Key:   key,
+				Value: value,
+			})
+		}
+		source = args.ModelSource
+	case types.JobSetTrainingJob:
+		args := job.Args().(*types.SubmitJobSetArgs)
+		name = args.ModelName
+		if name == "" {
+			return nil, nil, nil
+		}
+		for key, value := range args.Labels {
+			versionTags = append(versionTags, &types.ModelVersionTag{
+				Key:   key,
+				Value: value,
+			})
+		}
+		source = args.ModelSource
 	}
 	modelClient, err := client.Model()
 	if err != nil {
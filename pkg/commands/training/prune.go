@@ -19,6 +19,7 @@ import (
 
 	"github.com/kubeflow/arena/pkg/apis/arenaclient"
 	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/apis/utils"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -26,9 +27,18 @@ import (
 func NewPruneCommand() *cobra.Command {
 	var allNamespaces bool
 	var since time.Duration
+	var trainingType string
+	var pruneArgs types.PruneArgs
 	var command = &cobra.Command{
 		Use:   "prune history job",
 		Short: "Prune the history jobs",
+		Long: `Prune the history jobs by the retention policy. A job is pruned if any of the policies selects it:
+  --since          the not running jobs which live longer than the duration
+  --succeeded-ttl  the succeeded jobs which live longer than the duration
+  --failed-ttl     the failed jobs which live longer than the duration
+  --keep-last      the finished jobs out of the latest N jobs per user or label
+
+The jobs can be archived into a local directory or a pvc before deletion.`,
 		PreRun: func(cmd *cobra.Command, args []string) {
 			_ = viper.BindPFlags(cmd.Flags())
 		},
@@ -43,10 +53,30 @@ func NewPruneCommand() *cobra.Command {
 			if err != nil {
 				return fmt.Errorf("failed to create arena client: %v", err)
 			}
-			return client.Training().Prune(allNamespaces, since)
+			if pruneArgs.ArchiveDir != "" && pruneArgs.ArchivePVC != "" {
+				return fmt.Errorf("--archive-dir and --archive-pvc can not be set at the same time")
+			}
+			pruneArgs.AllNamespaces = allNamespaces
+			// --since is not set if it is negative, and --since 0 prunes all the not running jobs
+			if since >= 0 {
+				pruneArgs.Since = &since
+			}
+			pruneArgs.Type = utils.TransferTrainingJobType(trainingType)
+			_, err = client.Training().PruneWithPolicy(&pruneArgs)
+			return err
 		},
 	}
 	command.Flags().BoolVarP(&allNamespaces, "all-namespaces", "A", false, "prune training jobs in all the namespaces")
 	command.Flags().DurationVarP(&since, "since", "s", -1, "Clean job that live longer than relative duration like 5s, 2m, or 3h.")
+	command.Flags().DurationVar(&pruneArgs.SucceededTTL, "succeeded-ttl", 0, "Clean the succeeded job that live longer than relative duration like 2m or 3h.")
+	command.Flags().DurationVar(&pruneArgs.FailedTTL, "failed-ttl", 0, "Clean the failed job that live longer than relative duration like 2m or 3h.")
+	command.Flags().IntVar(&pruneArgs.KeepLast, "keep-last", 0, "Keep the latest N finished jobs per group and clean the others.")
+	command.Flags().StringVar(&pruneArgs.KeepLastBy, "keep-last-by", types.PruneKeepLastByUser, "Group the jobs for --keep-last by 'user' or a label key.")
+	command.Flags().StringVarP(&trainingType, "type", "T", "", fmt.Sprintf("The training type to prune, the possible option is %v. (optional)", utils.GetSupportTrainingJobTypesInfo()))
+	command.Flags().StringVarP(&pruneArgs.Selector, "selector", "l", "", "Selector (label query) to filter the jobs, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2)")
+	command.Flags().BoolVar(&pruneArgs.DryRun, "dry-run", false, "Only list the jobs which would be cleaned.")
+	command.Flags().StringVar(&pruneArgs.ArchiveDir, "archive-dir", "", "Archive the job info, configmap and logs into the local directory before deletion.")
+	command.Flags().StringVar(&pruneArgs.ArchiveImage, "archive-image", types.DefaultPruneArchiveImage, "The image of the pod which writes the archives into the pvc of --archive-pvc.")
+	command.Flags().StringVar(&pruneArgs.ArchivePVC, "archive-pvc", "", "Archive the job info, configmap and logs into the pvc in the namespace of job before deletion.")
 	return command
 }
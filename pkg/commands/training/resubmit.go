@@ -0,0 +1,84 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kubeflow/arena/pkg/apis/arenaclient"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/apis/utils"
+	"github.com/kubeflow/arena/pkg/argsbuilder"
+)
+
+// NewResubmitCommand
+func NewResubmitCommand() *cobra.Command {
+	var jobType string
+	var name string
+	var overrides []string
+	var dryRun types.DryRunStrategy
+	var command = &cobra.Command{
+		Use:   "resubmit JOB [-T JOB_TYPE] [--name NEW_NAME] [--override OPTION=VALUE]...",
+		Short: "Submit a new training job with the options of an existing job",
+		Long: `Submit a new training job with the options of an existing job.
+The options are reconstructed from the values stored when submitting the job,
+the new job is named "<JOB>-<N>" if --name is not given.`,
+		Example: `  # resubmit the job with more gpus and another command
+  arena resubmit pytorch-dist --override gpus=2 --override command="python train.py --resume"
+
+  # the list options given by --override replace the options of the job
+  arena resubmit pytorch-dist --name pytorch-dist-v2 --override env=EPOCHS=10 --override env=LR=0.01`,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			_ = viper.BindPFlags(cmd.Flags())
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				cmd.HelpFunc()(cmd, args)
+				return fmt.Errorf("not set job name,please set it")
+			}
+			client, err := arenaclient.NewArenaClient(types.ArenaClientArgs{
+				Kubeconfig:     viper.GetString("config"),
+				LogLevel:       viper.GetString("loglevel"),
+				Namespace:      viper.GetString("namespace"),
+				ArenaNamespace: viper.GetString("arena-namespace"),
+				IsDaemonMode:   false,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create arena client: %v", err)
+			}
+			// the dry run strategy is passed to the new job as an option of it
+			if dryRun != types.DryRunNone {
+				overrides = append(overrides, fmt.Sprintf("dry-run=%v", dryRun))
+			}
+			job, err := client.Training().Clone(args[0], utils.TransferTrainingJobType(jobType), name, overrides...)
+			if err != nil {
+				return err
+			}
+			if dryRun == types.DryRunNone {
+				log.Infof("The Job %s is resubmitted from %s", job.Name(), args[0])
+			}
+			return nil
+		},
+	}
+	command.Flags().StringVarP(&jobType, "type", "T", "", fmt.Sprintf("The training type of the job, the possible option is %v. (optional)", utils.GetSupportTrainingJobTypesInfo()))
+	command.Flags().StringVar(&name, "name", "", "The name of the new job, default is \"<JOB>-<N>\"")
+	command.Flags().StringArrayVar(&overrides, "override", []string{}, `override the options of the job, usage: "--override gpus=2", the option "command" overrides the job command`)
+	argsbuilder.AddDryRunFlag(command, &dryRun)
+	return command
+}
@@ -27,6 +27,8 @@ Available Commands:
   horovod,hj           Submit a Horovod Job.
   volcanojob,vj        Submit a VolcanoJob.
   rayjob,rj            Submit a RayJob.
+  trainjob,tj          Submit a TrainJob of Kubeflow Trainer v2.
+  jobset,js            Submit a JobSet.
     `
 )
 
@@ -49,5 +51,7 @@ func NewSubmitCommand() *cobra.Command {
 	command.AddCommand(NewSubmitETJobCommand())
 	command.AddCommand(NewSubmitDeepSpeedJobCommand())
 	command.AddCommand(NewSubmitRayJobCommand())
+	command.AddCommand(NewSubmitTrainJobCommand())
+	command.AddCommand(NewSubmitJobSetCommand())
 	return command
 }
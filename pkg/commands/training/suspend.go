@@ -0,0 +1,59 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kubeflow/arena/pkg/apis/arenaclient"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/apis/utils"
+)
+
+// NewSuspendCommand creates the command to suspend the training job
+func NewSuspendCommand() *cobra.Command {
+	var trainingType string
+	var command = &cobra.Command{
+		Use:   "suspend JOB_NAME [-T JOB_TYPE]",
+		Short: "Suspend a training job and delete its instances until it is resumed",
+		Long: `Suspend a training job and delete its instances until it is resumed by 'arena resume'. The configuration
+of the job is kept. Only tfjob, pytorchjob, trainjob and jobset are supported.`,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			_ = viper.BindPFlags(cmd.Flags())
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				cmd.HelpFunc()(cmd, args)
+				return fmt.Errorf("not set job name,please set it")
+			}
+			client, err := arenaclient.NewArenaClient(types.ArenaClientArgs{
+				Kubeconfig:     viper.GetString("config"),
+				LogLevel:       viper.GetString("loglevel"),
+				Namespace:      viper.GetString("namespace"),
+				ArenaNamespace: viper.GetString("arena-namespace"),
+				IsDaemonMode:   false,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create arena client: %v", err)
+			}
+			return client.Training().Suspend(args[0], utils.TransferTrainingJobType(trainingType))
+		},
+	}
+	command.Flags().StringVarP(&trainingType, "type", "T", "", fmt.Sprintf("The training type to suspend, the possible option is %v. (optional)", utils.GetSupportTrainingJobTypesInfo()))
+	return command
+}
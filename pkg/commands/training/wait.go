@@ -0,0 +1,128 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v2"
+
+	"github.com/kubeflow/arena/pkg/apis/arenaclient"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/apis/utils"
+)
+
+// NewWaitCommand
+func NewWaitCommand() *cobra.Command {
+	var jobType string
+	var condition string
+	var timeout time.Duration
+	var output string
+	var command = &cobra.Command{
+		Use:   "wait JOB [-T JOB_TYPE] [--for=succeeded|failed|running] [--timeout DURATION]",
+		Short: "Wait for a training job to reach the given status",
+		Long: `Wait for a training job to reach the given status.
+The command exits with non-zero code if the job reaches a terminal status which is not
+the expected one, or the timeout is exceeded.`,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			_ = viper.BindPFlags(cmd.Flags())
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				cmd.HelpFunc()(cmd, args)
+				return fmt.Errorf("not set job name,please set it")
+			}
+			format := utils.TransferPrintFormat(output)
+			if format == types.UnknownFormat {
+				return fmt.Errorf("unknown output format,only support:[wide|json|yaml]")
+			}
+			client, err := arenaclient.NewArenaClient(types.ArenaClientArgs{
+				Kubeconfig:     viper.GetString("config"),
+				LogLevel:       viper.GetString("loglevel"),
+				Namespace:      viper.GetString("namespace"),
+				ArenaNamespace: viper.GetString("arena-namespace"),
+				IsDaemonMode:   false,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create arena client: %v", err)
+			}
+			ctx := context.Background()
+			if timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, timeout)
+				defer cancel()
+			}
+			result, err := client.Training().Wait(ctx, args[0], utils.TransferTrainingJobType(jobType), types.TrainingJobWaitCondition(strings.ToLower(condition)))
+			if err != nil {
+				return err
+			}
+			if err := printWaitResult(result, format); err != nil {
+				return err
+			}
+			if !result.ConditionMet {
+				return fmt.Errorf("the training job %v is %v, it can not be %v any more", result.Name, result.Status, result.Condition)
+			}
+			return nil
+		},
+	}
+	command.Flags().StringVarP(&jobType, "type", "T", "", fmt.Sprintf("The training type to wait, the possible option is %v. (optional)", utils.GetSupportTrainingJobTypesInfo()))
+	command.Flags().StringVar(&condition, "for", string(types.WaitForSucceeded), "The status to wait for. One of: succeeded|failed|running")
+	command.Flags().DurationVar(&timeout, "timeout", 0, "The max time to wait, like 30m, zero means waiting forever")
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return command
+}
+
+func printWaitResult(result *types.TrainingJobWaitResult, format types.FormatStyle) error {
+	switch format {
+	case types.JsonFormat:
+		data, err := json.MarshalIndent(result, "", "    ")
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%v\n", string(data))
+	case types.YamlFormat:
+		data, err := yaml.Marshal(result)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%v", string(data))
+	default:
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintf(w, "Name:\t%v\n", result.Name)
+		fmt.Fprintf(w, "Namespace:\t%v\n", result.Namespace)
+		fmt.Fprintf(w, "Trainer:\t%v\n", result.Trainer)
+		fmt.Fprintf(w, "Status:\t%v\n", result.Status)
+		fmt.Fprintf(w, "Duration:\t%v\n", result.Duration)
+		if result.LogsExport != "" {
+			fmt.Fprintf(w, "Logs:\t%v\n", result.LogsExport)
+		}
+		if len(result.ChiefExitCodes) != 0 {
+			fmt.Fprintf(w, "\nPOD\tCONTAINER\tEXIT CODE\tREASON\n")
+			for _, exitCode := range result.ChiefExitCodes {
+				fmt.Fprintf(w, "%v\t%v\t%v\t%v\n", exitCode.Pod, exitCode.Container, exitCode.ExitCode, exitCode.Reason)
+			}
+		}
+		w.Flush()
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobtemplate
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+func DisplayJobTemplates(templates []*types.JobTemplate, format types.FormatStyle) {
+	switch format {
+	case "json":
+		data, _ := json.MarshalIndent(templates, "", "    ")
+		fmt.Printf("%v\n", string(data))
+		return
+	case "yaml":
+		data, _ := yaml.Marshal(templates)
+		fmt.Printf("%v", string(data))
+		return
+	case "", "wide":
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		printLine(w, "NAME", "OPTIONS", "CREATED_BY", "CREATION_TIME")
+		for _, template := range templates {
+			printLine(w,
+				template.Name,
+				strings.Join(sortedOptions(template.Options), ","),
+				valueOrNA(template.CreatedBy),
+				valueOrNA(template.CreationTimestamp),
+			)
+		}
+		_ = w.Flush()
+		return
+	}
+}
+
+func DisplayJobTemplate(template *types.JobTemplate, format types.FormatStyle) {
+	switch format {
+	case "json":
+		data, _ := json.MarshalIndent(template, "", "    ")
+		fmt.Printf("%v\n", string(data))
+		return
+	case "yaml":
+		data, _ := yaml.Marshal(template)
+		fmt.Printf("%v", string(data))
+		return
+	case "", "wide":
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		printLine(w, "Name:", template.Name)
+		printLine(w, "CreatedBy:", valueOrNA(template.CreatedBy))
+		printLine(w, "CreationTime:", valueOrNA(template.CreationTimestamp))
+		printLine(w, "")
+		printLine(w, "Options:")
+		printLine(w, "  OPTION", "VALUE")
+		printLine(w, "  ------", "-----")
+		for _, option := range sortedOptions(template.Options) {
+			for _, value := range TemplateOptionValues(template.Options[option]) {
+				printLine(w, fmt.Sprintf("  --%v", option), value)
+			}
+		}
+		_ = w.Flush()
+		return
+	}
+}
+
+func sortedOptions(options map[string]interface{}) []string {
+	names := []string{}
+	for name := range options {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func valueOrNA(value string) string {
+	if value == "" {
+		return "N/A"
+	}
+	return value
+}
+
+func printLine(w io.Writer, fields ...string) {
+	buffer := strings.Join(fields, "\t")
+	fmt.Fprintln(w, buffer)
+}
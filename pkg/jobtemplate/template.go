@@ -0,0 +1,183 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobtemplate
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/util"
+)
+
+// ListJobTemplates returns all the job templates sorted by name
+func ListJobTemplates() ([]*types.JobTemplate, error) {
+	configMap, err := getTemplatesConfigMap()
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return []*types.JobTemplate{}, nil
+		}
+		return nil, err
+	}
+	templates := []*types.JobTemplate{}
+	for name, content := range configMap.Data {
+		template, err := parseJobTemplate(name, content)
+		if err != nil {
+			log.Warnf("skip the invalid job template %v: %v", name, err)
+			continue
+		}
+		templates = append(templates, template)
+	}
+	sort.Slice(templates, func(i, j int) bool {
+		return templates[i].Name < templates[j].Name
+	})
+	return templates, nil
+}
+
+// GetJobTemplate returns the job template with the name
+func GetJobTemplate(name string) (*types.JobTemplate, error) {
+	configMap, err := getTemplatesConfigMap()
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, fmt.Errorf("%w %v", types.ErrJobTemplateNotFound, name)
+		}
+		return nil, err
+	}
+	content, ok := configMap.Data[name]
+	if !ok {
+		return nil, fmt.Errorf("%w %v", types.ErrJobTemplateNotFound, name)
+	}
+	return parseJobTemplate(name, content)
+}
+
+// CreateJobTemplate stores the job template, the configmap of templates is created if it does not exist
+func CreateJobTemplate(template *types.JobTemplate) error {
+	if err := util.ValidateJobName(template.Name); err != nil {
+		return fmt.Errorf("invalid template name: %v", err)
+	}
+	if len(template.Options) == 0 {
+		return fmt.Errorf("the template %v has no options", template.Name)
+	}
+	arenaConfiger := config.GetArenaConfiger()
+	if template.CreatedBy == "" {
+		template.CreatedBy = arenaConfiger.GetUser().GetName()
+	}
+	if template.CreationTimestamp == "" {
+		template.CreationTimestamp = time.Now().UTC().Format(time.RFC3339)
+	}
+	content, err := yaml.Marshal(template)
+	if err != nil {
+		return err
+	}
+	client := arenaConfiger.GetClientSet()
+	namespace := arenaConfiger.GetArenaNamespace()
+	configMap, err := getTemplatesConfigMap()
+	if k8serrors.IsNotFound(err) {
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      types.JobTemplatesConfigMapName,
+				Namespace: namespace,
+				Labels: map[string]string{
+					"createdBy": "arena",
+				},
+			},
+			Data: map[string]string{
+				template.Name: string(content),
+			},
+		}
+		_, err = client.CoreV1().ConfigMaps(namespace).Create(context.TODO(), configMap, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	if _, ok := configMap.Data[template.Name]; ok {
+		return fmt.Errorf("the job template %v already exists, please delete it first", template.Name)
+	}
+	if configMap.Data == nil {
+		configMap.Data = map[string]string{}
+	}
+	configMap.Data[template.Name] = string(content)
+	_, err = client.CoreV1().ConfigMaps(namespace).Update(context.TODO(), configMap, metav1.UpdateOptions{})
+	return err
+}
+
+// DeleteJobTemplate deletes the job template with the name
+func DeleteJobTemplate(name string) error {
+	configMap, err := getTemplatesConfigMap()
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return fmt.Errorf("%w %v", types.ErrJobTemplateNotFound, name)
+		}
+		return err
+	}
+	if _, ok := configMap.Data[name]; !ok {
+		return fmt.Errorf("%w %v", types.ErrJobTemplateNotFound, name)
+	}
+	delete(configMap.Data, name)
+	arenaConfiger := config.GetArenaConfiger()
+	_, err = arenaConfiger.GetClientSet().CoreV1().ConfigMaps(configMap.Namespace).Update(context.TODO(), configMap, metav1.UpdateOptions{})
+	return err
+}
+
+// TemplateOptionValues returns the value of template option as a string list
+func TemplateOptionValues(value interface{}) []string {
+	switch v := value.(type) {
+	case nil:
+		return []string{}
+	case []string:
+		return v
+	case []interface{}:
+		values := []string{}
+		for _, item := range v {
+			values = append(values, fmt.Sprint(item))
+		}
+		return values
+	}
+	return []string{fmt.Sprint(value)}
+}
+
+func getTemplatesConfigMap() (*corev1.ConfigMap, error) {
+	arenaConfiger := config.GetArenaConfiger()
+	return arenaConfiger.GetClientSet().CoreV1().ConfigMaps(arenaConfiger.GetArenaNamespace()).Get(context.TODO(), types.JobTemplatesConfigMapName, metav1.GetOptions{})
+}
+
+func parseJobTemplate(name, content string) (*types.JobTemplate, error) {
+	template := &types.JobTemplate{}
+	if err := yaml.Unmarshal([]byte(content), template); err != nil {
+		return nil, fmt.Errorf("failed to parse the job template %v: %v", name, err)
+	}
+	template.Name = name
+	// yaml.v2 decodes the values to map[interface{}]interface{} or []interface{},
+	// normalize them to string or string list
+	for key, value := range template.Options {
+		switch value.(type) {
+		case []interface{}:
+			template.Options[key] = TemplateOptionValues(value)
+		default:
+			template.Options[key] = fmt.Sprint(value)
+		}
+	}
+	return template, nil
+}
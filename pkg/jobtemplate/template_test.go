@@ -0,0 +1,47 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobtemplate
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseJobTemplate(t *testing.T) {
+	content := `
+options:
+  image: kubeflow/pytorch-dist-mnist:latest
+  gpus: 1
+  data:
+  - training-data:/data
+  - models:/models
+createdBy: alice
+`
+	template, err := parseJobTemplate("gpu-team-a", content)
+	if err != nil {
+		t.Fatalf("failed to parse job template: %v", err)
+	}
+	if template.Name != "gpu-team-a" || template.CreatedBy != "alice" {
+		t.Errorf("unexpected template metadata: %+v", template)
+	}
+	expected := map[string]interface{}{
+		"image": "kubeflow/pytorch-dist-mnist:latest",
+		"gpus":  "1",
+		"data":  []string{"training-data:/data", "models:/models"},
+	}
+	if !reflect.DeepEqual(template.Options, expected) {
+		t.Errorf("expected options %v, got %v", expected, template.Options)
+	}
+}
@@ -36,6 +36,14 @@ const (
 	RayJobCRDName             = "rayjobs.ray.io"
 	RayJobCRDNameInDaemonMode = "RayJob.ray.io"
 
+	TrainJobCRDName             = "trainjobs.trainer.kubeflow.org"
+	TrainJobCRDNameInDaemonMode = "TrainJob.trainer.kubeflow.org"
+
+	JobSetCRDName             = "jobsets.jobset.x-k8s.io"
+	JobSetCRDNameInDaemonMode = "JobSet.jobset.x-k8s.io"
+
+	KueueLocalQueueCRDName = "localqueues.kueue.x-k8s.io"
+
 	LWSCRDName             = "leaderworkersets.leaderworkerset.x-k8s.io"
 	LWSCRDNameInDaemonMode = "Leaderworkerset.leaderworkerset.x-k8s.io"
 )
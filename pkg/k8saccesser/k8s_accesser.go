@@ -29,10 +29,13 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
+	toolscache "k8s.io/client-go/tools/cache"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
@@ -40,8 +43,11 @@ import (
 	"github.com/kubeflow/arena/pkg/apis/types"
 	v1alpha12 "github.com/kubeflow/arena/pkg/operators/et-operator/api/v1alpha1"
 	etversioned "github.com/kubeflow/arena/pkg/operators/et-operator/client/clientset/versioned"
+	jobset_v1alpha2 "github.com/kubeflow/arena/pkg/operators/jobset-operator/apis/jobset/v1alpha2"
 	cron_v1alpha1 "github.com/kubeflow/arena/pkg/operators/kubedl-operator/apis/apps/v1alpha1"
 	cronversioned "github.com/kubeflow/arena/pkg/operators/kubedl-operator/client/clientset/versioned"
+	kueue_v1beta1 "github.com/kubeflow/arena/pkg/operators/kueue-operator/apis/kueue/v1beta1"
+	kueue_visibility_v1beta1 "github.com/kubeflow/arena/pkg/operators/kueue-operator/apis/visibility/v1beta1"
 	"github.com/kubeflow/arena/pkg/operators/mpi-operator/apis/kubeflow/v1alpha1"
 	mpiversioned "github.com/kubeflow/arena/pkg/operators/mpi-operator/client/clientset/versioned"
 	pytorch_v1 "github.com/kubeflow/arena/pkg/operators/pytorch-operator/apis/pytorch/v1"
@@ -50,6 +56,7 @@ import (
 	sparkversioned "github.com/kubeflow/arena/pkg/operators/spark-operator/client/clientset/versioned"
 	tfv1 "github.com/kubeflow/arena/pkg/operators/tf-operator/apis/tensorflow/v1"
 	tfversioned "github.com/kubeflow/arena/pkg/operators/tf-operator/client/clientset/versioned"
+	trainer_v1alpha1 "github.com/kubeflow/arena/pkg/operators/trainer-operator/apis/trainer/v1alpha1"
 	volcano_v1alpha1 "github.com/kubeflow/arena/pkg/operators/volcano-operator/apis/batch/v1alpha1"
 	volcanovesioned "github.com/kubeflow/arena/pkg/operators/volcano-operator/client/clientset/versioned"
 	ray_v1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
@@ -70,6 +77,9 @@ func init() {
 	utilruntime.Must(volcano_v1alpha1.AddToScheme(scheme.Scheme))
 	utilruntime.Must(cron_v1alpha1.AddToScheme(scheme.Scheme))
 	utilruntime.Must(ray_v1.AddToScheme(scheme.Scheme))
+	utilruntime.Must(trainer_v1alpha1.AddToScheme(scheme.Scheme))
+	utilruntime.Must(jobset_v1alpha2.AddToScheme(scheme.Scheme))
+	utilruntime.Must(kueue_v1beta1.AddToScheme(scheme.Scheme))
 }
 
 func InitK8sResourceAccesser(config *rest.Config, clientset *kubernetes.Clientset, isDaemonMode bool) error {
@@ -146,6 +156,48 @@ func (k *k8sResourceAccesser) GetCacheClient() cache.Cache {
 	return k.cacheClient
 }
 
+// IsCacheEnabled returns true if the resources are read from the informer cache
+func (k *k8sResourceAccesser) IsCacheEnabled() bool {
+	return k.cacheEnabled
+}
+
+// AddEventHandler registers the handler to the informer of the object kind in cache,
+// it is only available when the cache is enabled
+func (k *k8sResourceAccesser) AddEventHandler(ctx context.Context, obj client.Object, handler toolscache.ResourceEventHandler) error {
+	if !k.cacheEnabled {
+		return fmt.Errorf("the cache of k8s resource accesser is not enabled")
+	}
+	informer, err := k.cacheClient.GetInformer(ctx, obj)
+	if err != nil {
+		return err
+	}
+	_, err = informer.AddEventHandler(handler)
+	return err
+}
+
+// NotifyOnChange returns a channel which receives a notification when any object of the given kinds is changed,
+// the notifications are coalesced if the receiver is busy
+func (k *k8sResourceAccesser) NotifyOnChange(ctx context.Context, objs ...client.Object) (<-chan struct{}, error) {
+	changed := make(chan struct{}, 1)
+	notify := func() {
+		select {
+		case changed <- struct{}{}:
+		default:
+		}
+	}
+	handler := toolscache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj interface{}) { notify() },
+		UpdateFunc: func(oldObj, newObj interface{}) { notify() },
+		DeleteFunc: func(obj interface{}) { notify() },
+	}
+	for _, obj := range objs {
+		if err := k.AddEventHandler(ctx, obj, handler); err != nil {
+			return nil, fmt.Errorf("failed to watch %T: %v", obj, err)
+		}
+	}
+	return changed, nil
+}
+
 func (k *k8sResourceAccesser) ListPods(namespace string, filterLabels string, filterFields string, filterFunc func(*corev1.Pod) bool) ([]*corev1.Pod, error) {
 	pods := []*corev1.Pod{}
 	podList := &corev1.PodList{}
@@ -563,6 +615,77 @@ func (k *k8sResourceAccesser) ListRayJobs(rayClient *rayversioned.Clientset, nam
 	return jobs, nil
 }
 
+// ListTrainJobs lists the TrainJobs of Kubeflow Trainer v2, there is no generated clientset of them,
+// so they are read by the dynamic client when the cache is disabled
+func (k *k8sResourceAccesser) ListTrainJobs(dynamicClient dynamic.Interface, namespace string, labels string) ([]*trainer_v1alpha1.TrainJob, error) {
+	jobs := []*trainer_v1alpha1.TrainJob{}
+	jobList := &trainer_v1alpha1.TrainJobList{}
+	var err error
+	labelSelector, err := parseLabelSelector(labels)
+	if err != nil {
+		return nil, err
+	}
+	if k.cacheEnabled {
+		err = k.cacheClient.List(
+			context.Background(),
+			jobList,
+			client.InNamespace(namespace),
+			&client.ListOptions{
+				LabelSelector: labelSelector,
+			})
+	} else {
+		unstructuredList, listErr := dynamicClient.Resource(trainer_v1alpha1.SchemeGroupVersion.WithResource("trainjobs")).Namespace(namespace).List(context.Background(), metav1.ListOptions{
+			LabelSelector: labelSelector.String(),
+		})
+		err = listErr
+		if err == nil {
+			err = runtime.DefaultUnstructuredConverter.FromUnstructured(unstructuredList.UnstructuredContent(), jobList)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, job := range jobList.Items {
+		jobs = append(jobs, job.DeepCopy())
+	}
+	return jobs, nil
+}
+
+// ListJobSets lists the JobSets, they are read by the dynamic client when the cache is disabled
+func (k *k8sResourceAccesser) ListJobSets(dynamicClient dynamic.Interface, namespace string, labels string) ([]*jobset_v1alpha2.JobSet, error) {
+	jobs := []*jobset_v1alpha2.JobSet{}
+	jobList := &jobset_v1alpha2.JobSetList{}
+	var err error
+	labelSelector, err := parseLabelSelector(labels)
+	if err != nil {
+		return nil, err
+	}
+	if k.cacheEnabled {
+		err = k.cacheClient.List(
+			context.Background(),
+			jobList,
+			client.InNamespace(namespace),
+			&client.ListOptions{
+				LabelSelector: labelSelector,
+			})
+	} else {
+		unstructuredList, listErr := dynamicClient.Resource(jobset_v1alpha2.SchemeGroupVersion.WithResource("jobsets")).Namespace(namespace).List(context.Background(), metav1.ListOptions{
+			LabelSelector: labelSelector.String(),
+		})
+		err = listErr
+		if err == nil {
+			err = runtime.DefaultUnstructuredConverter.FromUnstructured(unstructuredList.UnstructuredContent(), jobList)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, job := range jobList.Items {
+		jobs = append(jobs, job.DeepCopy())
+	}
+	return jobs, nil
+}
+
 func (k *k8sResourceAccesser) ListETJobs(etjobClient *etversioned.Clientset, namespace string, labels string) ([]*v1alpha12.TrainingJob, error) {
 	jobs := []*v1alpha12.TrainingJob{}
 	jobList := &v1alpha12.TrainingJobList{}
@@ -795,6 +918,54 @@ func (k *k8sResourceAccesser) GetRayJob(rayClient *rayversioned.Clientset, names
 	return rayJob, err
 }
 
+func (k *k8sResourceAccesser) GetTrainJob(dynamicClient dynamic.Interface, namespace string, name string) (*trainer_v1alpha1.TrainJob, error) {
+	trainJob := &trainer_v1alpha1.TrainJob{}
+	var err error
+	if k.cacheEnabled {
+		err = k.cacheClient.Get(context.Background(), client.ObjectKey{Namespace: namespace, Name: name}, trainJob)
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(`%v "%v" not found`, TrainJobCRDNameInDaemonMode, name)) {
+				return nil, types.ErrTrainingJobNotFound
+			}
+			return nil, fmt.Errorf("failed to find trainjob %v from cache,reason: %v", name, err)
+		}
+	} else {
+		obj, getErr := dynamicClient.Resource(trainer_v1alpha1.SchemeGroupVersion.WithResource("trainjobs")).Namespace(namespace).Get(context.Background(), name, metav1.GetOptions{})
+		if getErr != nil {
+			if strings.Contains(getErr.Error(), fmt.Sprintf(`%v "%v" not found`, TrainJobCRDName, name)) {
+				return nil, types.ErrTrainingJobNotFound
+			}
+			return nil, fmt.Errorf("failed to find trainjob %v from api server,reason: %v", name, getErr)
+		}
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), trainJob)
+	}
+	return trainJob, err
+}
+
+func (k *k8sResourceAccesser) GetJobSet(dynamicClient dynamic.Interface, namespace string, name string) (*jobset_v1alpha2.JobSet, error) {
+	jobSet := &jobset_v1alpha2.JobSet{}
+	var err error
+	if k.cacheEnabled {
+		err = k.cacheClient.Get(context.Background(), client.ObjectKey{Namespace: namespace, Name: name}, jobSet)
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(`%v "%v" not found`, JobSetCRDNameInDaemonMode, name)) {
+				return nil, types.ErrTrainingJobNotFound
+			}
+			return nil, fmt.Errorf("failed to find jobset %v from cache,reason: %v", name, err)
+		}
+	} else {
+		obj, getErr := dynamicClient.Resource(jobset_v1alpha2.SchemeGroupVersion.WithResource("jobsets")).Namespace(namespace).Get(context.Background(), name, metav1.GetOptions{})
+		if getErr != nil {
+			if strings.Contains(getErr.Error(), fmt.Sprintf(`%v "%v" not found`, JobSetCRDName, name)) {
+				return nil, types.ErrTrainingJobNotFound
+			}
+			return nil, fmt.Errorf("failed to find jobset %v from api server,reason: %v", name, getErr)
+		}
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), jobSet)
+	}
+	return jobSet, err
+}
+
 func (k *k8sResourceAccesser) GetETJob(etjobClient *etversioned.Clientset, namespace string, name string) (*v1alpha12.TrainingJob, error) {
 	etjob := &v1alpha12.TrainingJob{}
 	var err error
@@ -989,6 +1160,113 @@ func (k *k8sResourceAccesser) ListJobs(namespace string, filterLabels string, fi
 	return jobs, nil
 }
 
+// ListKueueWorkloads lists the kueue workloads which match the labels
+func (k *k8sResourceAccesser) ListKueueWorkloads(dynamicClient dynamic.Interface, namespace string, labels string) ([]*kueue_v1beta1.Workload, error) {
+	workloads := []*kueue_v1beta1.Workload{}
+	workloadList := &kueue_v1beta1.WorkloadList{}
+	labelSelector, err := parseLabelSelector(labels)
+	if err != nil {
+		return nil, err
+	}
+	if k.cacheEnabled {
+		err = k.cacheClient.List(
+			context.Background(),
+			workloadList,
+			client.InNamespace(namespace),
+			&client.ListOptions{
+				LabelSelector: labelSelector,
+			})
+	} else {
+		unstructuredList, listErr := dynamicClient.Resource(kueue_v1beta1.SchemeGroupVersion.WithResource("workloads")).Namespace(namespace).List(context.Background(), metav1.ListOptions{
+			LabelSelector: labelSelector.String(),
+		})
+		err = listErr
+		if err == nil {
+			err = runtime.DefaultUnstructuredConverter.FromUnstructured(unstructuredList.UnstructuredContent(), workloadList)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, workload := range workloadList.Items {
+		workloads = append(workloads, workload.DeepCopy())
+	}
+	return workloads, nil
+}
+
+// ListKueueLocalQueues lists the kueue local queues of the namespace
+func (k *k8sResourceAccesser) ListKueueLocalQueues(dynamicClient dynamic.Interface, namespace string) ([]*kueue_v1beta1.LocalQueue, error) {
+	queues := []*kueue_v1beta1.LocalQueue{}
+	queueList := &kueue_v1beta1.LocalQueueList{}
+	var err error
+	if k.cacheEnabled {
+		err = k.cacheClient.List(context.Background(), queueList, client.InNamespace(namespace))
+	} else {
+		unstructuredList, listErr := dynamicClient.Resource(kueue_v1beta1.SchemeGroupVersion.WithResource("localqueues")).Namespace(namespace).List(context.Background(), metav1.ListOptions{})
+		err = listErr
+		if err == nil {
+			err = runtime.DefaultUnstructuredConverter.FromUnstructured(unstructuredList.UnstructuredContent(), queueList)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, queue := range queueList.Items {
+		queues = append(queues, queue.DeepCopy())
+	}
+	return queues, nil
+}
+
+// GetKueueLocalQueue gets the kueue local queue
+func (k *k8sResourceAccesser) GetKueueLocalQueue(dynamicClient dynamic.Interface, namespace string, name string) (*kueue_v1beta1.LocalQueue, error) {
+	queue := &kueue_v1beta1.LocalQueue{}
+	var err error
+	if k.cacheEnabled {
+		err = k.cacheClient.Get(context.Background(), client.ObjectKey{Namespace: namespace, Name: name}, queue)
+	} else {
+		obj, getErr := dynamicClient.Resource(kueue_v1beta1.SchemeGroupVersion.WithResource("localqueues")).Namespace(namespace).Get(context.Background(), name, metav1.GetOptions{})
+		err = getErr
+		if err == nil {
+			err = runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), queue)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return queue, nil
+}
+
+// GetKueueClusterQueue gets the kueue cluster queue
+func (k *k8sResourceAccesser) GetKueueClusterQueue(dynamicClient dynamic.Interface, name string) (*kueue_v1beta1.ClusterQueue, error) {
+	queue := &kueue_v1beta1.ClusterQueue{}
+	var err error
+	if k.cacheEnabled {
+		err = k.cacheClient.Get(context.Background(), client.ObjectKey{Name: name}, queue)
+	} else {
+		obj, getErr := dynamicClient.Resource(kueue_v1beta1.SchemeGroupVersion.WithResource("clusterqueues")).Get(context.Background(), name, metav1.GetOptions{})
+		err = getErr
+		if err == nil {
+			err = runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), queue)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return queue, nil
+}
+
+// GetKueuePendingWorkloads gets the pending workloads of the local queue from the kueue visibility api,
+// the positions are computed by kueue so they are always read from api server
+func (k *k8sResourceAccesser) GetKueuePendingWorkloads(dynamicClient dynamic.Interface, namespace string, localQueue string) (*kueue_visibility_v1beta1.PendingWorkloadsSummary, error) {
+	summary := &kueue_visibility_v1beta1.PendingWorkloadsSummary{}
+	obj, err := dynamicClient.Resource(kueue_visibility_v1beta1.SchemeGroupVersion.WithResource("localqueues")).Namespace(namespace).Get(context.Background(), localQueue, metav1.GetOptions{}, "pendingworkloads")
+	if err != nil {
+		return nil, err
+	}
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), summary)
+	return summary, err
+}
+
 func parseLabelSelector(item string) (labels.Selector, error) {
 	if item == "" {
 		return labels.Everything(), nil
@@ -33,7 +33,7 @@ func SubmitModelBenchmarkJob(namespace string, args *types.ModelBenchmarkArgs) e
 	}
 
 	modelJobChart := util.GetChartsFolder() + "/modeljob"
-	err := workflow.SubmitJob(args.Name, string(types.ModelBenchmarkJob), namespace, args, modelJobChart, args.HelmOptions...)
+	err := workflow.SubmitJob(args.Name, string(types.ModelBenchmarkJob), namespace, args, modelJobChart, args.DryRun, args.HelmOptions...)
 	if err != nil {
 		return err
 	}
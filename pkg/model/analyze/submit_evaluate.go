@@ -32,7 +32,7 @@ func SubmitModelEvaluateJob(namespace string, args *types.ModelEvaluateArgs) err
 	}
 
 	modelJobChart := util.GetChartsFolder() + "/modeljob"
-	err := workflow.SubmitJob(args.Name, string(types.ModelEvaluateJob), namespace, args, modelJobChart, args.HelmOptions...)
+	err := workflow.SubmitJob(args.Name, string(types.ModelEvaluateJob), namespace, args, modelJobChart, args.DryRun, args.HelmOptions...)
 	if err != nil {
 		return err
 	}
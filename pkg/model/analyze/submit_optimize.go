@@ -32,7 +32,7 @@ func SubmitModelOptimizeJob(namespace string, args *types.ModelOptimizeArgs) err
 	}
 
 	modelJobChart := util.GetChartsFolder() + "/modeljob"
-	err := workflow.SubmitJob(args.Name, string(types.ModelOptimizeJob), namespace, args, modelJobChart, args.HelmOptions...)
+	err := workflow.SubmitJob(args.Name, string(types.ModelOptimizeJob), namespace, args, modelJobChart, args.DryRun, args.HelmOptions...)
 	if err != nil {
 		return err
 	}
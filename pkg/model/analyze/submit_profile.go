@@ -32,7 +32,7 @@ func SubmitModelProfileJob(namespace string, args *types.ModelProfileArgs) error
 	}
 
 	modelJobChart := util.GetChartsFolder() + "/modeljob"
-	err := workflow.SubmitJob(args.Name, string(types.ModelProfileJob), namespace, args, modelJobChart, args.HelmOptions...)
+	err := workflow.SubmitJob(args.Name, string(types.ModelProfileJob), namespace, args, modelJobChart, args.DryRun, args.HelmOptions...)
 	if err != nil {
 		return err
 	}
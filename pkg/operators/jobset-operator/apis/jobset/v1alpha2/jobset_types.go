@@ -0,0 +1,118 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package v1alpha2 contains the subset of the JobSet API which is used by arena,
+// the fields which arena does not read are dropped when decoding the JobSets.
+package v1alpha2
+
+import (
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// JobSetCompleted means the job completed its execution.
+	JobSetCompleted string = "Completed"
+	// JobSetFailed means the job has failed its execution.
+	JobSetFailed string = "Failed"
+	// JobSetSuspended means the job is suspended.
+	JobSetSuspended string = "Suspended"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// JobSet is the Schema for the jobsets API
+type JobSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   JobSetSpec   `json:"spec,omitempty"`
+	Status JobSetStatus `json:"status,omitempty"`
+}
+
+// JobSetSpec defines the desired state of JobSet
+type JobSetSpec struct {
+	// ReplicatedJobs is the group of jobs that will form the set.
+	ReplicatedJobs []ReplicatedJob `json:"replicatedJobs,omitempty"`
+
+	// Suspend suspends all running child Jobs when set to true.
+	Suspend *bool `json:"suspend,omitempty"`
+
+	// ManagedBy is used to indicate the controller or entity that manages a JobSet.
+	ManagedBy *string `json:"managedBy,omitempty"`
+}
+
+// ReplicatedJob is a group of identical jobs of JobSet
+type ReplicatedJob struct {
+	// Name is the name of the entry and will be used as a suffix
+	// for the Job name.
+	Name string `json:"name"`
+
+	// Template defines the template of the Job that will be created.
+	Template batchv1.JobTemplateSpec `json:"template"`
+
+	// Replicas is the number of jobs that will be created from this ReplicatedJob's template.
+	// Jobs names will be in the format: <jobSet.name>-<spec.replicatedJob.name>-<job-index>
+	Replicas int32 `json:"replicas,omitempty"`
+}
+
+// JobSetStatus defines the observed state of JobSet
+type JobSetStatus struct {
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Restarts tracks the number of times the JobSet has restarted (i.e. recreated in case of RecreateAll policy).
+	Restarts int32 `json:"restarts,omitempty"`
+
+	// TerminalState the state of the JobSet when it finishes execution.
+	// It can be either Completed or Failed. Otherwise, it is empty by default.
+	TerminalState string `json:"terminalState,omitempty"`
+
+	// ReplicatedJobsStatus track the number of JobsReady for each replicatedJob.
+	ReplicatedJobsStatus []ReplicatedJobStatus `json:"replicatedJobsStatus,omitempty"`
+}
+
+// ReplicatedJobStatus defines the observed ReplicatedJobs Readiness.
+type ReplicatedJobStatus struct {
+	// Name of the ReplicatedJob.
+	Name string `json:"name"`
+
+	// Ready is the number of child Jobs where the number of ready pods and completed pods
+	// is greater than or equal to the total expected pod count for the Job.
+	Ready int32 `json:"ready"`
+
+	// Succeeded is the number of successfully completed child Jobs.
+	Succeeded int32 `json:"succeeded"`
+
+	// Failed is the number of failed child Jobs.
+	Failed int32 `json:"failed"`
+
+	// Active is the number of child Jobs with at least 1 pod in a running or pending state
+	// which are not marked for deletion.
+	Active int32 `json:"active"`
+
+	// Suspended is the number of child Jobs which are in a suspended state.
+	Suspended int32 `json:"suspended"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// JobSetList contains a list of JobSet
+type JobSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []JobSet `json:"items"`
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// StrictFIFO means that workloads of higher priority are always admitted first,
+	// and workloads of the same priority are admitted in the order of creation.
+	StrictFIFO = "StrictFIFO"
+	// BestEffortFIFO means that the older workloads which can not be admitted
+	// do not block the newer ones which fit the available quota.
+	BestEffortFIFO = "BestEffortFIFO"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterQueue is the Schema for the clusterQueue API.
+type ClusterQueue struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterQueueSpec   `json:"spec,omitempty"`
+	Status ClusterQueueStatus `json:"status,omitempty"`
+}
+
+// ClusterQueueSpec defines the desired state of ClusterQueue
+type ClusterQueueSpec struct {
+	// ResourceGroups describes groups of resources.
+	ResourceGroups []ResourceGroup `json:"resourceGroups,omitempty"`
+
+	// Cohort that this ClusterQueue belongs to.
+	Cohort string `json:"cohort,omitempty"`
+
+	// QueueingStrategy indicates the queueing strategy of the workloads
+	// across the queues in this ClusterQueue.
+	QueueingStrategy string `json:"queueingStrategy,omitempty"`
+}
+
+// ResourceGroup describes the quotas of the resources which are covered by a group of flavors
+type ResourceGroup struct {
+	// CoveredResources is the list of resources covered by the flavors in this group.
+	CoveredResources []corev1.ResourceName `json:"coveredResources"`
+
+	// Flavors is the list of flavors that provide the resources of this group.
+	Flavors []FlavorQuotas `json:"flavors"`
+}
+
+// FlavorQuotas describes the quotas of a flavor
+type FlavorQuotas struct {
+	// Name of this flavor.
+	Name string `json:"name"`
+
+	// Resources is the list of quotas for this flavor per resource.
+	Resources []ResourceQuota `json:"resources"`
+}
+
+// ResourceQuota describes the quota of a resource
+type ResourceQuota struct {
+	// Name of this resource.
+	Name corev1.ResourceName `json:"name"`
+
+	// NominalQuota is the quantity of this resource that is available for
+	// Workloads admitted by this ClusterQueue at a point in time.
+	NominalQuota resource.Quantity `json:"nominalQuota"`
+
+	// BorrowingLimit is the maximum amount of quota for the [flavor, resource]
+	// combination that this ClusterQueue is allowed to borrow from the unused
+	// quota of other ClusterQueues in the same cohort.
+	BorrowingLimit *resource.Quantity `json:"borrowingLimit,omitempty"`
+
+	// LendingLimit is the maximum amount of unused quota for the [flavor, resource]
+	// combination that this ClusterQueue can lend to other ClusterQueues in the same cohort.
+	LendingLimit *resource.Quantity `json:"lendingLimit,omitempty"`
+}
+
+// ClusterQueueStatus defines the observed state of ClusterQueue
+type ClusterQueueStatus struct {
+	// FlavorsReservation are the reserved quotas, by flavor, currently in use by the
+	// workloads assigned to this ClusterQueue.
+	FlavorsReservation []FlavorUsage `json:"flavorsReservation,omitempty"`
+
+	// FlavorsUsage are the used quotas, by flavor, currently in use by the
+	// workloads admitted in this ClusterQueue.
+	FlavorsUsage []FlavorUsage `json:"flavorsUsage,omitempty"`
+
+	// PendingWorkloads is the number of workloads currently waiting to be
+	// admitted to this clusterQueue.
+	PendingWorkloads int32 `json:"pendingWorkloads,omitempty"`
+
+	// ReservingWorkloads is the number of workloads currently reserving quota in this
+	// clusterQueue.
+	ReservingWorkloads int32 `json:"reservingWorkloads,omitempty"`
+
+	// AdmittedWorkloads is the number of workloads currently admitted to this
+	// clusterQueue and haven't finished yet.
+	AdmittedWorkloads int32 `json:"admittedWorkloads,omitempty"`
+
+	// Conditions hold the latest available observations of the ClusterQueue current state.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// FlavorUsage is the usage of a flavor
+type FlavorUsage struct {
+	// Name of the flavor.
+	Name string `json:"name"`
+
+	// Resources lists the quota usage for the resources in this flavor.
+	Resources []ResourceUsage `json:"resources,omitempty"`
+}
+
+// ResourceUsage is the usage of a resource
+type ResourceUsage struct {
+	// Name of the resource
+	Name corev1.ResourceName `json:"name"`
+
+	// Total is the total quantity of used quota, including the amount borrowed
+	// from the cohort.
+	Total resource.Quantity `json:"total,omitempty"`
+
+	// Borrowed is quantity of quota that is borrowed from the cohort.
+	Borrowed resource.Quantity `json:"borrowed,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterQueueList contains a list of ClusterQueue
+type ClusterQueueList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterQueue `json:"items"`
+}
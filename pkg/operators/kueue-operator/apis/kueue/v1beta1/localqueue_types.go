@@ -0,0 +1,93 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// LocalQueue is the Schema for the localQueues API
+type LocalQueue struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LocalQueueSpec   `json:"spec,omitempty"`
+	Status LocalQueueStatus `json:"status,omitempty"`
+}
+
+// LocalQueueSpec defines the desired state of LocalQueue
+type LocalQueueSpec struct {
+	// ClusterQueue is a reference to a clusterQueue that backs this localQueue.
+	ClusterQueue string `json:"clusterQueue,omitempty"`
+}
+
+// LocalQueueStatus defines the observed state of LocalQueue
+type LocalQueueStatus struct {
+	// PendingWorkloads is the number of Workloads in the LocalQueue not yet admitted to a ClusterQueue
+	PendingWorkloads int32 `json:"pendingWorkloads,omitempty"`
+
+	// ReservingWorkloads is the number of workloads in this LocalQueue
+	// reserving quota in a ClusterQueue and that haven't finished yet.
+	ReservingWorkloads int32 `json:"reservingWorkloads,omitempty"`
+
+	// AdmittedWorkloads is the number of workloads in this LocalQueue
+	// admitted to a ClusterQueue and that haven't finished yet.
+	AdmittedWorkloads int32 `json:"admittedWorkloads,omitempty"`
+
+	// Conditions hold the latest available observations of the LocalQueue current state.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// FlavorsReservation are the reserved quotas, by flavor currently in use by the
+	// workloads assigned to this LocalQueue.
+	FlavorsReservation []LocalQueueFlavorUsage `json:"flavorsReservation,omitempty"`
+
+	// FlavorUsage are the used quotas, by flavor currently in use by the
+	// workloads assigned to this LocalQueue.
+	FlavorUsage []LocalQueueFlavorUsage `json:"flavorUsage,omitempty"`
+}
+
+// LocalQueueFlavorUsage is the usage of a resource flavor
+type LocalQueueFlavorUsage struct {
+	// Name of the flavor.
+	Name string `json:"name"`
+
+	// Resources lists the quota usage for the resources in this flavor.
+	Resources []LocalQueueResourceUsage `json:"resources,omitempty"`
+}
+
+// LocalQueueResourceUsage is the usage of a resource
+type LocalQueueResourceUsage struct {
+	// Name of the resource.
+	Name corev1.ResourceName `json:"name"`
+
+	// Total is the total quantity of used quota.
+	Total resource.Quantity `json:"total,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// LocalQueueList contains a list of LocalQueue
+type LocalQueueList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LocalQueue `json:"items"`
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package v1beta1 contains the subset of the Kueue API which is used by arena,
+// the fields which arena does not read are dropped when decoding the objects.
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// QueueNameLabel is the label key of the workload object which holds the name of the LocalQueue.
+	QueueNameLabel = "kueue.x-k8s.io/queue-name"
+	// JobUIDLabel is the label key of the Workload which holds the uid of the owner job.
+	JobUIDLabel = "kueue.x-k8s.io/job-uid"
+)
+
+const (
+	// WorkloadQuotaReserved means that the Workload has reserved quota in a ClusterQueue.
+	WorkloadQuotaReserved = "QuotaReserved"
+	// WorkloadAdmitted means that the Workload has reserved quota and all the admissionChecks are satisfied.
+	WorkloadAdmitted = "Admitted"
+	// WorkloadFinished means that the workload finished.
+	WorkloadFinished = "Finished"
+	// WorkloadEvicted means that the Workload was evicted by a ClusterQueue.
+	WorkloadEvicted = "Evicted"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Workload is the Schema for the workloads API
+type Workload struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WorkloadSpec   `json:"spec,omitempty"`
+	Status WorkloadStatus `json:"status,omitempty"`
+}
+
+// WorkloadSpec defines the desired state of Workload
+type WorkloadSpec struct {
+	// PodSets is a list of sets of homogeneous pods, each described by a Pod spec and a count.
+	PodSets []PodSet `json:"podSets,omitempty"`
+
+	// QueueName is the name of the LocalQueue the Workload is associated with.
+	QueueName string `json:"queueName,omitempty"`
+
+	// PriorityClassName is the name of the PriorityClass the Workload is associated with.
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+
+	// Priority determines the order of access to the resources managed by the ClusterQueue.
+	Priority *int32 `json:"priority,omitempty"`
+
+	// Active determines if a workload can be admitted into a queue.
+	Active *bool `json:"active,omitempty"`
+}
+
+// PodSet describes a set of homogeneous pods of the Workload
+type PodSet struct {
+	// Name is the PodSet name.
+	Name string `json:"name,omitempty"`
+
+	// Template is the Pod template.
+	Template corev1.PodTemplateSpec `json:"template"`
+
+	// Count is the number of pods for the spec.
+	Count int32 `json:"count"`
+}
+
+// WorkloadStatus defines the observed state of Workload
+type WorkloadStatus struct {
+	// Admission holds the parameters of the admission of the workload by a ClusterQueue.
+	Admission *Admission `json:"admission,omitempty"`
+
+	// Conditions hold the latest available observations of the Workload current state.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// Admission holds the ClusterQueue and flavors which the Workload is admitted with
+type Admission struct {
+	// ClusterQueue is the name of the ClusterQueue that admitted this workload.
+	ClusterQueue string `json:"clusterQueue"`
+
+	// PodSetAssignments hold the admission results for each of the .spec.podSets entries.
+	PodSetAssignments []PodSetAssignment `json:"podSetAssignments,omitempty"`
+}
+
+// PodSetAssignment is the admission result of a PodSet
+type PodSetAssignment struct {
+	// Name is the name of the podSet. It should match one of the names in .spec.podSets.
+	Name string `json:"name"`
+
+	// Flavors are the flavors assigned to the workload for each resource.
+	Flavors map[corev1.ResourceName]string `json:"flavors,omitempty"`
+
+	// ResourceUsage keeps track of the total resources all the pods in the podset need to run.
+	ResourceUsage corev1.ResourceList `json:"resourceUsage,omitempty"`
+
+	// Count is the number of pods taken into account at admission time.
+	Count *int32 `json:"count,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// WorkloadList contains a list of Workload
+type WorkloadList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Workload `json:"items"`
+}
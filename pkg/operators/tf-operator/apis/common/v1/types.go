@@ -131,6 +131,10 @@ const (
 	// reached phase failed with no restarting.
 	// The training has failed its execution.
 	JobFailed JobConditionType = "Failed"
+
+	// JobSuspended means the job is suspended by setting runPolicy.suspend,
+	// all the pods of this job are deleted until it is resumed.
+	JobSuspended JobConditionType = "Suspended"
 )
 
 // CleanPodPolicy describes how to deal with pods when the job is finished. Can be one
@@ -0,0 +1,190 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package v1alpha1 contains the subset of the Kubeflow Trainer v2 API which is used by arena,
+// the fields which arena does not read are dropped when decoding the TrainJobs.
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	// ClusterTrainingRuntimeKind is the kind of the cluster scoped training runtime.
+	ClusterTrainingRuntimeKind = "ClusterTrainingRuntime"
+	// TrainingRuntimeKind is the kind of the namespace scoped training runtime.
+	TrainingRuntimeKind = "TrainingRuntime"
+)
+
+const (
+	// TrainJobCreated means that the JobSet of TrainJob has been created.
+	TrainJobCreated string = "Created"
+	// TrainJobSuspended means that the TrainJob is suspended.
+	TrainJobSuspended string = "Suspended"
+	// TrainJobComplete means that the TrainJob has completed its execution.
+	TrainJobComplete string = "Complete"
+	// TrainJobFailed means that the TrainJob has failed its execution.
+	TrainJobFailed string = "Failed"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// TrainJob represents configuration of a training job.
+type TrainJob struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec of the TrainJob.
+	Spec TrainJobSpec `json:"spec,omitempty"`
+
+	// Current status of TrainJob.
+	Status TrainJobStatus `json:"status,omitempty"`
+}
+
+// TrainJobSpec represents specification of the desired TrainJob.
+type TrainJobSpec struct {
+	// Reference to the training runtime.
+	RuntimeRef RuntimeRef `json:"runtimeRef"`
+
+	// Configuration of the initializer.
+	Initializer *Initializer `json:"initializer,omitempty"`
+
+	// Configuration of the trainer.
+	Trainer *Trainer `json:"trainer,omitempty"`
+
+	// Labels to apply for the derivative JobSet and Jobs.
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations to apply for the derivative JobSet and Jobs.
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// Whether the controller should suspend the running TrainJob.
+	Suspend *bool `json:"suspend,omitempty"`
+
+	// ManagedBy is used to indicate the controller or entity that manages a TrainJob.
+	ManagedBy *string `json:"managedBy,omitempty"`
+}
+
+// RuntimeRef represents the reference to the existing training runtime.
+type RuntimeRef struct {
+	// Name of the runtime being referenced.
+	Name string `json:"name"`
+
+	// APIGroup of the runtime being referenced.
+	// Defaults to `trainer.kubeflow.org`.
+	APIGroup *string `json:"apiGroup,omitempty"`
+
+	// Kind of the runtime being referenced.
+	// Defaults to ClusterTrainingRuntime.
+	Kind *string `json:"kind,omitempty"`
+}
+
+// Initializer represents the desired configuration for the dataset and model initialization.
+type Initializer struct {
+	// Configuration of the dataset initialization and pre-processing.
+	Dataset *DatasetInitializer `json:"dataset,omitempty"`
+
+	// Configuration of the pre-trained model initialization
+	Model *ModelInitializer `json:"model,omitempty"`
+}
+
+// DatasetInitializer represents the desired configuration to initialize and pre-process dataset.
+type DatasetInitializer struct {
+	// Storage uri for the dataset provider.
+	StorageUri *string `json:"storageUri,omitempty"`
+
+	// List of environment variables to set in the dataset initializer container.
+	Env []corev1.EnvVar `json:"env,omitempty"`
+}
+
+// ModelInitializer represents the desired configuration to initialize pre-trained model.
+type ModelInitializer struct {
+	// Storage uri for the model provider.
+	StorageUri *string `json:"storageUri,omitempty"`
+
+	// List of environment variables to set in the model initializer container.
+	Env []corev1.EnvVar `json:"env,omitempty"`
+}
+
+// Trainer represents the desired configuration for the training job.
+type Trainer struct {
+	// Docker image for the training container.
+	Image *string `json:"image,omitempty"`
+
+	// Entrypoint commands for the training container.
+	Command []string `json:"command,omitempty"`
+
+	// Arguments to the entrypoint for the training container.
+	Args []string `json:"args,omitempty"`
+
+	// List of environment variables to set in the training container.
+	Env []corev1.EnvVar `json:"env,omitempty"`
+
+	// Number of training nodes.
+	NumNodes *int32 `json:"numNodes,omitempty"`
+
+	// Compute resources for each training node.
+	ResourcesPerNode *corev1.ResourceRequirements `json:"resourcesPerNode,omitempty"`
+
+	// Number of processes/workers/slots on every training node.
+	NumProcPerNode *intstr.IntOrString `json:"numProcPerNode,omitempty"`
+}
+
+// TrainJobStatus represents the current status of TrainJob.
+type TrainJobStatus struct {
+	// Conditions for the TrainJob.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// JobsStatus tracks the child Jobs in TrainJob.
+	JobsStatus []JobStatus `json:"jobsStatus,omitempty"`
+}
+
+// JobStatus represents the status of the child Jobs in TrainJob.
+type JobStatus struct {
+	// Name of the child Job.
+	Name string `json:"name"`
+
+	// Ready is the number of child Jobs where the number of ready pods and completed pods
+	// is greater than or equal to the total expected pod count for the child Job.
+	Ready int32 `json:"ready"`
+
+	// Succeeded is the number of successfully completed child Jobs.
+	Succeeded int32 `json:"succeeded"`
+
+	// Failed is the number of failed child Jobs.
+	Failed int32 `json:"failed"`
+
+	// Active is the number of child Jobs with at least 1 pod in a running or pending state
+	// which are not marked for deletion.
+	Active int32 `json:"active"`
+
+	// Suspended is the number of child Jobs which are in a suspended state.
+	Suspended int32 `json:"suspended"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// TrainJobList is a collection of training jobs.
+type TrainJobList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// List of TrainJobs.
+	Items []TrainJob `json:"items"`
+}
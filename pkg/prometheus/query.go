@@ -61,6 +61,11 @@ func GetPrometheusClient() promv1.API {
 	return globalPrometheusClient
 }
 
+// GetPrometheusAddress returns the prometheus address of env PROMETHEUS_ADDRESS or the arena configuration file
+func GetPrometheusAddress() string {
+	return getPrometheusAddress(config.GetArenaConfiger().GetConfigsFromConfigFile())
+}
+
 // address format should like: http://123.123.123.123:9000
 func getPrometheusAddress(configs map[string]string) string {
 	if os.Getenv("PROMETHEUS_ADDRESS") != "" {
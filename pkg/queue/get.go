@@ -0,0 +1,110 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queue
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/util"
+)
+
+var getQueueTemplate = `
+Name:               %v
+Namespace:          %v
+ClusterQueue:       %v
+QueueingStrategy:   %v
+PendingWorkloads:   %v
+AdmittedWorkloads:  %v
+%v
+%v
+`
+
+func GetQueueInfo(name, namespace string) (*types.QueueInfo, error) {
+	return GetKueueHandler().GetQueue(namespace, name)
+}
+
+func DisplayQueue(queue *types.QueueInfo, format types.FormatStyle) {
+	switch format {
+	case "json":
+		data, _ := json.MarshalIndent(queue, "", "    ")
+		fmt.Printf("%v\n", string(data))
+		return
+	case "yaml":
+		data, _ := yaml.Marshal(queue)
+		fmt.Printf("%v", string(data))
+		return
+	case "", "wide":
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+		resourceLines := []string{"\nResources:", "FLAVOR\tRESOURCE\tUSED\tCLUSTERQUEUE_USED\tNOMINAL_QUOTA"}
+		resourceLines = append(resourceLines, "------\t--------\t----\t-----------------\t-------------")
+		for _, r := range queue.Resources {
+			resourceLines = append(resourceLines, fmt.Sprintf("%v\t%v\t%v\t%v\t%v",
+				r.Flavor, r.Resource, r.Used, valueOrNA(r.ClusterQueueUsed), valueOrNA(r.NominalQuota)))
+		}
+
+		workloadLines := []string{"\nWorkloads:", "POSITION\tCLUSTERQUEUE_POSITION\tJOB\tKIND\tSTATUS\tPRIORITY\tAGE\tMESSAGE"}
+		workloadLines = append(workloadLines, "--------\t---------------------\t---\t----\t------\t--------\t---\t-------")
+		for _, workload := range queue.Workloads {
+			age := util.ShortHumanDuration(time.Since(time.Unix(workload.CreationTimestamp, 0)))
+			workloadLines = append(workloadLines, fmt.Sprintf("%v\t%v\t%v\t%v\t%v\t%v\t%v\t%v",
+				positionOrNA(workload.PositionInLocalQueue),
+				positionOrNA(workload.PositionInClusterQueue),
+				valueOrNA(workload.JobName),
+				valueOrNA(workload.JobKind),
+				workload.Status,
+				workload.Priority,
+				age,
+				workload.Message,
+			))
+		}
+
+		printLine(w, fmt.Sprintf(strings.Trim(getQueueTemplate, "\n"),
+			queue.Name,
+			queue.Namespace,
+			queue.ClusterQueue,
+			valueOrNA(queue.QueueingStrategy),
+			queue.PendingWorkloads,
+			queue.AdmittedWorkloads,
+			strings.Join(resourceLines, "\n"),
+			strings.Join(workloadLines, "\n"),
+		))
+
+		_ = w.Flush()
+		return
+	}
+}
+
+func valueOrNA(value string) string {
+	if value == "" {
+		return "N/A"
+	}
+	return value
+}
+
+func positionOrNA(position *int32) string {
+	if position == nil {
+		return "N/A"
+	}
+	return fmt.Sprintf("%v", *position)
+}
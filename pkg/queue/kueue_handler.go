@@ -0,0 +1,318 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queue
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	log "github.com/sirupsen/logrus"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/k8saccesser"
+	kueuev1beta1 "github.com/kubeflow/arena/pkg/operators/kueue-operator/apis/kueue/v1beta1"
+)
+
+var kueueHandler *KueueHandler
+
+// KueueHandler reads the queues and workloads of kueue
+type KueueHandler struct {
+	dynamicClient dynamic.Interface
+	// check if it's enabled
+	enabled bool
+}
+
+func NewKueueHandler() *KueueHandler {
+	arenaConfiger := config.GetArenaConfiger()
+	enable := false
+	_, err := arenaConfiger.GetAPIExtensionClientSet().ApiextensionsV1().CustomResourceDefinitions().Get(context.TODO(), k8saccesser.KueueLocalQueueCRDName, metav1.GetOptions{})
+	if err == nil {
+		log.Debugf("KueueHandler is enabled")
+		enable = true
+	} else {
+		log.Debugf("KueueHandler is disabled,reason: %v", err)
+	}
+	log.Debugf("Succeed to init KueueHandler")
+	return &KueueHandler{
+		dynamicClient: dynamic.NewForConfigOrDie(arenaConfiger.GetRestConfig()),
+		enabled:       enable,
+	}
+}
+
+func GetKueueHandler() *KueueHandler {
+	if kueueHandler == nil {
+		kueueHandler = NewKueueHandler()
+	}
+	return kueueHandler
+}
+
+// IsEnabled returns true if kueue is installed
+func (kh *KueueHandler) IsEnabled() bool {
+	return kh.enabled
+}
+
+func (kh *KueueHandler) ListQueues(namespace string, allNamespaces bool) ([]*types.QueueInfo, error) {
+	if !kh.enabled {
+		return nil, fmt.Errorf("kueue is not installed, not found crd %v", k8saccesser.KueueLocalQueueCRDName)
+	}
+	if allNamespaces {
+		namespace = metav1.NamespaceAll
+	}
+	localQueues, err := k8saccesser.GetK8sResourceAccesser().ListKueueLocalQueues(kh.dynamicClient, namespace)
+	if err != nil {
+		return nil, err
+	}
+	clusterQueues := map[string]*kueuev1beta1.ClusterQueue{}
+	queueInfos := []*types.QueueInfo{}
+	for _, localQueue := range localQueues {
+		clusterQueue, ok := clusterQueues[localQueue.Spec.ClusterQueue]
+		if !ok {
+			clusterQueue = kh.getClusterQueue(localQueue.Spec.ClusterQueue)
+			clusterQueues[localQueue.Spec.ClusterQueue] = clusterQueue
+		}
+		queueInfos = append(queueInfos, buildQueueInfo(localQueue, clusterQueue))
+	}
+	sort.Slice(queueInfos, func(i, j int) bool {
+		if queueInfos[i].Namespace != queueInfos[j].Namespace {
+			return queueInfos[i].Namespace < queueInfos[j].Namespace
+		}
+		return queueInfos[i].Name < queueInfos[j].Name
+	})
+	return queueInfos, nil
+}
+
+func (kh *KueueHandler) GetQueue(namespace, name string) (*types.QueueInfo, error) {
+	if !kh.enabled {
+		return nil, fmt.Errorf("kueue is not installed, not found crd %v", k8saccesser.KueueLocalQueueCRDName)
+	}
+	localQueue, err := k8saccesser.GetK8sResourceAccesser().GetKueueLocalQueue(kh.dynamicClient, namespace, name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, types.ErrQueueNotFound
+		}
+		return nil, fmt.Errorf("failed to get local queue %v,reason: %v", name, err)
+	}
+	queueInfo := buildQueueInfo(localQueue, kh.getClusterQueue(localQueue.Spec.ClusterQueue))
+	workloads, err := k8saccesser.GetK8sResourceAccesser().ListKueueWorkloads(kh.dynamicClient, namespace, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workloads of queue %v,reason: %v", name, err)
+	}
+	for _, workload := range workloads {
+		if workload.Spec.QueueName != name {
+			continue
+		}
+		workloadInfo := buildQueueWorkloadInfo(workload)
+		if workloadInfo.Status == types.QueueWorkloadFinished {
+			continue
+		}
+		queueInfo.Workloads = append(queueInfo.Workloads, workloadInfo)
+	}
+	kh.setWorkloadPositions(localQueue, queueInfo.Workloads)
+	return queueInfo, nil
+}
+
+// GetWorkloadOfJob returns the kueue workload which is created for the job
+func (kh *KueueHandler) GetWorkloadOfJob(namespace, jobUID string) (*kueuev1beta1.Workload, error) {
+	if !kh.enabled {
+		return nil, fmt.Errorf("kueue is not installed")
+	}
+	workloads, err := k8saccesser.GetK8sResourceAccesser().ListKueueWorkloads(kh.dynamicClient, namespace, fmt.Sprintf("%v=%v", kueuev1beta1.JobUIDLabel, jobUID))
+	if err != nil {
+		return nil, err
+	}
+	if len(workloads) == 0 {
+		return nil, fmt.Errorf("not found workload of job %v", jobUID)
+	}
+	return workloads[0], nil
+}
+
+// IsJobQueuing returns true if the job is waiting for the admission of kueue
+func IsJobQueuing(namespace, jobUID string) bool {
+	workload, err := GetKueueHandler().GetWorkloadOfJob(namespace, jobUID)
+	if err != nil {
+		log.Debugf("failed to get workload of job %v,reason: %v", jobUID, err)
+		return false
+	}
+	status, _ := getWorkloadStatus(workload)
+	return status == types.QueueWorkloadPending || status == types.QueueWorkloadQuotaReserved
+}
+
+// getClusterQueue returns nil if the cluster queue is not readable, users may be not allowed to get cluster scoped objects
+func (kh *KueueHandler) getClusterQueue(name string) *kueuev1beta1.ClusterQueue {
+	clusterQueue, err := k8saccesser.GetK8sResourceAccesser().GetKueueClusterQueue(kh.dynamicClient, name)
+	if err != nil {
+		log.Debugf("failed to get cluster queue %v,reason: %v", name, err)
+		return nil
+	}
+	return clusterQueue
+}
+
+// setWorkloadPositions sets the positions of pending workloads, they are read from the kueue visibility api,
+// if the api is not available, the positions in local queue are estimated by priority and creation time
+func (kh *KueueHandler) setWorkloadPositions(localQueue *kueuev1beta1.LocalQueue, workloads []types.QueueWorkloadInfo) {
+	summary, err := k8saccesser.GetK8sResourceAccesser().GetKueuePendingWorkloads(kh.dynamicClient, localQueue.Namespace, localQueue.Name)
+	if err == nil {
+		for _, item := range summary.Items {
+			for i := range workloads {
+				if workloads[i].Name != item.Name {
+					continue
+				}
+				positionInLocalQueue := item.PositionInLocalQueue
+				positionInClusterQueue := item.PositionInClusterQueue
+				workloads[i].PositionInLocalQueue = &positionInLocalQueue
+				workloads[i].PositionInClusterQueue = &positionInClusterQueue
+			}
+		}
+	} else {
+		log.Debugf("failed to get pending workloads from kueue visibility api,reason: %v", err)
+		pending := []*types.QueueWorkloadInfo{}
+		for i := range workloads {
+			if workloads[i].Status == types.QueueWorkloadPending {
+				pending = append(pending, &workloads[i])
+			}
+		}
+		sort.SliceStable(pending, func(i, j int) bool {
+			if pending[i].Priority != pending[j].Priority {
+				return pending[i].Priority > pending[j].Priority
+			}
+			return pending[i].CreationTimestamp < pending[j].CreationTimestamp
+		})
+		for i := range pending {
+			position := int32(i)
+			pending[i].PositionInLocalQueue = &position
+		}
+	}
+	sort.SliceStable(workloads, func(i, j int) bool {
+		pi, pj := workloads[i].PositionInLocalQueue, workloads[j].PositionInLocalQueue
+		if pi != nil && pj != nil {
+			return *pi < *pj
+		}
+		if pi != nil || pj != nil {
+			return pi != nil
+		}
+		return workloads[i].CreationTimestamp < workloads[j].CreationTimestamp
+	})
+}
+
+func buildQueueInfo(localQueue *kueuev1beta1.LocalQueue, clusterQueue *kueuev1beta1.ClusterQueue) *types.QueueInfo {
+	queueInfo := &types.QueueInfo{
+		Name:              localQueue.Name,
+		Namespace:         localQueue.Namespace,
+		ClusterQueue:      localQueue.Spec.ClusterQueue,
+		PendingWorkloads:  localQueue.Status.PendingWorkloads,
+		AdmittedWorkloads: localQueue.Status.AdmittedWorkloads,
+		Resources:         []types.QueueResourceInfo{},
+	}
+	used := map[string]string{}
+	for _, flavor := range localQueue.Status.FlavorsReservation {
+		for _, r := range flavor.Resources {
+			used[flavor.Name+"/"+string(r.Name)] = r.Total.String()
+		}
+	}
+	if clusterQueue == nil {
+		for _, flavor := range localQueue.Status.FlavorsReservation {
+			for _, r := range flavor.Resources {
+				queueInfo.Resources = append(queueInfo.Resources, types.QueueResourceInfo{
+					Flavor:   flavor.Name,
+					Resource: string(r.Name),
+					Used:     r.Total.String(),
+				})
+			}
+		}
+		return queueInfo
+	}
+	queueInfo.QueueingStrategy = clusterQueue.Spec.QueueingStrategy
+	clusterQueueUsed := map[string]string{}
+	for _, flavor := range clusterQueue.Status.FlavorsReservation {
+		for _, r := range flavor.Resources {
+			clusterQueueUsed[flavor.Name+"/"+string(r.Name)] = r.Total.String()
+		}
+	}
+	for _, group := range clusterQueue.Spec.ResourceGroups {
+		for _, flavor := range group.Flavors {
+			for _, r := range flavor.Resources {
+				key := flavor.Name + "/" + string(r.Name)
+				queueInfo.Resources = append(queueInfo.Resources, types.QueueResourceInfo{
+					Flavor:           flavor.Name,
+					Resource:         string(r.Name),
+					Used:             valueOrZero(used[key]),
+					ClusterQueueUsed: valueOrZero(clusterQueueUsed[key]),
+					NominalQuota:     r.NominalQuota.String(),
+				})
+			}
+		}
+	}
+	return queueInfo
+}
+
+func buildQueueWorkloadInfo(workload *kueuev1beta1.Workload) types.QueueWorkloadInfo {
+	status, message := getWorkloadStatus(workload)
+	workloadInfo := types.QueueWorkloadInfo{
+		Name:              workload.Name,
+		Status:            status,
+		Message:           message,
+		CreationTimestamp: workload.CreationTimestamp.Unix(),
+	}
+	if workload.Spec.Priority != nil {
+		workloadInfo.Priority = *workload.Spec.Priority
+	}
+	for _, owner := range workload.OwnerReferences {
+		if workloadInfo.JobName == "" || (owner.Controller != nil && *owner.Controller) {
+			workloadInfo.JobName = owner.Name
+			workloadInfo.JobKind = owner.Kind
+		}
+	}
+	return workloadInfo
+}
+
+// getWorkloadStatus returns the status of workload and the reason why it is not admitted
+func getWorkloadStatus(workload *kueuev1beta1.Workload) (types.QueueWorkloadStatus, string) {
+	conditions := workload.Status.Conditions
+	switch {
+	case meta.IsStatusConditionTrue(conditions, kueuev1beta1.WorkloadFinished):
+		return types.QueueWorkloadFinished, ""
+	case meta.IsStatusConditionTrue(conditions, kueuev1beta1.WorkloadAdmitted):
+		return types.QueueWorkloadAdmitted, ""
+	case meta.IsStatusConditionTrue(conditions, kueuev1beta1.WorkloadQuotaReserved):
+		cond := meta.FindStatusCondition(conditions, kueuev1beta1.WorkloadAdmitted)
+		if cond != nil {
+			return types.QueueWorkloadQuotaReserved, cond.Message
+		}
+		return types.QueueWorkloadQuotaReserved, ""
+	}
+	if workload.Spec.Active != nil && !*workload.Spec.Active {
+		return types.QueueWorkloadPending, "the workload is deactivated"
+	}
+	if cond := meta.FindStatusCondition(conditions, kueuev1beta1.WorkloadEvicted); cond != nil && cond.Status == metav1.ConditionTrue {
+		return types.QueueWorkloadPending, cond.Message
+	}
+	if cond := meta.FindStatusCondition(conditions, kueuev1beta1.WorkloadQuotaReserved); cond != nil {
+		return types.QueueWorkloadPending, cond.Message
+	}
+	return types.QueueWorkloadPending, ""
+}
+
+func valueOrZero(value string) string {
+	if value == "" {
+		return "0"
+	}
+	return value
+}
@@ -0,0 +1,128 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queue
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+	kueuev1beta1 "github.com/kubeflow/arena/pkg/operators/kueue-operator/apis/kueue/v1beta1"
+)
+
+func TestGetWorkloadStatus(t *testing.T) {
+	inactive := false
+	testcases := []struct {
+		conditions []metav1.Condition
+		active     *bool
+		expected   types.QueueWorkloadStatus
+		message    string
+	}{
+		{
+			expected: types.QueueWorkloadPending,
+		},
+		{
+			conditions: []metav1.Condition{{Type: kueuev1beta1.WorkloadQuotaReserved, Status: metav1.ConditionFalse, Message: "insufficient quota"}},
+			expected:   types.QueueWorkloadPending,
+			message:    "insufficient quota",
+		},
+		{
+			active:   &inactive,
+			expected: types.QueueWorkloadPending,
+			message:  "the workload is deactivated",
+		},
+		{
+			conditions: []metav1.Condition{{Type: kueuev1beta1.WorkloadQuotaReserved, Status: metav1.ConditionTrue}},
+			expected:   types.QueueWorkloadQuotaReserved,
+		},
+		{
+			conditions: []metav1.Condition{
+				{Type: kueuev1beta1.WorkloadQuotaReserved, Status: metav1.ConditionTrue},
+				{Type: kueuev1beta1.WorkloadAdmitted, Status: metav1.ConditionTrue},
+			},
+			expected: types.QueueWorkloadAdmitted,
+		},
+		{
+			conditions: []metav1.Condition{
+				{Type: kueuev1beta1.WorkloadAdmitted, Status: metav1.ConditionTrue},
+				{Type: kueuev1beta1.WorkloadFinished, Status: metav1.ConditionTrue},
+			},
+			expected: types.QueueWorkloadFinished,
+		},
+	}
+	for i, tc := range testcases {
+		workload := &kueuev1beta1.Workload{
+			Spec:   kueuev1beta1.WorkloadSpec{Active: tc.active},
+			Status: kueuev1beta1.WorkloadStatus{Conditions: tc.conditions},
+		}
+		status, message := getWorkloadStatus(workload)
+		if status != tc.expected || message != tc.message {
+			t.Errorf("case %d: expected status %v(%v), got %v(%v)", i, tc.expected, tc.message, status, message)
+		}
+	}
+}
+
+func TestBuildQueueInfo(t *testing.T) {
+	localQueue := &kueuev1beta1.LocalQueue{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-a", Namespace: "default"},
+		Spec:       kueuev1beta1.LocalQueueSpec{ClusterQueue: "cluster-queue"},
+		Status: kueuev1beta1.LocalQueueStatus{
+			PendingWorkloads:  2,
+			AdmittedWorkloads: 1,
+			FlavorsReservation: []kueuev1beta1.LocalQueueFlavorUsage{{
+				Name:      "default-flavor",
+				Resources: []kueuev1beta1.LocalQueueResourceUsage{{Name: "nvidia.com/gpu", Total: resource.MustParse("2")}},
+			}},
+		},
+	}
+	clusterQueue := &kueuev1beta1.ClusterQueue{
+		Spec: kueuev1beta1.ClusterQueueSpec{
+			QueueingStrategy: kueuev1beta1.BestEffortFIFO,
+			ResourceGroups: []kueuev1beta1.ResourceGroup{{
+				CoveredResources: []corev1.ResourceName{"cpu", "nvidia.com/gpu"},
+				Flavors: []kueuev1beta1.FlavorQuotas{{
+					Name: "default-flavor",
+					Resources: []kueuev1beta1.ResourceQuota{
+						{Name: "cpu", NominalQuota: resource.MustParse("32")},
+						{Name: "nvidia.com/gpu", NominalQuota: resource.MustParse("8")},
+					},
+				}},
+			}},
+		},
+		Status: kueuev1beta1.ClusterQueueStatus{
+			FlavorsReservation: []kueuev1beta1.FlavorUsage{{
+				Name:      "default-flavor",
+				Resources: []kueuev1beta1.ResourceUsage{{Name: "nvidia.com/gpu", Total: resource.MustParse("6")}},
+			}},
+		},
+	}
+	queueInfo := buildQueueInfo(localQueue, clusterQueue)
+	if queueInfo.QueueingStrategy != kueuev1beta1.BestEffortFIFO || len(queueInfo.Resources) != 2 {
+		t.Fatalf("unexpected queue info: %+v", queueInfo)
+	}
+	gpu := queueInfo.Resources[1]
+	if gpu.Used != "2" || gpu.ClusterQueueUsed != "6" || gpu.NominalQuota != "8" {
+		t.Errorf("unexpected gpu usage: %+v", gpu)
+	}
+	if usage := queueUsage(queueInfo.Resources); usage != "cpu=0/32,nvidia.com/gpu=2/8" {
+		t.Errorf("unexpected usage summary: %v", usage)
+	}
+	if usage := queueUsage(buildQueueInfo(localQueue, nil).Resources); usage != "nvidia.com/gpu=2" {
+		t.Errorf("unexpected usage summary without cluster queue: %v", usage)
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queue
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v2"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+func ListQueues(namespace string, allNamespaces bool) ([]*types.QueueInfo, error) {
+	return GetKueueHandler().ListQueues(namespace, allNamespaces)
+}
+
+func DisplayAllQueues(queues []*types.QueueInfo, allNamespaces bool, format types.FormatStyle) {
+	switch format {
+	case "json":
+		data, _ := json.MarshalIndent(queues, "", "    ")
+		fmt.Printf("%v\n", string(data))
+		return
+	case "yaml":
+		data, _ := yaml.Marshal(queues)
+		fmt.Printf("%v", string(data))
+		return
+	case "", "wide":
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		var header []string
+		if allNamespaces {
+			header = append(header, "NAMESPACE")
+		}
+		header = append(header, []string{"NAME", "CLUSTERQUEUE", "PENDING", "ADMITTED", "USAGE"}...)
+		printLine(w, header...)
+
+		for _, queue := range queues {
+			var items []string
+			if allNamespaces {
+				items = append(items, queue.Namespace)
+			}
+			items = append(items, []string{
+				queue.Name,
+				queue.ClusterQueue,
+				fmt.Sprintf("%v", queue.PendingWorkloads),
+				fmt.Sprintf("%v", queue.AdmittedWorkloads),
+				queueUsage(queue.Resources),
+			}...)
+			printLine(w, items...)
+		}
+		_ = w.Flush()
+		return
+	}
+}
+
+// queueUsage summarizes the quota usage of all flavors by resource, like "cpu=4/32,nvidia.com/gpu=2/8"
+func queueUsage(resources []types.QueueResourceInfo) string {
+	used := map[string]resource.Quantity{}
+	quota := map[string]resource.Quantity{}
+	for _, r := range resources {
+		if q, err := resource.ParseQuantity(r.Used); err == nil {
+			total := used[r.Resource]
+			total.Add(q)
+			used[r.Resource] = total
+		}
+		if q, err := resource.ParseQuantity(r.NominalQuota); err == nil {
+			total := quota[r.Resource]
+			total.Add(q)
+			quota[r.Resource] = total
+		}
+	}
+	if len(used) == 0 {
+		return "N/A"
+	}
+	names := []string{}
+	for name := range used {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	items := []string{}
+	for _, name := range names {
+		u := used[name]
+		item := fmt.Sprintf("%v=%v", name, u.String())
+		if q, ok := quota[name]; ok {
+			item = fmt.Sprintf("%v/%v", item, q.String())
+		}
+		items = append(items, item)
+	}
+	return strings.Join(items, ",")
+}
+
+func printLine(w io.Writer, fields ...string) {
+	buffer := strings.Join(fields, "\t")
+	fmt.Fprintln(w, buffer)
+}
@@ -0,0 +1,336 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/prometheus"
+)
+
+const (
+	scaleMetricAnnotationKey = "arena.kubeflow.org/scale-metric"
+	scaleTargetAnnotationKey = "arena.kubeflow.org/scale-target"
+	scaledObjectResource     = "scaledobjects"
+	// kedaHPAPrefix is the name prefix of the HorizontalPodAutoscaler created by KEDA for ScaledObject
+	kedaHPAPrefix = "keda-hpa-"
+)
+
+var kedaGroupVersion = schema.GroupVersion{Group: "keda.sh", Version: "v1alpha1"}
+
+// applyServingAutoscaler creates or updates the autoscaler of the serving deployment,
+// the autoscaler is owned by the deployment and deleted with it
+func applyServingAutoscaler(namespace, name, version string, servingType types.ServingJobType, args types.ServingAutoscaleArgs) error {
+	if args.MaxReplicas == 0 {
+		return nil
+	}
+	deploy, err := findServingDeployment(namespace, name, version, servingType)
+	if err != nil {
+		return err
+	}
+	return applyDeploymentAutoscaler(deploy, args)
+}
+
+func applyDeploymentAutoscaler(deploy *appsv1.Deployment, args types.ServingAutoscaleArgs) error {
+	if args.MaxReplicas == 0 {
+		return nil
+	}
+	switch args.ScaleMetric {
+	case types.GPUUtilScaleMetric, types.QPSScaleMetric:
+		if err := deleteHorizontalPodAutoscaler(deploy.Namespace, deploy.Name); err != nil {
+			return err
+		}
+		if err := applyScaledObject(deploy, args); err != nil {
+			return fmt.Errorf("failed to apply ScaledObject %v, please make sure KEDA is installed: %v", deploy.Name, err)
+		}
+	default:
+		if err := deleteScaledObject(deploy.Namespace, deploy.Name); err != nil {
+			return err
+		}
+		if err := applyHorizontalPodAutoscaler(deploy, args); err != nil {
+			return fmt.Errorf("failed to apply HorizontalPodAutoscaler %v: %v", deploy.Name, err)
+		}
+	}
+	log.Infof("The autoscaler of %v scales between %v and %v replicas by %v", deploy.Name, args.MinReplicas, args.MaxReplicas, args.ScaleMetric)
+	return nil
+}
+
+func findServingDeployment(namespace, name, version string, servingType types.ServingJobType) (*appsv1.Deployment, error) {
+	selector := []string{
+		fmt.Sprintf("%v=%v", servingNameLabelKey, name),
+		fmt.Sprintf("%v=%v", servingVersionLabelKey, version),
+		fmt.Sprintf("%v=%v", servingTypeLabelKey, servingType),
+	}
+	deployments, err := config.GetArenaConfiger().GetClientSet().AppsV1().Deployments(namespace).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: strings.Join(selector, ","),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(deployments.Items) == 0 {
+		return nil, fmt.Errorf("not found the deployment of serving job %v with version %v", name, version)
+	}
+	return &deployments.Items[0], nil
+}
+
+// autoscalerMeta returns the metadata of the autoscaler, the labels are used to find it by serving job,
+// and it is controlled by the deployment so it is deleted in the foreground deletion of the deployment
+func autoscalerMeta(deploy *appsv1.Deployment, args types.ServingAutoscaleArgs) metav1.ObjectMeta {
+	isController := true
+	ownerReference := workloadOwnerReference(deploy)
+	ownerReference.Controller = &isController
+	ownerReference.BlockOwnerDeletion = &isController
+	return metav1.ObjectMeta{
+		Name:      deploy.Name,
+		Namespace: deploy.Namespace,
+		Labels: map[string]string{
+			servingNameLabelKey:    deploy.Labels[servingNameLabelKey],
+			servingVersionLabelKey: deploy.Labels[servingVersionLabelKey],
+			"createdBy":            "arena",
+		},
+		Annotations: map[string]string{
+			scaleMetricAnnotationKey: string(args.ScaleMetric),
+			scaleTargetAnnotationKey: strconv.Itoa(args.ScaleTarget),
+		},
+		OwnerReferences: []metav1.OwnerReference{ownerReference},
+	}
+}
+
+func buildHorizontalPodAutoscaler(deploy *appsv1.Deployment, args types.ServingAutoscaleArgs) *autoscalingv2.HorizontalPodAutoscaler {
+	minReplicas := int32(args.MinReplicas)
+	target := int32(args.ScaleTarget)
+	resourceName := corev1.ResourceCPU
+	if args.ScaleMetric == types.MemoryScaleMetric {
+		resourceName = corev1.ResourceMemory
+	}
+	return &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: autoscalerMeta(deploy, args),
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       deploy.Name,
+			},
+			MinReplicas: &minReplicas,
+			MaxReplicas: int32(args.MaxReplicas),
+			Metrics: []autoscalingv2.MetricSpec{
+				{
+					Type: autoscalingv2.ResourceMetricSourceType,
+					Resource: &autoscalingv2.ResourceMetricSource{
+						Name: resourceName,
+						Target: autoscalingv2.MetricTarget{
+							Type:               autoscalingv2.UtilizationMetricType,
+							AverageUtilization: &target,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func applyHorizontalPodAutoscaler(deploy *appsv1.Deployment, args types.ServingAutoscaleArgs) error {
+	client := config.GetArenaConfiger().GetClientSet().AutoscalingV2().HorizontalPodAutoscalers(deploy.Namespace)
+	hpa := buildHorizontalPodAutoscaler(deploy, args)
+	existing, err := client.Get(context.TODO(), hpa.Name, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		_, err = client.Create(context.TODO(), hpa, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	existing.Annotations = hpa.Annotations
+	existing.Spec = hpa.Spec
+	_, err = client.Update(context.TODO(), existing, metav1.UpdateOptions{})
+	return err
+}
+
+func deleteHorizontalPodAutoscaler(namespace, name string) error {
+	client := config.GetArenaConfiger().GetClientSet().AutoscalingV2().HorizontalPodAutoscalers(namespace)
+	err := client.Delete(context.TODO(), name, metav1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// scaleMetricQuery returns the prometheus query of KEDA trigger and its metric type,
+// gpu-util is the average of all gpus and qps is divided by the replicas by KEDA
+func scaleMetricQuery(deploy *appsv1.Deployment, metric types.ServingScaleMetric) (string, string) {
+	if metric == types.GPUUtilScaleMetric {
+		return fmt.Sprintf(`avg(DCGM_FI_DEV_GPU_UTIL{namespace="%v",pod=~"%v-.*"})`, deploy.Namespace, deploy.Name), "Value"
+	}
+	return fmt.Sprintf(`sum(rate(istio_requests_total{destination_workload_namespace="%v",destination_workload="%v"}[1m]))`, deploy.Namespace, deploy.Name), "AverageValue"
+}
+
+func buildScaledObject(deploy *appsv1.Deployment, args types.ServingAutoscaleArgs, prometheusAddress string) map[string]interface{} {
+	query, metricType := scaleMetricQuery(deploy, args.ScaleMetric)
+	return map[string]interface{}{
+		"apiVersion": kedaGroupVersion.String(),
+		"kind":       "ScaledObject",
+		"metadata":   autoscalerMeta(deploy, args),
+		"spec": map[string]interface{}{
+			"scaleTargetRef": map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"name":       deploy.Name,
+			},
+			"minReplicaCount": args.MinReplicas,
+			"maxReplicaCount": args.MaxReplicas,
+			"triggers": []interface{}{
+				map[string]interface{}{
+					"type":       "prometheus",
+					"metricType": metricType,
+					"metadata": map[string]interface{}{
+						"serverAddress": prometheusAddress,
+						"query":         query,
+						"threshold":     strconv.Itoa(args.ScaleTarget),
+					},
+				},
+			},
+		},
+	}
+}
+
+func applyScaledObject(deploy *appsv1.Deployment, args types.ServingAutoscaleArgs) error {
+	kedaClient, err := newRESTClient(kedaGroupVersion)
+	if err != nil {
+		return err
+	}
+	scaledObject := buildScaledObject(deploy, args, prometheus.GetPrometheusAddress())
+	data, err := kedaClient.Get().Namespace(deploy.Namespace).Resource(scaledObjectResource).Name(deploy.Name).Do(context.TODO()).Raw()
+	if k8serrors.IsNotFound(err) {
+		body, err := json.Marshal(scaledObject)
+		if err != nil {
+			return err
+		}
+		log.Debugf("create scaledobject: %s", body)
+		_, err = kedaClient.Post().Namespace(deploy.Namespace).Resource(scaledObjectResource).Body(body).Do(context.TODO()).Raw()
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	// only the spec and annotations are replaced to keep the metadata managed by KEDA
+	existing := map[string]interface{}{}
+	if err := json.Unmarshal(data, &existing); err != nil {
+		return err
+	}
+	metadata, _ := existing["metadata"].(map[string]interface{})
+	if metadata == nil {
+		metadata = map[string]interface{}{}
+		existing["metadata"] = metadata
+	}
+	metadata["annotations"] = scaledObject["metadata"].(metav1.ObjectMeta).Annotations
+	existing["spec"] = scaledObject["spec"]
+	body, err := json.Marshal(existing)
+	if err != nil {
+		return err
+	}
+	log.Debugf("update scaledobject: %s", body)
+	_, err = kedaClient.Put().Namespace(deploy.Namespace).Resource(scaledObjectResource).Name(deploy.Name).Body(body).Do(context.TODO()).Raw()
+	return err
+}
+
+func deleteScaledObject(namespace, name string) error {
+	kedaClient, err := newRESTClient(kedaGroupVersion)
+	if err != nil {
+		return err
+	}
+	_, err = kedaClient.Delete().Namespace(namespace).Resource(scaledObjectResource).Name(name).Do(context.TODO()).Raw()
+	// KEDA may be not installed if the ScaledObject has never been used
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// getServingAutoscaler returns the autoscaler created by arena for the serving job, nil if not found
+func getServingAutoscaler(namespace, name, version string) *types.ServingAutoscalerInfo {
+	selector := fmt.Sprintf("%v=%v,%v=%v,createdBy=arena", servingNameLabelKey, name, servingVersionLabelKey, version)
+	hpas, err := config.GetArenaConfiger().GetClientSet().AutoscalingV2().HorizontalPodAutoscalers(namespace).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: selector,
+	})
+	if err != nil {
+		log.Debugf("failed to list HorizontalPodAutoscalers: %v", err)
+		return nil
+	}
+	currentReplicas := map[string]int{}
+	for _, hpa := range hpas.Items {
+		if strings.HasPrefix(hpa.Name, kedaHPAPrefix) {
+			currentReplicas[strings.TrimPrefix(hpa.Name, kedaHPAPrefix)] = int(hpa.Status.CurrentReplicas)
+			continue
+		}
+		info := &types.ServingAutoscalerInfo{
+			Kind:            "HorizontalPodAutoscaler",
+			Name:            hpa.Name,
+			MaxReplicas:     int(hpa.Spec.MaxReplicas),
+			ScaleMetric:     hpa.Annotations[scaleMetricAnnotationKey],
+			CurrentReplicas: int(hpa.Status.CurrentReplicas),
+		}
+		if hpa.Spec.MinReplicas != nil {
+			info.MinReplicas = int(*hpa.Spec.MinReplicas)
+		}
+		info.ScaleTarget, _ = strconv.Atoi(hpa.Annotations[scaleTargetAnnotationKey])
+		return info
+	}
+	kedaClient, err := newRESTClient(kedaGroupVersion)
+	if err != nil {
+		return nil
+	}
+	data, err := kedaClient.Get().Namespace(namespace).Resource(scaledObjectResource).Param("labelSelector", selector).Do(context.TODO()).Raw()
+	if err != nil {
+		log.Debugf("failed to list ScaledObjects: %v", err)
+		return nil
+	}
+	scaledObjects := struct {
+		Items []struct {
+			Metadata metav1.ObjectMeta `json:"metadata"`
+			Spec     struct {
+				MinReplicaCount int `json:"minReplicaCount"`
+				MaxReplicaCount int `json:"maxReplicaCount"`
+			} `json:"spec"`
+		} `json:"items"`
+	}{}
+	if err := json.Unmarshal(data, &scaledObjects); err != nil || len(scaledObjects.Items) == 0 {
+		return nil
+	}
+	scaledObject := scaledObjects.Items[0]
+	info := &types.ServingAutoscalerInfo{
+		Kind:            "ScaledObject",
+		Name:            scaledObject.Metadata.Name,
+		MinReplicas:     scaledObject.Spec.MinReplicaCount,
+		MaxReplicas:     scaledObject.Spec.MaxReplicaCount,
+		ScaleMetric:     scaledObject.Metadata.Annotations[scaleMetricAnnotationKey],
+		CurrentReplicas: currentReplicas[scaledObject.Metadata.Name],
+	}
+	info.ScaleTarget, _ = strconv.Atoi(scaledObject.Metadata.Annotations[scaleTargetAnnotationKey])
+	return info
+}
@@ -0,0 +1,60 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+func TestBuildServingAutoscaler(t *testing.T) {
+	deploy := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "mnist-v1-tensorflow-serving",
+			Namespace: "default",
+			UID:       "uid",
+			Labels:    map[string]string{"servingName": "mnist", "servingVersion": "v1"},
+		},
+	}
+	args := types.ServingAutoscaleArgs{MinReplicas: 1, MaxReplicas: 4, ScaleMetric: types.MemoryScaleMetric, ScaleTarget: 70}
+	hpa := buildHorizontalPodAutoscaler(deploy, args)
+	if hpa.Spec.ScaleTargetRef.Name != deploy.Name || *hpa.Spec.MinReplicas != 1 || hpa.Spec.MaxReplicas != 4 {
+		t.Errorf("unexpected hpa spec: %+v", hpa.Spec)
+	}
+	if resource := hpa.Spec.Metrics[0].Resource; resource.Name != corev1.ResourceMemory || *resource.Target.AverageUtilization != 70 {
+		t.Errorf("unexpected hpa metric: %+v", resource)
+	}
+	if len(hpa.OwnerReferences) != 1 || hpa.OwnerReferences[0].UID != deploy.UID {
+		t.Fatalf("expected the hpa is owned by the deployment, got %v", hpa.OwnerReferences)
+	}
+	if owner := hpa.OwnerReferences[0]; owner.Controller == nil || !*owner.Controller || owner.BlockOwnerDeletion == nil || !*owner.BlockOwnerDeletion {
+		t.Errorf("expected the hpa is controlled by the deployment, got %+v", owner)
+	}
+
+	args.ScaleMetric = types.QPSScaleMetric
+	args.ScaleTarget = 10
+	scaledObject := buildScaledObject(deploy, args, "http://prometheus:9090")
+	trigger := scaledObject["spec"].(map[string]interface{})["triggers"].([]interface{})[0].(map[string]interface{})
+	metadata := trigger["metadata"].(map[string]interface{})
+	expected := `sum(rate(istio_requests_total{destination_workload_namespace="default",destination_workload="mnist-v1-tensorflow-serving"}[1m]))`
+	if metadata["query"] != expected || metadata["threshold"] != "10" || trigger["metricType"] != "AverageValue" {
+		t.Errorf("unexpected trigger: %v", trigger)
+	}
+}
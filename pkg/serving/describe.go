@@ -0,0 +1,317 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	kservev1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"gopkg.in/yaml.v2"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	lwsv1 "sigs.k8s.io/lws/api/leaderworkerset/v1"
+
+	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/util"
+)
+
+// childWorkloadKinds are the kinds of objects created by the serving workload, their events are reported too
+var childWorkloadKinds = map[string]bool{
+	"ReplicaSet":  true,
+	"StatefulSet": true,
+	"Deployment":  true,
+	"Revision":    true,
+}
+
+// DescribeServingJob collects the conditions, events and diagnosed problems of the serving job
+func DescribeServingJob(namespace, name, version string, servingType types.ServingJobType) (*types.ServingJobDescription, error) {
+	job, err := SearchServingJob(namespace, name, version, servingType)
+	if err != nil {
+		return nil, err
+	}
+	description := &types.ServingJobDescription{
+		Name:      job.Name(),
+		Namespace: job.Namespace(),
+		Type:      string(job.Type()),
+		Version:   job.Version(),
+		Desired:   job.DesiredInstances(),
+		Available: job.AvailableInstances(),
+	}
+	workload, err := servingWorkload(job)
+	if err != nil {
+		return nil, err
+	}
+	eventList, err := config.GetArenaConfiger().GetClientSet().CoreV1().Events(namespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list events of serving job %v: %v", job.Name(), err)
+	}
+	events := servingJobEvents(workload, job.Pods(), eventList.Items)
+	description.Conditions = workloadConditions(workload)
+	description.Problems = append(workloadProblems(workload, description.Conditions), diagnoseServingPods(job.Pods(), events)...)
+	for _, e := range events {
+		description.Events = append(description.Events, types.ServingEvent{
+			Object:   fmt.Sprintf("%v/%v", strings.ToLower(e.InvolvedObject.Kind), e.InvolvedObject.Name),
+			Type:     e.Type,
+			Reason:   e.Reason,
+			Message:  strings.TrimSpace(e.Message),
+			Count:    e.Count,
+			LastSeen: eventLastSeen(e),
+		})
+	}
+	return description, nil
+}
+
+// servingJobEvents returns the events of the workload, its child objects and its pods, ordered by the last seen time
+func servingJobEvents(workload interface{}, pods []*corev1.Pod, events []corev1.Event) []corev1.Event {
+	meta := workloadObjectMeta(workload)
+	uids := map[string]bool{string(meta.UID): true}
+	for _, pod := range pods {
+		uids[string(pod.UID)] = true
+	}
+	matched := []corev1.Event{}
+	for _, e := range events {
+		involved := e.InvolvedObject
+		if uids[string(involved.UID)] ||
+			(childWorkloadKinds[involved.Kind] && strings.HasPrefix(involved.Name, meta.Name+"-")) {
+			matched = append(matched, e)
+		}
+	}
+	sort.SliceStable(matched, func(i, j int) bool {
+		return eventLastSeen(matched[i]).Before(eventLastSeen(matched[j]))
+	})
+	return matched
+}
+
+func eventLastSeen(e corev1.Event) time.Time {
+	if !e.LastTimestamp.IsZero() {
+		return e.LastTimestamp.Time
+	}
+	if !e.EventTime.IsZero() {
+		return e.EventTime.Time
+	}
+	return e.CreationTimestamp.Time
+}
+
+// workloadConditions returns the conditions of deployment, InferenceService or LeaderWorkerSet
+func workloadConditions(workload interface{}) []types.ServingCondition {
+	conditions := []types.ServingCondition{}
+	switch w := workload.(type) {
+	case *appsv1.Deployment:
+		for _, c := range w.Status.Conditions {
+			conditions = append(conditions, types.ServingCondition{
+				Object:  "deployment/" + w.Name,
+				Type:    string(c.Type),
+				Status:  string(c.Status),
+				Reason:  c.Reason,
+				Message: c.Message,
+			})
+		}
+	case *kservev1beta1.InferenceService:
+		for _, c := range w.Status.Conditions {
+			conditions = append(conditions, types.ServingCondition{
+				Object:  "inferenceservice/" + w.Name,
+				Type:    string(c.Type),
+				Status:  string(c.Status),
+				Reason:  c.Reason,
+				Message: c.Message,
+			})
+		}
+	case *lwsv1.LeaderWorkerSet:
+		for _, c := range w.Status.Conditions {
+			conditions = append(conditions, types.ServingCondition{
+				Object:  "leaderworkerset/" + w.Name,
+				Type:    c.Type,
+				Status:  string(c.Status),
+				Reason:  c.Reason,
+				Message: c.Message,
+			})
+		}
+	}
+	return conditions
+}
+
+// workloadProblems reports the unhealthy conditions of the serving workload
+func workloadProblems(workload interface{}, conditions []types.ServingCondition) []types.ServingProblem {
+	problems := []types.ServingProblem{}
+	for _, c := range conditions {
+		unhealthy := false
+		switch workload.(type) {
+		case *appsv1.Deployment:
+			unhealthy = (c.Type == string(appsv1.DeploymentReplicaFailure) && c.Status == string(corev1.ConditionTrue)) ||
+				(c.Type != string(appsv1.DeploymentReplicaFailure) && c.Status == string(corev1.ConditionFalse))
+		case *kservev1beta1.InferenceService:
+			unhealthy = c.Status == string(corev1.ConditionFalse)
+		case *lwsv1.LeaderWorkerSet:
+			unhealthy = c.Type == string(lwsv1.LeaderWorkerSetAvailable) && c.Status == string(metav1.ConditionFalse)
+		}
+		if !unhealthy {
+			continue
+		}
+		message := c.Message
+		if message == "" {
+			message = c.Reason
+		}
+		problems = addServingProblem(problems, types.WorkloadNotReadyProblem, fmt.Sprintf("%v: %v", c.Type, message), c.Object)
+	}
+	return problems
+}
+
+// diagnoseServingPods finds out why the pods of serving job are not ready
+func diagnoseServingPods(pods []*corev1.Pod, events []corev1.Event) []types.ServingProblem {
+	problems := []types.ServingProblem{}
+	for _, pod := range pods {
+		object := "pod/" + pod.Name
+		if pod.DeletionTimestamp != nil || pod.Status.Phase == corev1.PodSucceeded {
+			continue
+		}
+		for _, c := range pod.Status.Conditions {
+			if c.Type == corev1.PodScheduled && c.Status == corev1.ConditionFalse {
+				problems = addServingProblem(problems, unschedulableReason(c.Message), c.Message, object)
+			}
+		}
+		statuses := append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...)
+		statuses = append(statuses, pod.Status.ContainerStatuses...)
+		for _, status := range statuses {
+			if reason, message := containerProblem(status); reason != "" {
+				problems = addServingProblem(problems, reason, message, object)
+			}
+		}
+		if pod.Status.Phase != corev1.PodRunning || isPodReady(pod) {
+			continue
+		}
+		// the latest failed probe explains why a running pod is not ready
+		for i := len(events) - 1; i >= 0; i-- {
+			e := events[i]
+			if e.InvolvedObject.UID == pod.UID && e.Reason == "Unhealthy" {
+				problems = addServingProblem(problems, types.ProbeFailedProblem, strings.TrimSpace(e.Message), object)
+				break
+			}
+		}
+	}
+	return problems
+}
+
+// containerProblem returns the problem of container, such as image pull failure, crash loop and OOM
+func containerProblem(status corev1.ContainerStatus) (types.ServingProblemReason, string) {
+	if waiting := status.State.Waiting; waiting != nil {
+		switch waiting.Reason {
+		case "ErrImagePull", "ImagePullBackOff", "InvalidImageName":
+			return types.ImagePullProblem, fmt.Sprintf("container %v: %v", status.Name, defaultIfEmpty(waiting.Message))
+		case "CreateContainerConfigError", "CreateContainerError":
+			return types.ContainerConfigProblem, fmt.Sprintf("container %v: %v", status.Name, defaultIfEmpty(waiting.Message))
+		}
+	}
+	terminated := status.LastTerminationState.Terminated
+	if terminated == nil {
+		terminated = status.State.Terminated
+	}
+	if terminated != nil && terminated.Reason == "OOMKilled" {
+		return types.OOMKilledProblem, fmt.Sprintf("container %v was killed because it exceeded its memory limit", status.Name)
+	}
+	if waiting := status.State.Waiting; waiting != nil && waiting.Reason == "CrashLoopBackOff" {
+		message := fmt.Sprintf("container %v keeps crashing, restarted %v times", status.Name, status.RestartCount)
+		if terminated != nil {
+			message = fmt.Sprintf("%v, last exit code %v (%v)", message, terminated.ExitCode, defaultIfEmpty(terminated.Reason))
+		}
+		return types.CrashLoopBackOffProblem, message
+	}
+	return "", ""
+}
+
+// unschedulableReason classifies the message of scheduler
+func unschedulableReason(message string) types.ServingProblemReason {
+	switch {
+	case strings.Contains(message, "Insufficient "+GPU_RESOURCE_NAME):
+		return types.InsufficientGPUProblem
+	case strings.Contains(message, "Insufficient "+GPU_MEM_RESOURCE_NAME):
+		return types.InsufficientGPUMemoryProblem
+	case strings.Contains(message, "taint"):
+		return types.UntoleratedTaintProblem
+	}
+	return types.UnschedulableProblem
+}
+
+// addServingProblem merges the objects which have the same problem
+func addServingProblem(problems []types.ServingProblem, reason types.ServingProblemReason, message, object string) []types.ServingProblem {
+	for i := range problems {
+		if problems[i].Reason == reason && problems[i].Message == message {
+			problems[i].Objects = append(problems[i].Objects, object)
+			return problems
+		}
+	}
+	return append(problems, types.ServingProblem{Reason: reason, Message: message, Objects: []string{object}})
+}
+
+// PrintServingJobDescription prints the diagnosis report of serving job
+func PrintServingJobDescription(description *types.ServingJobDescription, format types.FormatStyle) {
+	switch format {
+	case types.JsonFormat:
+		data, _ := json.MarshalIndent(description, "", "    ")
+		fmt.Printf("%v\n", string(data))
+		return
+	case types.YamlFormat:
+		data, _ := yaml.Marshal(description)
+		fmt.Printf("%v", string(data))
+		return
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Name:\t%v\n", description.Name)
+	fmt.Fprintf(w, "Namespace:\t%v\n", description.Namespace)
+	fmt.Fprintf(w, "Type:\t%v\n", description.Type)
+	fmt.Fprintf(w, "Version:\t%v\n", description.Version)
+	fmt.Fprintf(w, "Desired:\t%v\n", description.Desired)
+	fmt.Fprintf(w, "Available:\t%v\n", description.Available)
+	if len(description.Conditions) != 0 {
+		fmt.Fprintf(w, "\nConditions:\n")
+		fmt.Fprintf(w, "  OBJECT\tTYPE\tSTATUS\tREASON\tMESSAGE\n")
+		for _, c := range description.Conditions {
+			fmt.Fprintf(w, "  %v\t%v\t%v\t%v\t%v\n", c.Object, c.Type, c.Status, defaultIfEmpty(c.Reason), defaultIfEmpty(c.Message))
+		}
+	}
+	fmt.Fprintf(w, "\nDiagnosis:\n")
+	if len(description.Problems) == 0 {
+		fmt.Fprintf(w, "  No problems found.\n")
+	} else {
+		fmt.Fprintf(w, "  REASON\tOBJECTS\tMESSAGE\n")
+		for _, p := range description.Problems {
+			fmt.Fprintf(w, "  %v\t%v\t%v\n", p.Reason, strings.Join(p.Objects, ","), p.Message)
+		}
+	}
+	fmt.Fprintf(w, "\nEvents:\n")
+	if len(description.Events) == 0 {
+		fmt.Fprintf(w, "  <none>\n")
+	} else {
+		fmt.Fprintf(w, "  LAST SEEN\tTYPE\tREASON\tOBJECT\tMESSAGE\n")
+		for _, e := range description.Events {
+			fmt.Fprintf(w, "  %v\t%v\t%v\t%v\t%v\n",
+				util.ShortHumanDuration(time.Since(e.LastSeen)),
+				e.Type,
+				e.Reason,
+				e.Object,
+				e.Message,
+			)
+		}
+	}
+	_ = w.Flush()
+}
@@ -0,0 +1,98 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+func TestDiagnoseServingPods(t *testing.T) {
+	pending := func(name string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodPending,
+				Conditions: []corev1.PodCondition{{
+					Type:    corev1.PodScheduled,
+					Status:  corev1.ConditionFalse,
+					Reason:  corev1.PodReasonUnschedulable,
+					Message: "0/3 nodes are available: 1 node(s) had untolerated taint {gpu: true}, 2 Insufficient nvidia.com/gpu.",
+				}},
+			},
+		}
+	}
+	crashing := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "mnist-2", UID: "uid-2"},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:         "serving",
+				RestartCount: 3,
+				State:        corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+				LastTerminationState: corev1.ContainerState{
+					Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137},
+				},
+			}},
+		},
+	}
+	pulling := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "mnist-3", UID: "uid-3"},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodPending,
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:  "serving",
+				State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff", Message: "Back-off pulling image"}},
+			}},
+		},
+	}
+	unready := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "mnist-4", UID: "uid-4"},
+		Status: corev1.PodStatus{
+			Phase:      corev1.PodRunning,
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionFalse}},
+		},
+	}
+	events := []corev1.Event{{
+		InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "mnist-4", UID: "uid-4"},
+		Reason:         "Unhealthy",
+		Message:        "Readiness probe failed: connection refused",
+	}}
+	problems := diagnoseServingPods([]*corev1.Pod{pending("mnist-0"), pending("mnist-1"), crashing, pulling, unready}, events)
+	expected := []struct {
+		reason  types.ServingProblemReason
+		objects int
+	}{
+		{types.InsufficientGPUProblem, 2},
+		{types.OOMKilledProblem, 1},
+		{types.ImagePullProblem, 1},
+		{types.ProbeFailedProblem, 1},
+	}
+	if len(problems) != len(expected) {
+		t.Fatalf("expected %v problems, got %+v", len(expected), problems)
+	}
+	for i, e := range expected {
+		if problems[i].Reason != e.reason || len(problems[i].Objects) != e.objects {
+			t.Errorf("problem %v: expected %v of %v objects, got %+v", i, e.reason, e.objects, problems[i])
+		}
+	}
+	if problems[3].Message != "Readiness probe failed: connection refused" {
+		t.Errorf("expected the probe event as evidence, got %v", problems[3].Message)
+	}
+}
@@ -118,17 +118,21 @@ func validateJobs(jobs []ServingJob, name string) error {
 }
 
 func PrintServingJob(job ServingJob, mv *types.ModelVersion, format types.FormatStyle) {
+	jobInfo := job.Convert2JobInfo()
+	if job.Type() != types.KServeJob {
+		jobInfo.Autoscaler = getServingAutoscaler(jobInfo.Namespace, jobInfo.Name, jobInfo.Version)
+	}
+	jobInfo.Stage = servingStage(job)
 	switch format {
 	case types.JsonFormat:
-		data, _ := json.MarshalIndent(job.Convert2JobInfo(), "", "    ")
+		data, _ := json.MarshalIndent(jobInfo, "", "    ")
 		fmt.Printf("%v", string(data))
 		return
 	case types.YamlFormat:
-		data, _ := yaml.Marshal(job.Convert2JobInfo())
+		data, _ := yaml.Marshal(jobInfo)
 		fmt.Printf("%v", string(data))
 		return
 	}
-	jobInfo := job.Convert2JobInfo()
 	endpointAddress := jobInfo.IPAddress
 	ports := []string{}
 	for _, e := range jobInfo.Endpoints {
@@ -195,11 +199,20 @@ func PrintServingJob(job ServingJob, mv *types.ModelVersion, format types.Format
 	fmt.Fprintf(w, "Namespace:\t%v\n", jobInfo.Namespace)
 	fmt.Fprintf(w, "Type:\t%v\n", jobInfo.Type)
 	fmt.Fprintf(w, "Version:\t%v\n", jobInfo.Version)
+	if jobInfo.Stage != "" {
+		fmt.Fprintf(w, "Stage:\t%v\n", jobInfo.Stage)
+	}
 	fmt.Fprintf(w, "Desired:\t%v\n", jobInfo.Desired)
 	fmt.Fprintf(w, "Available:\t%v\n", jobInfo.Available)
 	fmt.Fprintf(w, "Age:\t%v\n", jobInfo.Age)
 	fmt.Fprintf(w, "Address:\t%v\n", endpointAddress)
 	fmt.Fprintf(w, "Port:\t%v\n", strings.Join(ports, ","))
+	if a := jobInfo.Autoscaler; a != nil {
+		fmt.Fprintf(w, "Autoscaler:\t%v(min: %v, max: %v, current: %v, %v: %v)\n", a.Kind, a.MinReplicas, a.MaxReplicas, a.CurrentReplicas, a.ScaleMetric, a.ScaleTarget)
+	}
+	if jobInfo.OpenAIBaseURL != "" {
+		fmt.Fprintf(w, "OpenAIBaseURL:\t%v\n", jobInfo.OpenAIBaseURL)
+	}
 	if mv != nil {
 		if mv.Name != "" {
 			fmt.Fprintf(w, "ModelName:\t%v\n", mv.Name)
@@ -0,0 +1,286 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/kserve/kserve/pkg/constants"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+
+	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+// defaultKServePort is the port of kserve model server in the predictor container
+const defaultKServePort = 8080
+
+// InvokeServingJob sends a request to the inference api of the serving job,
+// the path and protocol are inferred by the serving type
+func InvokeServingJob(ctx context.Context, namespace string, args *types.ServingInvokeArgs) (*types.ServingInvokeResult, error) {
+	job, err := SearchServingJob(namespace, args.Name, args.Version, args.Type)
+	if err != nil {
+		return nil, err
+	}
+	if args.GRPC {
+		return invokeServingJobGRPC(ctx, job, args)
+	}
+	path := args.Path
+	if path == "" {
+		modelName := args.ModelName
+		if modelName == "" {
+			modelName = job.Name()
+		}
+		path = invokePath(job.Type(), modelName, invokeProtocol(job, args.Protocol), args.Data)
+	}
+	var baseURL string
+	if args.PortForward {
+		localPort, stop, err := portForwardServingJob(job, restfulServingPortName)
+		if err != nil {
+			return nil, err
+		}
+		defer stop()
+		baseURL = fmt.Sprintf("http://127.0.0.1:%v", localPort)
+	} else {
+		baseURL, err = servingJobBaseURL(job)
+		if err != nil {
+			return nil, err
+		}
+	}
+	requestURL := strings.TrimSuffix(baseURL, "/") + "/" + strings.TrimPrefix(path, "/")
+	method := http.MethodGet
+	if len(args.Data) != 0 {
+		method = http.MethodPost
+	}
+	request, err := http.NewRequestWithContext(ctx, method, requestURL, bytes.NewReader(args.Data))
+	if err != nil {
+		return nil, err
+	}
+	if len(args.Data) != 0 {
+		request.Header.Set("Content-Type", "application/json")
+	}
+	log.Debugf("%v %v", method, requestURL)
+	client := &http.Client{Timeout: args.Timeout}
+	start := time.Now()
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke serving job %v: %v", args.Name, err)
+	}
+	defer response.Body.Close()
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the response of serving job %v: %v", args.Name, err)
+	}
+	return &types.ServingInvokeResult{
+		URL:        requestURL,
+		StatusCode: response.StatusCode,
+		Latency:    time.Since(start),
+		Body:       body,
+	}, nil
+}
+
+// invokePath returns the path of the inference api:
+// tf-serving uses REST predict, triton uses v2 infer, kserve uses v1 predict or v2 infer,
+// vllm and sglang use OpenAI chat/completions if the request has messages, otherwise completions
+func invokePath(servingType types.ServingJobType, modelName, protocol string, data []byte) string {
+	switch servingType {
+	case types.TFServingJob:
+		return fmt.Sprintf("/v1/models/%v:predict", modelName)
+	case types.TritonServingJob:
+		return fmt.Sprintf("/v2/models/%v/infer", modelName)
+	case types.KServeJob, types.KFServingJob:
+		if protocol == string(constants.ProtocolV2) {
+			return fmt.Sprintf("/v2/models/%v/infer", modelName)
+		}
+		return fmt.Sprintf("/v1/models/%v:predict", modelName)
+	case types.SeldonServingJob:
+		return "/api/v1.0/predictions"
+	case types.VLLMServingJob, types.SGLangServingJob:
+		request := map[string]interface{}{}
+		if err := json.Unmarshal(data, &request); err == nil {
+			if _, ok := request["messages"]; ok {
+				return "/v1/chat/completions"
+			}
+		}
+		return "/v1/completions"
+	}
+	return "/"
+}
+
+// invokeProtocol returns the protocol of kserve from the InferenceService if it is not given
+func invokeProtocol(job ServingJob, protocol string) string {
+	if protocol != "" {
+		return protocol
+	}
+	if ksjob, ok := job.(*kserveJob); ok {
+		model := ksjob.inferenceService.Spec.Predictor.Model
+		if model != nil && model.ProtocolVersion != nil {
+			return string(*model.ProtocolVersion)
+		}
+	}
+	return string(constants.ProtocolV1)
+}
+
+// servingJobBaseURL returns the url of the serving job address, it is only reachable in the cluster
+// unless the service is exposed by a load balancer
+func servingJobBaseURL(job ServingJob) (string, error) {
+	if job.Type() == types.KServeJob {
+		if address := job.IPAddress(); address != "" {
+			return address, nil
+		}
+		return "", fmt.Errorf("the url of kserve job %v is not ready", job.Name())
+	}
+	jobInfo := job.Convert2JobInfo()
+	if jobInfo.IPAddress == "" || jobInfo.IPAddress == "N/A" {
+		return "", fmt.Errorf("not found the address of serving job %v, please use --port-forward", job.Name())
+	}
+	for _, e := range jobInfo.Endpoints {
+		if e.Name == "RESTFUL" || e.Name == "HTTP" {
+			return fmt.Sprintf("http://%v:%v", jobInfo.IPAddress, e.Port), nil
+		}
+	}
+	return "", fmt.Errorf("not found the restful endpoint of serving job %v", job.Name())
+}
+
+// portForwardServingJob forwards a local port to the port of a ready pod of the serving job,
+// the returned function stops the forwarding
+func portForwardServingJob(job ServingJob, portName string) (int, func(), error) {
+	var pod *corev1.Pod
+	for _, p := range job.Pods() {
+		if isPodReady(p) {
+			pod = p
+			break
+		}
+	}
+	if pod == nil {
+		return 0, nil, fmt.Errorf("not found ready instance of serving job %v", job.Name())
+	}
+	remotePort, err := servingPodPort(job, pod, portName)
+	if err != nil {
+		return 0, nil, err
+	}
+	return portForwardPod(pod, remotePort)
+}
+
+// portForwardPod forwards a local port to the port of pod, the returned function stops the forwarding
+func portForwardPod(pod *corev1.Pod, remotePort int) (int, func(), error) {
+	restConfig := config.GetArenaConfiger().GetRestConfig()
+	transport, upgrader, err := spdy.RoundTripperFor(restConfig)
+	if err != nil {
+		return 0, nil, err
+	}
+	forwardURL, err := url.Parse(fmt.Sprintf("%v/api/v1/namespaces/%v/pods/%v/portforward", strings.TrimSuffix(restConfig.Host, "/"), pod.Namespace, pod.Name))
+	if err != nil {
+		return 0, nil, err
+	}
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, forwardURL)
+	stopCh := make(chan struct{})
+	readyCh := make(chan struct{})
+	forwarder, err := portforward.New(dialer, []string{fmt.Sprintf("0:%v", remotePort)}, stopCh, readyCh, io.Discard, os.Stderr)
+	if err != nil {
+		return 0, nil, err
+	}
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- forwarder.ForwardPorts()
+	}()
+	select {
+	case <-readyCh:
+	case err := <-errCh:
+		return 0, nil, fmt.Errorf("failed to forward port %v of pod %v: %v", remotePort, pod.Name, err)
+	}
+	ports, err := forwarder.GetPorts()
+	if err != nil || len(ports) == 0 {
+		close(stopCh)
+		return 0, nil, fmt.Errorf("failed to get the forwarded port of pod %v: %v", pod.Name, err)
+	}
+	log.Debugf("forward 127.0.0.1:%v to %v:%v", ports[0].Local, pod.Name, remotePort)
+	return int(ports[0].Local), func() { close(stopCh) }, nil
+}
+
+// servingPodPort returns the container port of the service port with the name,
+// the restful port of kserve is the port of predictor container
+func servingPodPort(job ServingJob, pod *corev1.Pod, portName string) (int, error) {
+	if job.Type() == types.KServeJob && portName == restfulServingPortName {
+		for _, c := range pod.Spec.Containers {
+			if c.Name == constants.InferenceServiceContainerName && len(c.Ports) != 0 {
+				return int(c.Ports[0].ContainerPort), nil
+			}
+		}
+		return defaultKServePort, nil
+	}
+	for _, svc := range job.Services() {
+		for _, p := range svc.Spec.Ports {
+			if p.Name != portName {
+				continue
+			}
+			if p.TargetPort.IntVal != 0 {
+				return int(p.TargetPort.IntVal), nil
+			}
+			if p.TargetPort.StrVal == "" {
+				return int(p.Port), nil
+			}
+			for _, c := range pod.Spec.Containers {
+				for _, cp := range c.Ports {
+					if cp.Name == p.TargetPort.StrVal {
+						return int(cp.ContainerPort), nil
+					}
+				}
+			}
+		}
+	}
+	return 0, fmt.Errorf("not found the port %v of serving job %v", portName, job.Name())
+}
+
+func isPodReady(pod *corev1.Pod) bool {
+	if pod.Status.Phase != corev1.PodRunning {
+		return false
+	}
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// PrintServingInvokeResult prints the response and latency of the inference api
+func PrintServingInvokeResult(result *types.ServingInvokeResult) {
+	fmt.Printf("URL:      %v\n", result.URL)
+	if result.GRPCCode != "" {
+		fmt.Printf("Status:   %v\n", result.GRPCCode)
+	} else {
+		fmt.Printf("Status:   %v %v\n", result.StatusCode, http.StatusText(result.StatusCode))
+	}
+	fmt.Printf("Latency:  %v\n\n", result.Latency.Round(time.Millisecond))
+	var body bytes.Buffer
+	if err := json.Indent(&body, result.Body, "", "  "); err == nil {
+		fmt.Println(body.String())
+		return
+	}
+	fmt.Println(string(result.Body))
+}
@@ -0,0 +1,362 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+// grpcPredictAPI describes the gRPC predict method of a serving type,
+// the messages only have the fields used to predict and are built at runtime,
+// so arena needs not to depend on the generated code of tf-serving and triton
+type grpcPredictAPI struct {
+	method   string
+	request  protoreflect.MessageDescriptor
+	response protoreflect.MessageDescriptor
+	// modelNamePath is the field path of model name in the request
+	modelNamePath []string
+}
+
+// grpcPredictAPIs returns the gRPC predict api of tf-serving and triton
+func grpcPredictAPIs() (map[types.ServingJobType]*grpcPredictAPI, error) {
+	tfFile, err := protodesc.NewFile(tfServingFileDescriptor(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build the descriptor of tf-serving: %v", err)
+	}
+	tritonFile, err := protodesc.NewFile(tritonFileDescriptor(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build the descriptor of triton: %v", err)
+	}
+	return map[types.ServingJobType]*grpcPredictAPI{
+		types.TFServingJob: {
+			method:        "/tensorflow.serving.PredictionService/Predict",
+			request:       tfFile.Messages().ByName("PredictRequest"),
+			response:      tfFile.Messages().ByName("PredictResponse"),
+			modelNamePath: []string{"model_spec", "name"},
+		},
+		types.TritonServingJob: {
+			method:        "/inference.GRPCInferenceService/ModelInfer",
+			request:       tritonFile.Messages().ByName("ModelInferRequest"),
+			response:      tritonFile.Messages().ByName("ModelInferResponse"),
+			modelNamePath: []string{"model_name"},
+		},
+	}, nil
+}
+
+// invokeServingJobGRPC sends the request to the gRPC predict api of tf-serving or triton,
+// the request is the protobuf message in json and the model name is set if it is empty
+func invokeServingJobGRPC(ctx context.Context, job ServingJob, args *types.ServingInvokeArgs) (*types.ServingInvokeResult, error) {
+	apis, err := grpcPredictAPIs()
+	if err != nil {
+		return nil, err
+	}
+	api, ok := apis[job.Type()]
+	if !ok {
+		return nil, fmt.Errorf("the grpc api is only supported by %v and %v, not %v", types.TFServingJob, types.TritonServingJob, job.Type())
+	}
+	if len(args.Data) == 0 {
+		return nil, fmt.Errorf("the request of grpc api is required, please set it by --data")
+	}
+	request := dynamicpb.NewMessage(api.request)
+	if err := protojson.Unmarshal(args.Data, request); err != nil {
+		return nil, fmt.Errorf("failed to parse the request as %v: %v", api.request.FullName(), err)
+	}
+	modelName := args.ModelName
+	if modelName == "" {
+		modelName = job.Name()
+	}
+	setDefaultModelName(request, api.modelNamePath, modelName)
+	var address string
+	if args.PortForward {
+		localPort, stop, err := portForwardServingJob(job, grpcServingPortName)
+		if err != nil {
+			return nil, err
+		}
+		defer stop()
+		address = fmt.Sprintf("127.0.0.1:%v", localPort)
+	} else {
+		address, err = servingJobGRPCAddress(job)
+		if err != nil {
+			return nil, err
+		}
+	}
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if args.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, args.Timeout)
+		defer cancel()
+	}
+	requestURL := fmt.Sprintf("grpc://%v%v", address, api.method)
+	log.Debugf("call %v", requestURL)
+	response := dynamicpb.NewMessage(api.response)
+	start := time.Now()
+	if err := conn.Invoke(ctx, api.method, request, response); err != nil {
+		return nil, fmt.Errorf("failed to invoke serving job %v: %v", args.Name, status.Convert(err).Message())
+	}
+	latency := time.Since(start)
+	body, err := protojson.Marshal(response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the response of serving job %v: %v", args.Name, err)
+	}
+	return &types.ServingInvokeResult{
+		URL:      requestURL,
+		GRPCCode: codes.OK.String(),
+		Latency:  latency,
+		Body:     body,
+	}, nil
+}
+
+// setDefaultModelName sets the field of model name in the request if it is empty
+func setDefaultModelName(request protoreflect.Message, fieldPath []string, modelName string) {
+	message := request
+	for i, name := range fieldPath {
+		field := message.Descriptor().Fields().ByName(protoreflect.Name(name))
+		if i == len(fieldPath)-1 {
+			if message.Get(field).String() == "" {
+				message.Set(field, protoreflect.ValueOfString(modelName))
+			}
+			return
+		}
+		message = message.Mutable(field).Message()
+	}
+}
+
+// servingJobGRPCAddress returns the grpc address of the serving job, it is only reachable in the cluster
+// unless the service is exposed by a load balancer
+func servingJobGRPCAddress(job ServingJob) (string, error) {
+	jobInfo := job.Convert2JobInfo()
+	if jobInfo.IPAddress == "" || jobInfo.IPAddress == "N/A" {
+		return "", fmt.Errorf("not found the address of serving job %v, please use --port-forward", job.Name())
+	}
+	for _, e := range jobInfo.Endpoints {
+		if e.Name == "GRPC" {
+			return fmt.Sprintf("%v:%v", jobInfo.IPAddress, e.Port), nil
+		}
+	}
+	return "", fmt.Errorf("not found the grpc endpoint of serving job %v", job.Name())
+}
+
+// tfServingFileDescriptor describes the PredictionService.Predict messages of
+// tensorflow_serving/apis/predict.proto and its dependencies
+func tfServingFileDescriptor() *descriptorpb.FileDescriptorProto {
+	dataTypes := []string{
+		"DT_INVALID", "DT_FLOAT", "DT_DOUBLE", "DT_INT32", "DT_UINT8", "DT_INT16", "DT_INT8", "DT_STRING",
+		"DT_COMPLEX64", "DT_INT64", "DT_BOOL", "DT_QINT8", "DT_QUINT8", "DT_QINT32", "DT_BFLOAT16", "DT_QINT16",
+		"DT_QUINT16", "DT_UINT16", "DT_COMPLEX128", "DT_HALF", "DT_RESOURCE", "DT_VARIANT", "DT_UINT32", "DT_UINT64",
+	}
+	dataType := &descriptorpb.EnumDescriptorProto{Name: proto.String("DataType")}
+	for i, name := range dataTypes {
+		dataType.Value = append(dataType.Value, &descriptorpb.EnumValueDescriptorProto{
+			Name:   proto.String(name),
+			Number: proto.Int32(int32(i)),
+		})
+	}
+	tensorEntry := func(name string) *descriptorpb.DescriptorProto {
+		return mapEntryDescriptor(name, messageFieldDescriptor("value", 2, ".tensorflow.serving.TensorProto", false))
+	}
+	return &descriptorpb.FileDescriptorProto{
+		Name:     proto.String("arena/tensorflow_serving/predict.proto"),
+		Package:  proto.String("tensorflow.serving"),
+		Syntax:   proto.String("proto3"),
+		EnumType: []*descriptorpb.EnumDescriptorProto{dataType},
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("TensorShapeProto"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					messageFieldDescriptor("dim", 2, ".tensorflow.serving.TensorShapeProto.Dim", true),
+					scalarFieldDescriptor("unknown_rank", 3, descriptorpb.FieldDescriptorProto_TYPE_BOOL, false),
+				},
+				NestedType: []*descriptorpb.DescriptorProto{
+					{
+						Name: proto.String("Dim"),
+						Field: []*descriptorpb.FieldDescriptorProto{
+							scalarFieldDescriptor("size", 1, descriptorpb.FieldDescriptorProto_TYPE_INT64, false),
+							scalarFieldDescriptor("name", 2, descriptorpb.FieldDescriptorProto_TYPE_STRING, false),
+						},
+					},
+				},
+			},
+			{
+				Name: proto.String("TensorProto"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					{
+						Name:     proto.String("dtype"),
+						Number:   proto.Int32(1),
+						Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+						Type:     descriptorpb.FieldDescriptorProto_TYPE_ENUM.Enum(),
+						TypeName: proto.String(".tensorflow.serving.DataType"),
+					},
+					messageFieldDescriptor("tensor_shape", 2, ".tensorflow.serving.TensorShapeProto", false),
+					scalarFieldDescriptor("version_number", 3, descriptorpb.FieldDescriptorProto_TYPE_INT32, false),
+					scalarFieldDescriptor("tensor_content", 4, descriptorpb.FieldDescriptorProto_TYPE_BYTES, false),
+					scalarFieldDescriptor("half_val", 13, descriptorpb.FieldDescriptorProto_TYPE_INT32, true),
+					scalarFieldDescriptor("float_val", 5, descriptorpb.FieldDescriptorProto_TYPE_FLOAT, true),
+					scalarFieldDescriptor("double_val", 6, descriptorpb.FieldDescriptorProto_TYPE_DOUBLE, true),
+					scalarFieldDescriptor("int_val", 7, descriptorpb.FieldDescriptorProto_TYPE_INT32, true),
+					scalarFieldDescriptor("string_val", 8, descriptorpb.FieldDescriptorProto_TYPE_BYTES, true),
+					scalarFieldDescriptor("scomplex_val", 9, descriptorpb.FieldDescriptorProto_TYPE_FLOAT, true),
+					scalarFieldDescriptor("int64_val", 10, descriptorpb.FieldDescriptorProto_TYPE_INT64, true),
+					scalarFieldDescriptor("bool_val", 11, descriptorpb.FieldDescriptorProto_TYPE_BOOL, true),
+					scalarFieldDescriptor("dcomplex_val", 12, descriptorpb.FieldDescriptorProto_TYPE_DOUBLE, true),
+					scalarFieldDescriptor("uint32_val", 16, descriptorpb.FieldDescriptorProto_TYPE_UINT32, true),
+					scalarFieldDescriptor("uint64_val", 17, descriptorpb.FieldDescriptorProto_TYPE_UINT64, true),
+				},
+			},
+			{
+				Name: proto.String("ModelSpec"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					scalarFieldDescriptor("name", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, false),
+					scalarFieldDescriptor("signature_name", 3, descriptorpb.FieldDescriptorProto_TYPE_STRING, false),
+					scalarFieldDescriptor("version_label", 4, descriptorpb.FieldDescriptorProto_TYPE_STRING, false),
+				},
+			},
+			{
+				Name: proto.String("PredictRequest"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					messageFieldDescriptor("model_spec", 1, ".tensorflow.serving.ModelSpec", false),
+					messageFieldDescriptor("inputs", 2, ".tensorflow.serving.PredictRequest.InputsEntry", true),
+					scalarFieldDescriptor("output_filter", 3, descriptorpb.FieldDescriptorProto_TYPE_STRING, true),
+				},
+				NestedType: []*descriptorpb.DescriptorProto{tensorEntry("InputsEntry")},
+			},
+			{
+				Name: proto.String("PredictResponse"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					messageFieldDescriptor("outputs", 1, ".tensorflow.serving.PredictResponse.OutputsEntry", true),
+					messageFieldDescriptor("model_spec", 2, ".tensorflow.serving.ModelSpec", false),
+				},
+				NestedType: []*descriptorpb.DescriptorProto{tensorEntry("OutputsEntry")},
+			},
+		},
+	}
+}
+
+// tritonFileDescriptor describes the GRPCInferenceService.ModelInfer messages of grpc_service.proto,
+// the parameters of request and tensors are not supported
+func tritonFileDescriptor() *descriptorpb.FileDescriptorProto {
+	tensor := func(name string) *descriptorpb.DescriptorProto {
+		return &descriptorpb.DescriptorProto{
+			Name: proto.String(name),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				scalarFieldDescriptor("name", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, false),
+				scalarFieldDescriptor("datatype", 2, descriptorpb.FieldDescriptorProto_TYPE_STRING, false),
+				scalarFieldDescriptor("shape", 3, descriptorpb.FieldDescriptorProto_TYPE_INT64, true),
+				messageFieldDescriptor("contents", 5, ".inference.InferTensorContents", false),
+			},
+		}
+	}
+	return &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("arena/triton/grpc_service.proto"),
+		Package: proto.String("inference"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("InferTensorContents"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					scalarFieldDescriptor("bool_contents", 1, descriptorpb.FieldDescriptorProto_TYPE_BOOL, true),
+					scalarFieldDescriptor("int_contents", 2, descriptorpb.FieldDescriptorProto_TYPE_INT32, true),
+					scalarFieldDescriptor("int64_contents", 3, descriptorpb.FieldDescriptorProto_TYPE_INT64, true),
+					scalarFieldDescriptor("uint_contents", 4, descriptorpb.FieldDescriptorProto_TYPE_UINT32, true),
+					scalarFieldDescriptor("uint64_contents", 5, descriptorpb.FieldDescriptorProto_TYPE_UINT64, true),
+					scalarFieldDescriptor("fp32_contents", 6, descriptorpb.FieldDescriptorProto_TYPE_FLOAT, true),
+					scalarFieldDescriptor("fp64_contents", 7, descriptorpb.FieldDescriptorProto_TYPE_DOUBLE, true),
+					scalarFieldDescriptor("bytes_contents", 8, descriptorpb.FieldDescriptorProto_TYPE_BYTES, true),
+				},
+			},
+			{
+				Name: proto.String("ModelInferRequest"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					scalarFieldDescriptor("model_name", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, false),
+					scalarFieldDescriptor("model_version", 2, descriptorpb.FieldDescriptorProto_TYPE_STRING, false),
+					scalarFieldDescriptor("id", 3, descriptorpb.FieldDescriptorProto_TYPE_STRING, false),
+					messageFieldDescriptor("inputs", 5, ".inference.ModelInferRequest.InferInputTensor", true),
+					messageFieldDescriptor("outputs", 6, ".inference.ModelInferRequest.InferRequestedOutputTensor", true),
+					scalarFieldDescriptor("raw_input_contents", 7, descriptorpb.FieldDescriptorProto_TYPE_BYTES, true),
+				},
+				NestedType: []*descriptorpb.DescriptorProto{
+					tensor("InferInputTensor"),
+					{
+						Name: proto.String("InferRequestedOutputTensor"),
+						Field: []*descriptorpb.FieldDescriptorProto{
+							scalarFieldDescriptor("name", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, false),
+						},
+					},
+				},
+			},
+			{
+				Name: proto.String("ModelInferResponse"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					scalarFieldDescriptor("model_name", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, false),
+					scalarFieldDescriptor("model_version", 2, descriptorpb.FieldDescriptorProto_TYPE_STRING, false),
+					scalarFieldDescriptor("id", 3, descriptorpb.FieldDescriptorProto_TYPE_STRING, false),
+					messageFieldDescriptor("outputs", 5, ".inference.ModelInferResponse.InferOutputTensor", true),
+					scalarFieldDescriptor("raw_output_contents", 6, descriptorpb.FieldDescriptorProto_TYPE_BYTES, true),
+				},
+				NestedType: []*descriptorpb.DescriptorProto{tensor("InferOutputTensor")},
+			},
+		},
+	}
+}
+
+func scalarFieldDescriptor(name string, number int32, fieldType descriptorpb.FieldDescriptorProto_Type, repeated bool) *descriptorpb.FieldDescriptorProto {
+	label := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
+	if repeated {
+		label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+	}
+	return &descriptorpb.FieldDescriptorProto{
+		Name:   proto.String(name),
+		Number: proto.Int32(number),
+		Label:  label.Enum(),
+		Type:   fieldType.Enum(),
+	}
+}
+
+func messageFieldDescriptor(name string, number int32, typeName string, repeated bool) *descriptorpb.FieldDescriptorProto {
+	field := scalarFieldDescriptor(name, number, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, repeated)
+	field.TypeName = proto.String(typeName)
+	return field
+}
+
+// mapEntryDescriptor describes the entry of map<string, value>
+func mapEntryDescriptor(name string, value *descriptorpb.FieldDescriptorProto) *descriptorpb.DescriptorProto {
+	return &descriptorpb.DescriptorProto{
+		Name: proto.String(name),
+		Field: []*descriptorpb.FieldDescriptorProto{
+			scalarFieldDescriptor("key", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, false),
+			value,
+		},
+		Options: &descriptorpb.MessageOptions{MapEntry: proto.Bool(true)},
+	}
+}
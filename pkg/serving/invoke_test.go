@@ -0,0 +1,91 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+func TestInvokePath(t *testing.T) {
+	cases := []struct {
+		servingType types.ServingJobType
+		protocol    string
+		data        string
+		expected    string
+	}{
+		{types.TFServingJob, "", `{"instances":[[1.0]]}`, "/v1/models/mnist:predict"},
+		{types.TritonServingJob, "", `{"inputs":[]}`, "/v2/models/mnist/infer"},
+		{types.KServeJob, "v1", `{"instances":[]}`, "/v1/models/mnist:predict"},
+		{types.KServeJob, "v2", `{"inputs":[]}`, "/v2/models/mnist/infer"},
+		{types.SeldonServingJob, "", `{"data":{}}`, "/api/v1.0/predictions"},
+		{types.VLLMServingJob, "", `{"messages":[{"role":"user","content":"hi"}]}`, "/v1/chat/completions"},
+		{types.SGLangServingJob, "", `{"prompt":"hi"}`, "/v1/completions"},
+		{types.CustomServingJob, "", "", "/"},
+	}
+	for _, c := range cases {
+		if path := invokePath(c.servingType, "mnist", c.protocol, []byte(c.data)); path != c.expected {
+			t.Errorf("%v: expected path %v, got %v", c.servingType, c.expected, path)
+		}
+	}
+}
+
+func TestGRPCPredictAPIs(t *testing.T) {
+	apis, err := grpcPredictAPIs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		servingType types.ServingJobType
+		request     string
+		expected    string
+	}{
+		{
+			types.TFServingJob,
+			`{"inputs":{"x":{"dtype":"DT_FLOAT","tensorShape":{"dim":[{"size":"1"},{"size":"3"}]},"floatVal":[1,2,5]}}}`,
+			`"modelSpec":{"name":"mnist"}`,
+		},
+		{
+			types.TFServingJob,
+			`{"model_spec":{"name":"half","signature_name":"serving_default"},"inputs":{"x":{"dtype":"DT_FLOAT","float_val":[1]}}}`,
+			`"name":"half"`,
+		},
+		{
+			types.TritonServingJob,
+			`{"inputs":[{"name":"INPUT0","datatype":"FP32","shape":["1","3"],"contents":{"fp32_contents":[1,2,5]}}]}`,
+			`"modelName":"mnist"`,
+		},
+	}
+	for _, c := range cases {
+		api := apis[c.servingType]
+		request := dynamicpb.NewMessage(api.request)
+		if err := protojson.Unmarshal([]byte(c.request), request); err != nil {
+			t.Fatalf("%v: failed to parse the request: %v", c.servingType, err)
+		}
+		setDefaultModelName(request, api.modelNamePath, "mnist")
+		body, err := protojson.Marshal(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(strings.ReplaceAll(string(body), " ", ""), c.expected) {
+			t.Errorf("%v: expected %v in the request, got %v", c.servingType, c.expected, string(body))
+		}
+	}
+}
@@ -81,6 +81,7 @@ func DisplayAllServingJobs(jobs []ServingJob, allNamespace bool, format types.Fo
 	for _, job := range jobs {
 		jobInfos = append(jobInfos, job.Convert2JobInfo())
 	}
+	setServingStages(jobs, jobInfos)
 	switch format {
 	case types.JsonFormat:
 		data, _ := json.MarshalIndent(jobInfos, "", "    ")
@@ -102,8 +103,7 @@ func DisplayAllServingJobs(jobs []ServingJob, allNamespace bool, format types.Fo
 			gpus += instance.RequestGPUs
 		}
 	}
-	fields := []string{"NAME", "TYPE", "VERSION", "DESIRED", "AVAILABLE", "ADDRESS", "PORTS"}
-	header = append(header, fields...)
+	header = append(header, servingJobListFields...)
 	if gpus != float64(0) {
 		header = append(header, "GPU")
 	}
@@ -119,44 +119,53 @@ func DisplayAllServingJobs(jobs []ServingJob, allNamespace bool, format types.Fo
 		if allNamespace {
 			line = append(line, jobInfo.Namespace)
 		}
-		endpointAddress := jobInfo.IPAddress
-		ports := []string{}
-		for _, e := range jobInfo.Endpoints {
-			port := ""
-			if e.NodePort != 0 {
-				port = fmt.Sprintf("%v:%v->%v", strings.ToUpper(e.Name), e.NodePort, e.Port)
-			} else {
-				port = fmt.Sprintf("%v:%v", strings.ToUpper(e.Name), e.Port)
-			}
-			ports = append(ports, port)
-		}
-		if len(ports) == 0 {
-			ports = append(ports, "N/A")
-		}
-
-		items := []string{
-			jobInfo.Name,
-			fmt.Sprintf("%v", jobInfo.Type),
-			jobInfo.Version,
-			fmt.Sprintf("%v", jobInfo.Desired),
-			fmt.Sprintf("%v", jobInfo.Available),
-			endpointAddress,
-			strings.Join(ports, ","),
-		}
+		line = append(line, servingJobListRow(jobInfo)...)
 		if gpus != float64(0) {
 			jobGPUs := float64(0)
 			for _, instance := range jobInfo.Instances {
 				jobGPUs += instance.RequestGPUs
 			}
-			items = append(items, fmt.Sprintf("%v", jobGPUs))
+			line = append(line, fmt.Sprintf("%v", jobGPUs))
 
 		}
-		line = append(line, items...)
 		PrintLine(w, line...)
 	}
 	_ = w.Flush()
 }
 
+// servingJobListFields are the common columns of the serving job table
+var servingJobListFields = []string{"NAME", "TYPE", "VERSION", "DESIRED", "AVAILABLE", "ADDRESS", "PORTS"}
+
+// servingJobListRow returns the common columns of the serving job
+func servingJobListRow(jobInfo types.ServingJobInfo) []string {
+	ports := []string{}
+	for _, e := range jobInfo.Endpoints {
+		port := ""
+		if e.NodePort != 0 {
+			port = fmt.Sprintf("%v:%v->%v", strings.ToUpper(e.Name), e.NodePort, e.Port)
+		} else {
+			port = fmt.Sprintf("%v:%v", strings.ToUpper(e.Name), e.Port)
+		}
+		ports = append(ports, port)
+	}
+	if len(ports) == 0 {
+		ports = append(ports, "N/A")
+	}
+	version := jobInfo.Version
+	if jobInfo.Stage != "" {
+		version = fmt.Sprintf("%v(%v)", version, jobInfo.Stage)
+	}
+	return []string{
+		jobInfo.Name,
+		fmt.Sprintf("%v", jobInfo.Type),
+		version,
+		fmt.Sprintf("%v", jobInfo.Desired),
+		fmt.Sprintf("%v", jobInfo.Available),
+		jobInfo.IPAddress,
+		strings.Join(ports, ","),
+	}
+}
+
 type ServingJobGroup struct {
 	Id        string
 	Namespace string
@@ -0,0 +1,335 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+const (
+	// DefaultLoadTestImage is the image of load generator, it runs fortio and is pinned so the
+	// output parsed by arena does not change with a new release
+	DefaultLoadTestImage = "fortio/fortio:1.75.3"
+	// loadTestStartupTimeout is the time reserved for pulling the image and starting the load generator
+	loadTestStartupTimeout = 5 * time.Minute
+	// loadTestTTLSeconds is the ttl of finished load generator jobs in case they are not deleted by arena
+	loadTestTTLSeconds = 600
+)
+
+// loadTestPollInterval is the interval of checking the status of load generator job
+var loadTestPollInterval = 5 * time.Second
+
+// fortioResult is the part of fortio json report used by arena
+type fortioResult struct {
+	ActualQPS         float64
+	ActualDuration    time.Duration
+	DurationHistogram struct {
+		Count       int64
+		Percentiles []struct {
+			Percentile float64
+			Value      float64
+		}
+	}
+	RetCodes map[string]int64
+}
+
+// RunServingLoadTest runs a load generator job in the cluster against each version of serving job
+// with the same load and returns the reports in the order of versions
+func RunServingLoadTest(ctx context.Context, namespace string, args *types.ServingLoadTestArgs) ([]*types.ServingLoadTestResult, error) {
+	if args.Duration <= 0 {
+		return nil, fmt.Errorf("the duration of load test must be greater than 0")
+	}
+	if args.Concurrency <= 0 {
+		return nil, fmt.Errorf("the concurrency of load test must be greater than 0")
+	}
+	if args.QPS < 0 {
+		return nil, fmt.Errorf("the qps of load test must not be negative")
+	}
+	if args.Image == "" {
+		args.Image = DefaultLoadTestImage
+	}
+	versions := args.Versions
+	if len(versions) == 0 {
+		versions = []string{""}
+	}
+	jobs := []ServingJob{}
+	for _, version := range versions {
+		job, err := SearchServingJob(namespace, args.Name, version, args.Type)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+	results := []*types.ServingLoadTestResult{}
+	for _, job := range jobs {
+		result, err := runServingVersionLoadTest(ctx, job, args)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func runServingVersionLoadTest(ctx context.Context, job ServingJob, args *types.ServingLoadTestArgs) (*types.ServingLoadTestResult, error) {
+	path := args.Path
+	if path == "" {
+		modelName := args.ModelName
+		if modelName == "" {
+			modelName = job.Name()
+		}
+		path = invokePath(job.Type(), modelName, invokeProtocol(job, ""), args.Data)
+	}
+	baseURL, err := loadTestBaseURL(job)
+	if err != nil {
+		return nil, err
+	}
+	result := &types.ServingLoadTestResult{
+		Name:        job.Name(),
+		Version:     job.Version(),
+		URL:         strings.TrimSuffix(baseURL, "/") + "/" + strings.TrimPrefix(path, "/"),
+		QPS:         args.QPS,
+		Concurrency: args.Concurrency,
+	}
+	client := config.GetArenaConfiger().GetClientSet()
+	loadTestJob, err := client.BatchV1().Jobs(job.Namespace()).Create(context.TODO(), buildLoadTestJob(job, args, result.URL), metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create load generator job: %v", err)
+	}
+	defer func() {
+		propagation := metav1.DeletePropagationBackground
+		err := client.BatchV1().Jobs(loadTestJob.Namespace).Delete(context.TODO(), loadTestJob.Name, metav1.DeleteOptions{PropagationPolicy: &propagation})
+		if err != nil {
+			log.Warnf("failed to delete load generator job %v: %v", loadTestJob.Name, err)
+		}
+	}()
+	log.Infof("running load test against %v for %v with job %v", result.URL, args.Duration, loadTestJob.Name)
+	succeeded, err := waitLoadTestJob(ctx, loadTestJob, args.Duration+loadTestStartupTimeout)
+	if err != nil {
+		return nil, err
+	}
+	logs, err := loadTestJobLogs(loadTestJob)
+	if err != nil {
+		return nil, err
+	}
+	if !succeeded {
+		return nil, fmt.Errorf("load generator job %v failed, logs:\n%v", loadTestJob.Name, string(logs))
+	}
+	if err := parseLoadTestResult(logs, result); err != nil {
+		return nil, fmt.Errorf("failed to parse the report of load generator job %v: %v", loadTestJob.Name, err)
+	}
+	return result, nil
+}
+
+// loadTestBaseURL returns the in cluster url of the serving version
+func loadTestBaseURL(job ServingJob) (string, error) {
+	if ksjob, ok := job.(*kserveJob); ok {
+		address := ksjob.inferenceService.Status.Address
+		if address != nil && address.URL != nil {
+			return address.URL.String(), nil
+		}
+		if url := ksjob.IPAddress(); url != "" {
+			return url, nil
+		}
+		return "", fmt.Errorf("the url of kserve job %v is not ready", job.Name())
+	}
+	for _, svc := range job.Services() {
+		for _, p := range svc.Spec.Ports {
+			if p.Name == restfulServingPortName {
+				return fmt.Sprintf("http://%v.%v:%v", svc.Name, svc.Namespace, p.Port), nil
+			}
+		}
+	}
+	return "", fmt.Errorf("not found the restful service of serving job %v with version %v", job.Name(), job.Version())
+}
+
+func buildLoadTestJob(job ServingJob, args *types.ServingLoadTestArgs, url string) *batchv1.Job {
+	command := []string{
+		"load", "-quiet", "-json", "-",
+		"-qps", strconv.Itoa(args.QPS),
+		"-c", strconv.Itoa(args.Concurrency),
+		"-t", args.Duration.String(),
+		"-p", "50,90,99",
+	}
+	if len(args.Data) != 0 {
+		command = append(command, "-payload", string(args.Data), "-content-type", "application/json")
+	}
+	command = append(command, url)
+	labels := map[string]string{
+		"app":                  "arena-serving-loadtest",
+		"createdBy":            "arena",
+		servingNameLabelKey:    job.Name(),
+		servingVersionLabelKey: job.Version(),
+	}
+	imagePullSecrets := []corev1.LocalObjectReference{}
+	for _, secret := range args.ImagePullSecrets {
+		imagePullSecrets = append(imagePullSecrets, corev1.LocalObjectReference{Name: secret})
+	}
+	backoffLimit := int32(0)
+	ttlSeconds := int32(loadTestTTLSeconds)
+	activeDeadlineSeconds := int64((args.Duration + loadTestStartupTimeout).Seconds())
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: fmt.Sprintf("%v-%v-loadtest-", job.Name(), job.Version()),
+			Namespace:    job.Namespace(),
+			Labels:       labels,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:            &backoffLimit,
+			TTLSecondsAfterFinished: &ttlSeconds,
+			ActiveDeadlineSeconds:   &activeDeadlineSeconds,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+					Annotations: map[string]string{
+						"sidecar.istio.io/inject": "false",
+					},
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy:    corev1.RestartPolicyNever,
+					ImagePullSecrets: imagePullSecrets,
+					Containers: []corev1.Container{
+						{
+							Name:  "loadtest",
+							Image: args.Image,
+							Args:  command,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// waitLoadTestJob waits until the load generator job is finished and returns whether it is succeeded
+func waitLoadTestJob(ctx context.Context, job *batchv1.Job, timeout time.Duration) (bool, error) {
+	deadline := time.Now().Add(timeout)
+	client := config.GetArenaConfiger().GetClientSet()
+	for {
+		current, err := client.BatchV1().Jobs(job.Namespace).Get(context.TODO(), job.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		if current.Status.Succeeded > 0 {
+			return true, nil
+		}
+		if current.Status.Failed > 0 {
+			return false, nil
+		}
+		if !time.Now().Before(deadline) {
+			return false, fmt.Errorf("load generator job %v is not finished in %v", job.Name, timeout)
+		}
+		select {
+		case <-ctx.Done():
+			return false, ctx.Err()
+		case <-time.After(loadTestPollInterval):
+		}
+	}
+}
+
+func loadTestJobLogs(job *batchv1.Job) ([]byte, error) {
+	client := config.GetArenaConfiger().GetClientSet()
+	pods, err := client.CoreV1().Pods(job.Namespace).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("job-name=%v", job.Name),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(pods.Items) == 0 {
+		return nil, fmt.Errorf("not found the pod of load generator job %v", job.Name)
+	}
+	return client.CoreV1().Pods(job.Namespace).GetLogs(pods.Items[0].Name, &corev1.PodLogOptions{}).DoRaw(context.TODO())
+}
+
+// parseLoadTestResult parses the fortio json report which starts with a line of "{",
+// the log lines before it are skipped
+func parseLoadTestResult(logs []byte, result *types.ServingLoadTestResult) error {
+	content := string(logs)
+	start := strings.LastIndex("\n"+content, "\n{\n")
+	if start < 0 {
+		return fmt.Errorf("not found json report in logs")
+	}
+	report := &fortioResult{}
+	if err := json.NewDecoder(strings.NewReader(content[start:])).Decode(report); err != nil {
+		return err
+	}
+	var succeeded int64
+	for code, count := range report.RetCodes {
+		if c, err := strconv.Atoi(code); err == nil && c >= 200 && c < 300 {
+			succeeded += count
+		}
+	}
+	result.Duration = report.ActualDuration
+	result.Requests = report.DurationHistogram.Count
+	result.Errors = result.Requests - succeeded
+	if result.Requests > 0 {
+		result.ErrorRate = float64(result.Errors) / float64(result.Requests)
+	}
+	result.Throughput = report.ActualQPS
+	for _, p := range report.DurationHistogram.Percentiles {
+		latency := p.Value * 1000
+		switch p.Percentile {
+		case 50:
+			result.P50Latency = latency
+		case 90:
+			result.P90Latency = latency
+		case 99:
+			result.P99Latency = latency
+		}
+	}
+	return nil
+}
+
+// PrintServingLoadTestResults prints the reports of load test, one row per version
+func PrintServingLoadTestResults(results []*types.ServingLoadTestResult, format types.FormatStyle) {
+	switch format {
+	case types.JsonFormat:
+		data, _ := json.MarshalIndent(results, "", "    ")
+		fmt.Printf("%v\n", string(data))
+		return
+	case types.YamlFormat:
+		data, _ := yaml.Marshal(results)
+		fmt.Printf("%v", string(data))
+		return
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "NAME\tVERSION\tQPS\tCONCURRENCY\tDURATION\tREQUESTS\tTHROUGHPUT\tP50\tP90\tP99\tERROR_RATE\n")
+	for _, r := range results {
+		qps := strconv.Itoa(r.QPS)
+		if r.QPS == 0 {
+			qps = "max"
+		}
+		fmt.Fprintf(w, "%v\t%v\t%v\t%v\t%v\t%v\t%.2f/s\t%.2fms\t%.2fms\t%.2fms\t%.2f%%\n",
+			r.Name, r.Version, qps, r.Concurrency, r.Duration.Round(time.Second), r.Requests,
+			r.Throughput, r.P50Latency, r.P90Latency, r.P99Latency, r.ErrorRate*100)
+	}
+	_ = w.Flush()
+}
@@ -0,0 +1,57 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+func TestParseLoadTestResult(t *testing.T) {
+	logs := `{"ts":1700000000.1,"level":"warn","msg":"Non ok http code","code":503}
+{
+  "RunType": "HTTP",
+  "ActualQPS": 49.5,
+  "ActualDuration": 60000000000,
+  "DurationHistogram": {
+    "Count": 200,
+    "Percentiles": [
+      {"Percentile": 50, "Value": 0.012},
+      {"Percentile": 90, "Value": 0.025},
+      {"Percentile": 99, "Value": 0.1}
+    ]
+  },
+  "RetCodes": {"200": 190, "503": 8, "-1": 2}
+}
+`
+	result := &types.ServingLoadTestResult{}
+	if err := parseLoadTestResult([]byte(logs), result); err != nil {
+		t.Fatalf("failed to parse report: %v", err)
+	}
+	if result.Requests != 200 || result.Errors != 10 || result.ErrorRate != 0.05 {
+		t.Errorf("expected 200 requests and 10 errors, got %v requests and %v errors with rate %v", result.Requests, result.Errors, result.ErrorRate)
+	}
+	if result.Duration != time.Minute || result.Throughput != 49.5 {
+		t.Errorf("expected 1m and 49.5/s, got %v and %v/s", result.Duration, result.Throughput)
+	}
+	if result.P50Latency != 12 || result.P90Latency != 25 || result.P99Latency != 100 {
+		t.Errorf("expected latencies 12/25/100ms, got %v/%v/%v", result.P50Latency, result.P90Latency, result.P99Latency)
+	}
+	if err := parseLoadTestResult([]byte("Aborting because of error\n"), result); err == nil {
+		t.Errorf("expected an error for logs without report")
+	}
+}
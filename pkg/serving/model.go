@@ -0,0 +1,128 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"text/tabwriter"
+
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+// servingModelNamePattern matches a single path segment, the model name is a directory of the triton model repository
+var servingModelNamePattern = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+// AddServingModel registers a model into a triton serving job or a ServingRuntime of KServe ModelMesh
+func AddServingModel(namespace string, args *types.ServingModelArgs) error {
+	if err := validateServingModelName(args.Name); err != nil {
+		return err
+	}
+	serverType, err := modelServerType(namespace, args.Server, args.ServerVersion, args.ServerType)
+	if err != nil {
+		return err
+	}
+	if serverType == types.TritonServingJob {
+		err = addTritonModel(namespace, args)
+	} else {
+		err = addModelMeshModel(namespace, args)
+	}
+	if err != nil {
+		return err
+	}
+	log.Infof("The model %v has been added to server %v", args.Name, args.Server)
+	return nil
+}
+
+// RemoveServingModel unregisters a model from the model server
+func RemoveServingModel(namespace string, args *types.ServingModelArgs) error {
+	if err := validateServingModelName(args.Name); err != nil {
+		return err
+	}
+	serverType, err := modelServerType(namespace, args.Server, args.ServerVersion, args.ServerType)
+	if err != nil {
+		return err
+	}
+	if serverType == types.TritonServingJob {
+		err = removeTritonModel(namespace, args)
+	} else {
+		err = removeModelMeshModel(namespace, args)
+	}
+	if err != nil {
+		return err
+	}
+	log.Infof("The model %v has been removed from server %v", args.Name, args.Server)
+	return nil
+}
+
+// validateServingModelName returns an error if the model name is not a single path segment
+func validateServingModelName(name string) error {
+	if name == "." || name == ".." || !servingModelNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid model name %q, it must consist of alphanumeric characters, '_', '.' or '-', and can not be '.' or '..'", name)
+	}
+	return nil
+}
+
+// ListServingModels returns the models of the model server and their states reported by the server
+func ListServingModels(namespace string, args *types.ServingModelArgs) ([]types.ServingModelInfo, error) {
+	serverType, err := modelServerType(namespace, args.Server, args.ServerVersion, args.ServerType)
+	if err != nil {
+		return nil, err
+	}
+	if serverType == types.TritonServingJob {
+		return listTritonModels(namespace, args)
+	}
+	return listModelMeshModels(namespace, args)
+}
+
+// modelServerType returns the type of model server, a triton serving job is searched first
+// if the type is not set, otherwise the server is regarded as a ServingRuntime of ModelMesh
+func modelServerType(namespace, server, version string, serverType types.ServingJobType) (types.ServingJobType, error) {
+	switch serverType {
+	case types.TritonServingJob, types.KServeJob:
+		return serverType, nil
+	case types.AllServingJob:
+		if _, err := SearchServingJob(namespace, server, version, types.TritonServingJob); err == nil {
+			return types.TritonServingJob, nil
+		}
+		return types.KServeJob, nil
+	}
+	return "", fmt.Errorf("the model server must be %v or %v, but got %v", types.TritonServingJob, types.KServeJob, serverType)
+}
+
+// PrintServingModels prints the models of model server
+func PrintServingModels(models []types.ServingModelInfo, format types.FormatStyle) {
+	switch format {
+	case types.JsonFormat:
+		data, _ := json.MarshalIndent(models, "", "    ")
+		fmt.Printf("%v\n", string(data))
+		return
+	case types.YamlFormat:
+		data, _ := yaml.Marshal(models)
+		fmt.Printf("%v", string(data))
+		return
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "NAME\tVERSION\tSERVER\tSTATE\tREASON\n")
+	for _, m := range models {
+		fmt.Fprintf(w, "%v\t%v\t%v\t%v\t%v\n", m.Name, defaultIfEmpty(m.Version), m.Server, m.State, m.Reason)
+	}
+	_ = w.Flush()
+}
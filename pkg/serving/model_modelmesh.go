@@ -0,0 +1,122 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"context"
+	"fmt"
+
+	kservev1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	kserveClient "github.com/kserve/kserve/pkg/client/clientset/versioned"
+	"github.com/kserve/kserve/pkg/constants"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+// addModelMeshModel creates an InferenceService in ModelMesh mode which is served by the ServingRuntime
+func addModelMeshModel(namespace string, args *types.ServingModelArgs) error {
+	if args.ModelPath == "" || args.ModelFormat == "" {
+		return fmt.Errorf("--model-path and --model-format must be set for ModelMesh model")
+	}
+	runtime := args.Server
+	storageURI := args.ModelPath
+	inferenceService := &kservev1beta1.InferenceService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      args.Name,
+			Namespace: namespace,
+			Labels: map[string]string{
+				"createdBy": "arena",
+			},
+			Annotations: map[string]string{
+				constants.DeploymentMode: string(constants.ModelMeshDeployment),
+			},
+		},
+		Spec: kservev1beta1.InferenceServiceSpec{
+			Predictor: kservev1beta1.PredictorSpec{
+				Model: &kservev1beta1.ModelSpec{
+					ModelFormat: kservev1beta1.ModelFormat{Name: args.ModelFormat},
+					Runtime:     &runtime,
+					PredictorExtensionSpec: kservev1beta1.PredictorExtensionSpec{
+						StorageURI: &storageURI,
+					},
+				},
+			},
+		},
+	}
+	client := kserveClient.NewForConfigOrDie(config.GetArenaConfiger().GetRestConfig())
+	_, err := client.ServingV1beta1().InferenceServices(namespace).Create(context.TODO(), inferenceService, metav1.CreateOptions{})
+	if k8serrors.IsAlreadyExists(err) {
+		return fmt.Errorf("the model %v already exists in namespace %v", args.Name, namespace)
+	}
+	return err
+}
+
+// removeModelMeshModel deletes the InferenceService of the model served by the ServingRuntime
+func removeModelMeshModel(namespace string, args *types.ServingModelArgs) error {
+	client := kserveClient.NewForConfigOrDie(config.GetArenaConfiger().GetRestConfig())
+	inferenceService, err := client.ServingV1beta1().InferenceServices(namespace).Get(context.TODO(), args.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if !isModelMeshModelOf(inferenceService, args.Server) {
+		return fmt.Errorf("the model %v is not served by ModelMesh runtime %v", args.Name, args.Server)
+	}
+	return client.ServingV1beta1().InferenceServices(namespace).Delete(context.TODO(), args.Name, metav1.DeleteOptions{})
+}
+
+// listModelMeshModels returns the models served by the ServingRuntime and their states reported by ModelMesh
+func listModelMeshModels(namespace string, args *types.ServingModelArgs) ([]types.ServingModelInfo, error) {
+	client := kserveClient.NewForConfigOrDie(config.GetArenaConfiger().GetRestConfig())
+	inferenceServices, err := client.ServingV1beta1().InferenceServices(namespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	models := []types.ServingModelInfo{}
+	for i := range inferenceServices.Items {
+		inferenceService := &inferenceServices.Items[i]
+		if !isModelMeshModelOf(inferenceService, args.Server) {
+			continue
+		}
+		models = append(models, modelMeshModelInfo(inferenceService, args.Server))
+	}
+	return models, nil
+}
+
+func isModelMeshModelOf(inferenceService *kservev1beta1.InferenceService, runtime string) bool {
+	if inferenceService.Annotations[constants.DeploymentMode] != string(constants.ModelMeshDeployment) {
+		return false
+	}
+	model := inferenceService.Spec.Predictor.Model
+	return model != nil && model.Runtime != nil && *model.Runtime == runtime
+}
+
+func modelMeshModelInfo(inferenceService *kservev1beta1.InferenceService, server string) types.ServingModelInfo {
+	info := types.ServingModelInfo{
+		Name:   inferenceService.Name,
+		Server: server,
+		State:  "Pending",
+	}
+	status := inferenceService.Status.ModelStatus
+	if status.ModelRevisionStates != nil && status.ModelRevisionStates.ActiveModelState != "" {
+		info.State = string(status.ModelRevisionStates.ActiveModelState)
+	}
+	if status.LastFailureInfo != nil {
+		info.Reason = status.LastFailureInfo.Message
+	}
+	return info
+}
@@ -0,0 +1,100 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"testing"
+
+	kservev1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+func TestTritonModelLayout(t *testing.T) {
+	args := &types.ServingModelArgs{
+		Name:         "mnist",
+		ModelPath:    "/data/models/mnist.onnx",
+		ModelVersion: 2,
+		Platform:     "onnxruntime_onnx",
+		MaxBatchSize: 8,
+	}
+	expectedConfig := "name: \"mnist\"\nplatform: \"onnxruntime_onnx\"\nmax_batch_size: 8\n"
+	if config := tritonModelConfig(args); config != expectedConfig {
+		t.Errorf("expected config %q, got %q", expectedConfig, config)
+	}
+	expectedCommand := "set -e; mkdir -p '/models/mnist/2'; rm -rf '/models/mnist/2/model.onnx'; " +
+		"cp -r '/data/models/mnist.onnx' '/models/mnist/2/model.onnx'; cat > '/models/mnist/config.pbtxt'"
+	if command := tritonModelLayoutCommand("/models", args); command != expectedCommand {
+		t.Errorf("expected command %q, got %q", expectedCommand, command)
+	}
+	if quoted := shellQuote("it's"); quoted != `'it'\''s'` {
+		t.Errorf("unexpected quoted value %v", quoted)
+	}
+}
+
+func TestValidateServingModelName(t *testing.T) {
+	for _, name := range []string{"mnist", "resnet-50", "bert_base.v2"} {
+		if err := validateServingModelName(name); err != nil {
+			t.Errorf("expected %q to be valid, got %v", name, err)
+		}
+	}
+	for _, name := range []string{"", ".", "..", "../models", "a/b", "mnist;rm"} {
+		if err := validateServingModelName(name); err == nil {
+			t.Errorf("expected %q to be invalid", name)
+		}
+	}
+}
+
+func TestTritonRepositoryPVC(t *testing.T) {
+	spec := &corev1.PodSpec{
+		Volumes: []corev1.Volume{
+			{Name: "models", VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "model-pvc"}}},
+			{Name: "cache", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+		},
+		Containers: []corev1.Container{{
+			VolumeMounts: []corev1.VolumeMount{
+				{Name: "models", MountPath: "/models"},
+				{Name: "cache", MountPath: "/models/cache"},
+			},
+		}},
+	}
+	testcases := map[string]string{
+		"/models/repository": "model-pvc",
+		"/models":            "model-pvc",
+		"/models/cache/repo": "",
+		"/models-local":      "",
+		"/repository":        "",
+	}
+	for repository, expected := range testcases {
+		if claim := tritonRepositoryPVC(spec, repository); claim != expected {
+			t.Errorf("%v: expected pvc %q, got %q", repository, expected, claim)
+		}
+	}
+}
+
+func TestModelMeshModelInfo(t *testing.T) {
+	inferenceService := &kservev1beta1.InferenceService{}
+	inferenceService.Name = "iris"
+	if info := modelMeshModelInfo(inferenceService, "mlserver-1.x"); info.State != "Pending" {
+		t.Errorf("expected Pending state without model status, got %v", info.State)
+	}
+	inferenceService.Status.ModelStatus.ModelRevisionStates = &kservev1beta1.ModelRevisionStates{ActiveModelState: kservev1beta1.FailedToLoad}
+	inferenceService.Status.ModelStatus.LastFailureInfo = &kservev1beta1.FailureInfo{Message: "unsupported format"}
+	info := modelMeshModelInfo(inferenceService, "mlserver-1.x")
+	if info.State != "FailedToLoad" || info.Reason != "unsupported format" {
+		t.Errorf("unexpected state %v and reason %v", info.State, info.Reason)
+	}
+}
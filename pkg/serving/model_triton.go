@@ -0,0 +1,267 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/util/kubeclient"
+)
+
+// tritonRepositoryTimeout is the timeout of triton model repository api, loading a model may be slow
+var tritonRepositoryTimeout = 5 * time.Minute
+
+// tritonModelFiles are the default model file names of triton platforms and backends
+var tritonModelFiles = map[string]string{
+	"onnxruntime_onnx":      "model.onnx",
+	"tensorrt_plan":         "model.plan",
+	"pytorch_libtorch":      "model.pt",
+	"tensorflow_savedmodel": "model.savedmodel",
+	"tensorflow_graphdef":   "model.graphdef",
+	"python":                "model.py",
+}
+
+// tritonModelState is an item of the triton repository index api
+type tritonModelState struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	State   string `json:"state"`
+	Reason  string `json:"reason"`
+}
+
+// addTritonModel generates the layout of model in the shared model repository and loads it in each instance
+func addTritonModel(namespace string, args *types.ServingModelArgs) error {
+	if args.ModelPath == "" {
+		return fmt.Errorf("--model-path must be set for triton model")
+	}
+	if _, ok := tritonModelFiles[args.Platform]; !ok && len(args.Config) == 0 {
+		return fmt.Errorf("unknown triton platform %v, please set --config-file", args.Platform)
+	}
+	job, pods, repository, err := getTritonServer(namespace, args)
+	if err != nil {
+		return err
+	}
+	if err := checkTritonSharedRepository(job, repository); err != nil {
+		return err
+	}
+	modelConfig := args.Config
+	if len(modelConfig) == 0 {
+		modelConfig = []byte(tritonModelConfig(args))
+	}
+	// the model repository is shared by all the instances, so the layout is generated only once
+	command := tritonModelLayoutCommand(repository, args)
+	if _, err := kubeclient.ExecInPod(pods[0], pods[0].Spec.Containers[0].Name, []string{"sh", "-c", command}, bytes.NewReader(modelConfig)); err != nil {
+		return fmt.Errorf("failed to generate the layout of model %v in instance %v: %v", args.Name, pods[0].Name, err)
+	}
+	for _, pod := range pods {
+		if err := callTritonRepositoryAPI(job, pod, fmt.Sprintf("/v2/repository/models/%v/load", args.Name), nil); err != nil {
+			if strings.Contains(err.Error(), "not allowed") {
+				log.Infof("triton server %v does not allow to load models explicitly, the model will be loaded by its model control mode", args.Server)
+				continue
+			}
+			return fmt.Errorf("failed to load model %v in instance %v: %v", args.Name, pod.Name, err)
+		}
+	}
+	return nil
+}
+
+// removeTritonModel unloads the model in each instance and deletes it from the shared model repository
+func removeTritonModel(namespace string, args *types.ServingModelArgs) error {
+	job, pods, repository, err := getTritonServer(namespace, args)
+	if err != nil {
+		return err
+	}
+	if err := checkTritonSharedRepository(job, repository); err != nil {
+		return err
+	}
+	for _, pod := range pods {
+		if err := callTritonRepositoryAPI(job, pod, fmt.Sprintf("/v2/repository/models/%v/unload", args.Name), nil); err != nil && !strings.Contains(err.Error(), "not allowed") {
+			return fmt.Errorf("failed to unload model %v in instance %v: %v", args.Name, pod.Name, err)
+		}
+	}
+	command := fmt.Sprintf("rm -rf %v", shellQuote(path.Join(repository, args.Name)))
+	if _, err := kubeclient.ExecInPod(pods[0], pods[0].Spec.Containers[0].Name, []string{"sh", "-c", command}, nil); err != nil {
+		return fmt.Errorf("failed to delete model %v from instance %v: %v", args.Name, pods[0].Name, err)
+	}
+	return nil
+}
+
+// listTritonModels returns the models in the repository index of the first ready instance
+func listTritonModels(namespace string, args *types.ServingModelArgs) ([]types.ServingModelInfo, error) {
+	job, pods, _, err := getTritonServer(namespace, args)
+	if err != nil {
+		return nil, err
+	}
+	states := []tritonModelState{}
+	if err := callTritonRepositoryAPI(job, pods[0], "/v2/repository/index", &states); err != nil {
+		return nil, err
+	}
+	models := []types.ServingModelInfo{}
+	for _, s := range states {
+		models = append(models, types.ServingModelInfo{
+			Name:    s.Name,
+			Version: s.Version,
+			Server:  args.Server,
+			State:   defaultIfEmpty(s.State),
+			Reason:  s.Reason,
+		})
+	}
+	return models, nil
+}
+
+// getTritonServer returns the triton serving job, its ready instances and its model repository
+func getTritonServer(namespace string, args *types.ServingModelArgs) (ServingJob, []*corev1.Pod, string, error) {
+	job, err := SearchServingJob(namespace, args.Server, args.ServerVersion, types.TritonServingJob)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	pods := []*corev1.Pod{}
+	for _, pod := range job.Pods() {
+		if isPodReady(pod) {
+			pods = append(pods, pod)
+		}
+	}
+	if len(pods) == 0 {
+		return nil, nil, "", fmt.Errorf("not found ready instance of triton server %v", args.Server)
+	}
+	repository := ""
+	if deploy := job.Deployment(); deploy != nil && len(deploy.Spec.Template.Spec.Containers) != 0 {
+		repository = modelPathFromContainer(&deploy.Spec.Template.Spec.Containers[0])
+	}
+	if repository == "" {
+		return nil, nil, "", fmt.Errorf("not found --model-repository of triton server %v", args.Server)
+	}
+	return job, pods, repository, nil
+}
+
+// checkTritonSharedRepository returns an error if the model repository is not on a pvc, the layout written into
+// the filesystem of an instance is lost when the instance restarts and is not seen by the new instances
+func checkTritonSharedRepository(job ServingJob, repository string) error {
+	deploy := job.Deployment()
+	if deploy == nil || tritonRepositoryPVC(&deploy.Spec.Template.Spec, repository) == "" {
+		return fmt.Errorf("the model repository %v of triton server %v is not on a pvc, please mount a pvc by --data and put the model repository on it", repository, job.Name())
+	}
+	return nil
+}
+
+// tritonRepositoryPVC returns the pvc which the model repository is on, the volume with the longest mount path
+// containing the repository backs it
+func tritonRepositoryPVC(spec *corev1.PodSpec, repository string) string {
+	if len(spec.Containers) == 0 {
+		return ""
+	}
+	claims := map[string]string{}
+	for _, volume := range spec.Volumes {
+		if volume.PersistentVolumeClaim != nil {
+			claims[volume.Name] = volume.PersistentVolumeClaim.ClaimName
+		}
+	}
+	repository = path.Clean(repository)
+	mountPath, claim := "", ""
+	for _, mount := range spec.Containers[0].VolumeMounts {
+		p := path.Clean(mount.MountPath)
+		if repository != p && !strings.HasPrefix(repository, strings.TrimSuffix(p, "/")+"/") {
+			continue
+		}
+		if len(p) > len(mountPath) {
+			mountPath, claim = p, claims[mount.Name]
+		}
+	}
+	return claim
+}
+
+// tritonModelConfig generates the config.pbtxt of model, the inputs and outputs are completed by triton
+func tritonModelConfig(args *types.ServingModelArgs) string {
+	lines := []string{fmt.Sprintf("name: %q", args.Name)}
+	if args.Platform == "python" {
+		lines = append(lines, `backend: "python"`)
+	} else {
+		lines = append(lines, fmt.Sprintf("platform: %q", args.Platform))
+	}
+	lines = append(lines, fmt.Sprintf("max_batch_size: %v", args.MaxBatchSize))
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// tritonModelLayoutCommand returns the shell command which generates the layout of model:
+// <repository>/<model>/config.pbtxt and <repository>/<model>/<version>/<model file>,
+// the config is read from stdin
+func tritonModelLayoutCommand(repository string, args *types.ServingModelArgs) string {
+	modelDir := path.Join(repository, args.Name)
+	versionDir := path.Join(modelDir, fmt.Sprintf("%v", args.ModelVersion))
+	modelFile, ok := tritonModelFiles[args.Platform]
+	if !ok {
+		modelFile = path.Base(args.ModelPath)
+	}
+	target := path.Join(versionDir, modelFile)
+	return strings.Join([]string{
+		"set -e",
+		fmt.Sprintf("mkdir -p %v", shellQuote(versionDir)),
+		fmt.Sprintf("rm -rf %v", shellQuote(target)),
+		fmt.Sprintf("cp -r %v %v", shellQuote(args.ModelPath), shellQuote(target)),
+		fmt.Sprintf("cat > %v", shellQuote(path.Join(modelDir, "config.pbtxt"))),
+	}, "; ")
+}
+
+// callTritonRepositoryAPI posts to the model repository api of a triton instance and decodes the response
+func callTritonRepositoryAPI(job ServingJob, pod *corev1.Pod, apiPath string, result interface{}) error {
+	remotePort, err := servingPodPort(job, pod, restfulServingPortName)
+	if err != nil {
+		return err
+	}
+	localPort, stop, err := portForwardPod(pod, remotePort)
+	if err != nil {
+		return err
+	}
+	defer stop()
+	client := &http.Client{Timeout: tritonRepositoryTimeout}
+	response, err := client.Post(fmt.Sprintf("http://127.0.0.1:%v%v", localPort, apiPath), "application/json", strings.NewReader("{}"))
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	if response.StatusCode != http.StatusOK {
+		errorResponse := struct {
+			Error string `json:"error"`
+		}{}
+		if json.Unmarshal(body, &errorResponse) == nil && errorResponse.Error != "" {
+			return fmt.Errorf("%v", errorResponse.Error)
+		}
+		return fmt.Errorf("%v %v", response.StatusCode, string(body))
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(body, result)
+}
+
+// shellQuote quotes the value as a single shell word
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
@@ -0,0 +1,156 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
+	lwsv1 "sigs.k8s.io/lws/api/leaderworkerset/v1"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+// RunServingPromote routes all traffic of serving job to a version and labels it as stable,
+// the other versions are kept, scaled down or deleted after the grace period
+func RunServingPromote(ctx context.Context, namespace string, args *types.ServingPromoteArgs) error {
+	job, err := SearchServingJob(namespace, args.ServingName, args.Version, args.Type)
+	if err != nil {
+		return err
+	}
+	if job.Type() == types.KServeJob {
+		return fmt.Errorf("kserve job has no versions to promote, please use --canary-traffic-percent of 'arena serve update kserve'")
+	}
+	processer, ok := GetAllProcesser()[job.Type()]
+	if !ok {
+		return fmt.Errorf("unknown processer %v,please define it", job.Type())
+	}
+	versions, err := processer.GetServingJobs(namespace, args.ServingName, "")
+	if err != nil {
+		return err
+	}
+	if err := waitServingVersionReady(ctx, namespace, args.ServingName, args.Version, job.Type(), args.ReadinessTimeout); err != nil {
+		return err
+	}
+	router, err := GetTrafficRouter(args.TrafficRouterOptions)
+	if err != nil {
+		return err
+	}
+	if err := router.SetVersionWeights(namespace, args.ServingName, []types.ServingVersionWeight{{Version: args.Version, Weight: 100}}); err != nil {
+		return err
+	}
+	oldVersions := []ServingJob{}
+	for _, v := range versions {
+		stable := v.Version() == args.Version
+		if err := setServingStableLabel(v, stable); err != nil {
+			return fmt.Errorf("failed to label version %v of serving job %v: %v", v.Version(), args.ServingName, err)
+		}
+		if !stable {
+			oldVersions = append(oldVersions, v)
+		}
+	}
+	log.Infof("version %v of serving job %v is promoted to stable and receives all traffic", args.Version, args.ServingName)
+	if args.OldVersions == types.KeepOldServingVersions || args.OldVersions == "" || len(oldVersions) == 0 {
+		return nil
+	}
+	log.Infof("waiting %v before the old versions are handled by the policy %v", args.GracePeriod, args.OldVersions)
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("the old versions are kept because %v", ctx.Err())
+	case <-time.After(args.GracePeriod):
+	}
+	for _, v := range oldVersions {
+		switch args.OldVersions {
+		case types.ScaleDownOldServingVersions:
+			if err := scaleDownServingVersion(v); err != nil {
+				return fmt.Errorf("failed to scale down version %v of serving job %v: %v", v.Version(), args.ServingName, err)
+			}
+			log.Infof("version %v of serving job %v has been scaled down", v.Version(), args.ServingName)
+		case types.DeleteOldServingVersions:
+			if err := DeleteServingJob(namespace, args.ServingName, v.Version(), v.Type()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// setServingStableLabel adds or removes the stable label of a serving version,
+// only the metadata of workload is changed so that the instances are not restarted
+func setServingStableLabel(job ServingJob, stable bool) error {
+	workload, err := servingWorkload(job)
+	if err != nil {
+		return err
+	}
+	meta := workloadObjectMeta(workload)
+	if (meta.Labels[servingStageLabelKey] == string(types.StableServingStage)) == stable {
+		return nil
+	}
+	if stable {
+		if meta.Labels == nil {
+			meta.Labels = map[string]string{}
+		}
+		meta.Labels[servingStageLabelKey] = string(types.StableServingStage)
+	} else {
+		delete(meta.Labels, servingStageLabelKey)
+	}
+	return updateServingWorkload(workload)
+}
+
+// scaleDownServingVersion scales the serving version to 0 instance
+func scaleDownServingVersion(job ServingJob) error {
+	workload, err := servingWorkload(job)
+	if err != nil {
+		return err
+	}
+	replicas := int32(0)
+	switch w := workload.(type) {
+	case *appsv1.Deployment:
+		w.Spec.Replicas = &replicas
+	case *lwsv1.LeaderWorkerSet:
+		w.Spec.Replicas = &replicas
+	default:
+		return fmt.Errorf("serving job %v with type %v can not be scaled down", job.Name(), job.Type())
+	}
+	return updateServingWorkload(workload)
+}
+
+// servingStage returns the stage of a serving version by its labels
+func servingStage(job ServingJob) types.ServingStage {
+	if job.GetLabels()[servingStageLabelKey] == string(types.StableServingStage) {
+		return types.StableServingStage
+	}
+	return ""
+}
+
+// setServingStages marks the stable versions and the other versions of the same serving job as candidates,
+// the job infos are in the same order as the jobs
+func setServingStages(jobs []ServingJob, jobInfos []types.ServingJobInfo) {
+	stableGroups := map[string]bool{}
+	for i, job := range jobs {
+		jobInfos[i].Stage = servingStage(job)
+		if jobInfos[i].Stage == types.StableServingStage {
+			stableGroups[genServingJobGroupKey(jobInfos[i])] = true
+		}
+	}
+	for i := range jobInfos {
+		if jobInfos[i].Stage == "" && stableGroups[genServingJobGroupKey(jobInfos[i])] {
+			jobInfos[i].Stage = types.CandidateServingStage
+		}
+	}
+}
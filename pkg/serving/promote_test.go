@@ -0,0 +1,55 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+func TestSetServingStages(t *testing.T) {
+	newJob := func(name, version string, labels map[string]string) *servingJob {
+		return &servingJob{
+			name:        name,
+			namespace:   "default",
+			servingType: types.TFServingJob,
+			version:     version,
+			deployment:  &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Labels: labels}},
+		}
+	}
+	jobs := []ServingJob{
+		newJob("mnist", "v1", nil),
+		newJob("mnist", "v2", map[string]string{servingStageLabelKey: "stable"}),
+		newJob("bert", "v1", nil),
+	}
+	jobInfos := []types.ServingJobInfo{}
+	for _, job := range jobs {
+		jobInfos = append(jobInfos, types.ServingJobInfo{Name: job.Name(), Namespace: job.Namespace(), Type: "Tensorflow", Version: job.Version()})
+	}
+	setServingStages(jobs, jobInfos)
+	expected := []types.ServingStage{types.CandidateServingStage, types.StableServingStage, ""}
+	for i, stage := range expected {
+		if jobInfos[i].Stage != stage {
+			t.Errorf("%v/%v: expected stage %q, got %q", jobInfos[i].Name, jobInfos[i].Version, stage, jobInfos[i].Stage)
+		}
+	}
+	if version := servingJobListRow(jobInfos[1])[2]; version != "v2(stable)" {
+		t.Errorf("expected the stable marker in version column, got %v", version)
+	}
+}
@@ -0,0 +1,500 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	kservev1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	lwsv1 "sigs.k8s.io/lws/api/leaderworkerset/v1"
+
+	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/util/kubectl"
+)
+
+const (
+	// servingRevisionHistoryLimit is the max number of revisions kept for a serving job
+	servingRevisionHistoryLimit = 10
+	// servingRevisionKeyPrefix is the prefix of revision keys in the configmap
+	servingRevisionKeyPrefix = "revision-"
+)
+
+// modelPathArgs are the arguments of serving containers which set the model path
+var modelPathArgs = []string{"--model_base_path", "--model-repository", "--model-store", "--model-path"}
+
+// servingRevisionRecord is a revision stored in the configmap,
+// the spec is the part of serving workload which is restored by rollback
+type servingRevisionRecord struct {
+	types.ServingRevision
+	Spec json.RawMessage `json:"spec"`
+}
+
+// ListServingRevisions returns the revisions of serving job ordered by revision number
+func ListServingRevisions(namespace, name, version string, servingType types.ServingJobType) ([]types.ServingRevision, error) {
+	job, err := SearchServingJob(namespace, name, version, servingType)
+	if err != nil {
+		return nil, err
+	}
+	workload, err := servingWorkload(job)
+	if err != nil {
+		return nil, err
+	}
+	records, err := getServingRevisionRecords(workload)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		// the serving job is never updated, its current spec is the first revision
+		record, err := initialServingRevision(workload)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	revisions := []types.ServingRevision{}
+	for _, r := range records {
+		revisions = append(revisions, r.ServingRevision)
+	}
+	return revisions, nil
+}
+
+// RollbackServingJob restores the spec of serving job to a recorded revision,
+// the rollback is recorded as a new revision
+func RollbackServingJob(namespace string, args *types.ServingRollbackArgs) error {
+	job, err := SearchServingJob(namespace, args.Name, args.Version, args.Type)
+	if err != nil {
+		return err
+	}
+	workload, err := servingWorkload(job)
+	if err != nil {
+		return err
+	}
+	records, err := getServingRevisionRecords(workload)
+	if err != nil {
+		return err
+	}
+	target, err := findServingRevision(records, args.ToRevision)
+	if err != nil {
+		return err
+	}
+	if err := restoreServingRevision(workload, target.Spec); err != nil {
+		return err
+	}
+	if err := updateServingWorkload(workload); err != nil {
+		return fmt.Errorf("failed to roll back serving job %v to revision %v: %v", job.Name(), target.Revision, err)
+	}
+	recordServingRevision(workload, fmt.Sprintf("rollback to revision %v", target.Revision))
+	log.Infof("The serving job %s with version %s has been rolled back to revision %v", job.Name(), job.Version(), target.Revision)
+	return nil
+}
+
+// findServingRevision returns the record of the revision, 0 means the revision before the latest one
+func findServingRevision(records []*servingRevisionRecord, revision int) (*servingRevisionRecord, error) {
+	if revision == 0 {
+		if len(records) < 2 {
+			return nil, fmt.Errorf("no previous revision to roll back to")
+		}
+		return records[len(records)-2], nil
+	}
+	for _, r := range records {
+		if r.Revision == revision {
+			return r, nil
+		}
+	}
+	return nil, fmt.Errorf("not found revision %v, please use 'arena serve history' to list the revisions", revision)
+}
+
+// updateServingWorkload updates the deployment, InferenceService or LeaderWorkerSet of serving job
+func updateServingWorkload(workload interface{}) error {
+	switch w := workload.(type) {
+	case *appsv1.Deployment:
+		return kubectl.UpdateDeployment(w)
+	case *kservev1beta1.InferenceService:
+		return kubectl.UpdateInferenceService(w)
+	case *lwsv1.LeaderWorkerSet:
+		return kubectl.UpdateLWSJob(w)
+	}
+	return fmt.Errorf("unsupported serving workload %T", workload)
+}
+
+// servingWorkload returns the object of serving job which is updated in place
+func servingWorkload(job ServingJob) (interface{}, error) {
+	switch j := job.(type) {
+	case *kserveJob:
+		return j.inferenceService.DeepCopy(), nil
+	case *lwsJob:
+		return j.lws.DeepCopy(), nil
+	}
+	if job.Deployment() == nil {
+		return nil, fmt.Errorf("serving job %v with type %v does not support revisions", job.Name(), job.Type())
+	}
+	return job.Deployment().DeepCopy(), nil
+}
+
+// initServingRevisions records the spec of serving workload before its first update as revision 1
+func initServingRevisions(workload interface{}) {
+	records, err := getServingRevisionRecords(workload)
+	if err != nil || len(records) != 0 {
+		return
+	}
+	var current interface{}
+	meta := workloadObjectMeta(workload)
+	switch workload.(type) {
+	case *appsv1.Deployment:
+		current, err = kubectl.GetDeployment(meta.Name, meta.Namespace)
+	case *kservev1beta1.InferenceService:
+		current, err = kubectl.GetInferenceService(meta.Name, meta.Namespace)
+	case *lwsv1.LeaderWorkerSet:
+		current, err = kubectl.GetLWSJob(meta.Name, meta.Namespace)
+	}
+	if err != nil {
+		log.Warnf("failed to get the current spec of %v: %v", meta.Name, err)
+		return
+	}
+	record, err := initialServingRevision(current)
+	if err != nil {
+		log.Warnf("failed to record the revision of %v: %v", meta.Name, err)
+		return
+	}
+	if err := saveServingRevisionRecords(workload, []*servingRevisionRecord{record}); err != nil {
+		log.Warnf("failed to record the revision of %v: %v", meta.Name, err)
+	}
+}
+
+func initialServingRevision(workload interface{}) (*servingRevisionRecord, error) {
+	record, err := snapshotServingRevision(workload)
+	if err != nil {
+		return nil, err
+	}
+	record.Revision = 1
+	record.ChangedAt = workloadObjectMeta(workload).CreationTimestamp.Time
+	record.Cause = "submit"
+	return record, nil
+}
+
+// recordServingRevision records the spec of serving workload as a new revision if it is changed,
+// the failure is only logged because the serving job has been changed
+func recordServingRevision(workload interface{}, cause string) {
+	name := workloadObjectMeta(workload).Name
+	record, err := snapshotServingRevision(workload)
+	if err != nil {
+		log.Warnf("failed to record the revision of %v: %v", name, err)
+		return
+	}
+	records, err := getServingRevisionRecords(workload)
+	if err != nil {
+		log.Warnf("failed to record the revision of %v: %v", name, err)
+		return
+	}
+	record.Revision = 1
+	if len(records) != 0 {
+		latest := records[len(records)-1]
+		if string(latest.Spec) == string(record.Spec) {
+			return
+		}
+		record.Revision = latest.Revision + 1
+	}
+	record.ChangedBy = config.GetArenaConfiger().GetUser().GetName()
+	record.ChangedAt = time.Now()
+	record.Cause = cause
+	records = append(records, record)
+	if len(records) > servingRevisionHistoryLimit {
+		records = records[len(records)-servingRevisionHistoryLimit:]
+	}
+	if err := saveServingRevisionRecords(workload, records); err != nil {
+		log.Warnf("failed to record the revision of %v: %v", name, err)
+	}
+}
+
+// snapshotServingRevision returns the spec of serving workload which is restored by rollback,
+// it is the pod template of deployment, the predictor of InferenceService and the templates of LeaderWorkerSet
+func snapshotServingRevision(workload interface{}) (*servingRevisionRecord, error) {
+	var spec interface{}
+	var container *corev1.Container
+	record := &servingRevisionRecord{}
+	switch w := workload.(type) {
+	case *appsv1.Deployment:
+		spec = w.Spec.Template
+		if len(w.Spec.Template.Spec.Containers) != 0 {
+			container = &w.Spec.Template.Spec.Containers[0]
+		}
+	case *kservev1beta1.InferenceService:
+		spec = w.Spec.Predictor
+		if model := w.Spec.Predictor.Model; model != nil {
+			container = &model.Container
+			if model.StorageURI != nil {
+				record.ModelPath = *model.StorageURI
+			}
+		} else if len(w.Spec.Predictor.Containers) != 0 {
+			container = &w.Spec.Predictor.Containers[0]
+		}
+	case *lwsv1.LeaderWorkerSet:
+		spec = w.Spec.LeaderWorkerTemplate
+		template := &w.Spec.LeaderWorkerTemplate.WorkerTemplate
+		if w.Spec.LeaderWorkerTemplate.LeaderTemplate != nil {
+			template = w.Spec.LeaderWorkerTemplate.LeaderTemplate
+		}
+		if len(template.Spec.Containers) != 0 {
+			container = &template.Spec.Containers[0]
+		}
+	default:
+		return nil, fmt.Errorf("unsupported serving workload %T", workload)
+	}
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	record.Spec = data
+	if container != nil {
+		record.Image = container.Image
+		if record.ModelPath == "" {
+			record.ModelPath = modelPathFromContainer(container)
+		}
+		for _, env := range container.Env {
+			if env.ValueFrom == nil {
+				if record.Envs == nil {
+					record.Envs = map[string]string{}
+				}
+				record.Envs[env.Name] = env.Value
+			}
+		}
+		for name, quantity := range container.Resources.Limits {
+			if record.Resources == nil {
+				record.Resources = map[string]string{}
+			}
+			record.Resources[string(name)] = quantity.String()
+		}
+	}
+	return record, nil
+}
+
+// restoreServingRevision replaces the spec of serving workload with the recorded one
+func restoreServingRevision(workload interface{}, spec []byte) error {
+	switch w := workload.(type) {
+	case *appsv1.Deployment:
+		template := corev1.PodTemplateSpec{}
+		if err := json.Unmarshal(spec, &template); err != nil {
+			return err
+		}
+		w.Spec.Template = template
+	case *kservev1beta1.InferenceService:
+		predictor := kservev1beta1.PredictorSpec{}
+		if err := json.Unmarshal(spec, &predictor); err != nil {
+			return err
+		}
+		w.Spec.Predictor = predictor
+	case *lwsv1.LeaderWorkerSet:
+		template := lwsv1.LeaderWorkerTemplate{}
+		if err := json.Unmarshal(spec, &template); err != nil {
+			return err
+		}
+		w.Spec.LeaderWorkerTemplate = template
+	default:
+		return fmt.Errorf("unsupported serving workload %T", workload)
+	}
+	return nil
+}
+
+// modelPathFromContainer finds the model path in the command and arguments of serving container
+func modelPathFromContainer(container *corev1.Container) string {
+	fields := []string{}
+	for _, arg := range append(append([]string{}, container.Command...), container.Args...) {
+		fields = append(fields, strings.Fields(arg)...)
+	}
+	for i, field := range fields {
+		for _, arg := range modelPathArgs {
+			if strings.HasPrefix(field, arg+"=") {
+				return strings.TrimPrefix(field, arg+"=")
+			}
+			if field == arg && i+1 < len(fields) {
+				return fields[i+1]
+			}
+		}
+	}
+	return ""
+}
+
+// workloadObjectMeta returns the object meta of serving workload
+func workloadObjectMeta(workload interface{}) *metav1.ObjectMeta {
+	switch w := workload.(type) {
+	case *appsv1.Deployment:
+		return &w.ObjectMeta
+	case *kservev1beta1.InferenceService:
+		return &w.ObjectMeta
+	case *lwsv1.LeaderWorkerSet:
+		return &w.ObjectMeta
+	}
+	return &metav1.ObjectMeta{}
+}
+
+// revisionConfigMapName returns the name of configmap which stores the revisions of serving workload
+func revisionConfigMapName(workload interface{}) string {
+	kind := "deployment"
+	switch workload.(type) {
+	case *kservev1beta1.InferenceService:
+		kind = "inferenceservice"
+	case *lwsv1.LeaderWorkerSet:
+		kind = "leaderworkerset"
+	}
+	return fmt.Sprintf("%v-%v-revisions", workloadObjectMeta(workload).Name, kind)
+}
+
+func getServingRevisionRecords(workload interface{}) ([]*servingRevisionRecord, error) {
+	meta := workloadObjectMeta(workload)
+	configMap, err := config.GetArenaConfiger().GetClientSet().CoreV1().ConfigMaps(meta.Namespace).Get(context.TODO(), revisionConfigMapName(workload), metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return parseServingRevisionRecords(configMap.Data)
+}
+
+func parseServingRevisionRecords(data map[string]string) ([]*servingRevisionRecord, error) {
+	records := []*servingRevisionRecord{}
+	for key, value := range data {
+		if !strings.HasPrefix(key, servingRevisionKeyPrefix) {
+			continue
+		}
+		record := &servingRevisionRecord{}
+		if err := json.Unmarshal([]byte(value), record); err != nil {
+			return nil, fmt.Errorf("failed to parse %v: %v", key, err)
+		}
+		records = append(records, record)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].Revision < records[j].Revision
+	})
+	return records, nil
+}
+
+// saveServingRevisionRecords replaces the revisions in the configmap, the configmap is owned by
+// the serving workload so that it is deleted with the serving job
+func saveServingRevisionRecords(workload interface{}, records []*servingRevisionRecord) error {
+	meta := workloadObjectMeta(workload)
+	data := map[string]string{}
+	for _, r := range records {
+		value, err := json.Marshal(r)
+		if err != nil {
+			return err
+		}
+		data[servingRevisionKeyPrefix+strconv.Itoa(r.Revision)] = string(value)
+	}
+	client := config.GetArenaConfiger().GetClientSet().CoreV1().ConfigMaps(meta.Namespace)
+	configMap, err := client.Get(context.TODO(), revisionConfigMapName(workload), metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      revisionConfigMapName(workload),
+				Namespace: meta.Namespace,
+				Labels: map[string]string{
+					"createdBy":            "arena",
+					servingNameLabelKey:    meta.Labels[servingNameLabelKey],
+					servingVersionLabelKey: meta.Labels[servingVersionLabelKey],
+				},
+				OwnerReferences: []metav1.OwnerReference{workloadOwnerReference(workload)},
+			},
+			Data: data,
+		}
+		_, err = client.Create(context.TODO(), configMap, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	configMap.Data = data
+	_, err = client.Update(context.TODO(), configMap, metav1.UpdateOptions{})
+	return err
+}
+
+func workloadOwnerReference(workload interface{}) metav1.OwnerReference {
+	meta := workloadObjectMeta(workload)
+	ownerReference := metav1.OwnerReference{
+		APIVersion: appsv1.SchemeGroupVersion.String(),
+		Kind:       "Deployment",
+		Name:       meta.Name,
+		UID:        meta.UID,
+	}
+	switch workload.(type) {
+	case *kservev1beta1.InferenceService:
+		ownerReference.APIVersion = kservev1beta1.SchemeGroupVersion.String()
+		ownerReference.Kind = "InferenceService"
+	case *lwsv1.LeaderWorkerSet:
+		ownerReference.APIVersion = lwsv1.GroupVersion.String()
+		ownerReference.Kind = "LeaderWorkerSet"
+	}
+	return ownerReference
+}
+
+// PrintServingRevisions prints the revisions of serving job
+func PrintServingRevisions(revisions []types.ServingRevision, format types.FormatStyle) {
+	switch format {
+	case types.JsonFormat:
+		data, _ := json.MarshalIndent(revisions, "", "    ")
+		fmt.Printf("%v\n", string(data))
+		return
+	case types.YamlFormat:
+		data, _ := yaml.Marshal(revisions)
+		fmt.Printf("%v", string(data))
+		return
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "REVISION\tIMAGE\tMODEL_PATH\tRESOURCES\tENVS\tCHANGED_BY\tCHANGED_AT\tCAUSE\n")
+	for _, r := range revisions {
+		fmt.Fprintf(w, "%v\t%v\t%v\t%v\t%v\t%v\t%v\t%v\n",
+			r.Revision,
+			defaultIfEmpty(r.Image),
+			defaultIfEmpty(r.ModelPath),
+			defaultIfEmpty(joinKeyValues(r.Resources)),
+			defaultIfEmpty(joinKeyValues(r.Envs)),
+			defaultIfEmpty(r.ChangedBy),
+			r.ChangedAt.Format(time.RFC3339),
+			r.Cause,
+		)
+	}
+	_ = w.Flush()
+}
+
+func joinKeyValues(values map[string]string) string {
+	pairs := []string{}
+	for k, v := range values {
+		pairs = append(pairs, fmt.Sprintf("%v=%v", k, v))
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+func defaultIfEmpty(value string) string {
+	if value == "" {
+		return "N/A"
+	}
+	return value
+}
@@ -0,0 +1,74 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestServingRevisionSnapshotAndRestore(t *testing.T) {
+	deploy := &appsv1.Deployment{}
+	deploy.Spec.Template.Spec.Containers = []corev1.Container{{
+		Name:    "serving",
+		Image:   "tensorflow/serving:2.11.0",
+		Command: []string{"/bin/sh", "-c"},
+		Args:    []string{"/usr/bin/tensorflow_model_server --port=8500 --model_base_path=/models/mnist"},
+		Env:     []corev1.EnvVar{{Name: "TF_CPP_MIN_LOG_LEVEL", Value: "2"}},
+		Resources: corev1.ResourceRequirements{
+			Limits: corev1.ResourceList{ResourceGPU: resource.MustParse("1")},
+		},
+	}}
+	record, err := snapshotServingRevision(deploy)
+	if err != nil {
+		t.Fatalf("failed to snapshot deployment: %v", err)
+	}
+	if record.Image != "tensorflow/serving:2.11.0" || record.ModelPath != "/models/mnist" {
+		t.Errorf("unexpected image %v or model path %v", record.Image, record.ModelPath)
+	}
+	if record.Envs["TF_CPP_MIN_LOG_LEVEL"] != "2" || record.Resources[string(ResourceGPU)] != "1" {
+		t.Errorf("unexpected envs %v or resources %v", record.Envs, record.Resources)
+	}
+
+	deploy.Spec.Template.Spec.Containers[0].Image = "tensorflow/serving:2.12.0"
+	if err := restoreServingRevision(deploy, record.Spec); err != nil {
+		t.Fatalf("failed to restore deployment: %v", err)
+	}
+	if image := deploy.Spec.Template.Spec.Containers[0].Image; image != "tensorflow/serving:2.11.0" {
+		t.Errorf("expected the image to be restored, got %v", image)
+	}
+}
+
+func TestFindServingRevision(t *testing.T) {
+	records := []*servingRevisionRecord{{}, {}, {}}
+	for i, r := range records {
+		r.Revision = i + 3
+	}
+	if r, err := findServingRevision(records, 0); err != nil || r.Revision != 4 {
+		t.Errorf("expected the previous revision 4, got %v, %v", r, err)
+	}
+	if r, err := findServingRevision(records, 3); err != nil || r.Revision != 3 {
+		t.Errorf("expected revision 3, got %v, %v", r, err)
+	}
+	if _, err := findServingRevision(records, 1); err == nil {
+		t.Errorf("expected an error for the revision which is not kept")
+	}
+	if _, err := findServingRevision(records[:1], 0); err == nil {
+		t.Errorf("expected an error without previous revision")
+	}
+}
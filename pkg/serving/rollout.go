@@ -0,0 +1,168 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"text/template"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/prometheus"
+)
+
+// rolloutPollInterval is the interval of checking the readiness of serving version
+var rolloutPollInterval = 5 * time.Second
+
+// RunServingRollout shifts the traffic from one version to another step by step,
+// the readiness and the prometheus queries of the new version are checked after each step,
+// and the traffic weights before rollout are restored if any check fails or the context is canceled
+func RunServingRollout(ctx context.Context, namespace string, args *types.ServingRolloutArgs) error {
+	for _, version := range []string{args.FromVersion, args.ToVersion} {
+		if _, err := SearchServingJob(namespace, args.ServingName, version, args.Type); err != nil {
+			return err
+		}
+	}
+	router, err := GetTrafficRouter(args.TrafficRouterOptions)
+	if err != nil {
+		return err
+	}
+	previousWeights, err := router.GetVersionWeights(namespace, args.ServingName)
+	if err != nil {
+		return err
+	}
+	rollback := func(reason error) error {
+		log.Warnf("rollout of serving job %v failed: %v, rolling back the traffic", args.ServingName, reason)
+		if err := router.SetVersionWeights(namespace, args.ServingName, rollbackWeights(previousWeights, args.FromVersion)); err != nil {
+			return fmt.Errorf("%v, and failed to roll back the traffic: %v", reason, err)
+		}
+		return fmt.Errorf("the traffic of serving job %v is rolled back, because %v", args.ServingName, reason)
+	}
+	for i, step := range args.Steps {
+		if err := waitServingVersionReady(ctx, namespace, args.ServingName, args.ToVersion, args.Type, args.ReadinessTimeout); err != nil {
+			return rollback(err)
+		}
+		weights := []types.ServingVersionWeight{
+			{Version: args.FromVersion, Weight: 100 - step},
+			{Version: args.ToVersion, Weight: step},
+		}
+		if err := router.SetVersionWeights(namespace, args.ServingName, weights); err != nil {
+			return rollback(err)
+		}
+		log.Infof("step %v/%v: %v%% of traffic is routed to version %v", i+1, len(args.Steps), step, args.ToVersion)
+		// the final step is analyzed too, a regression which only appears with all traffic is rolled back
+		select {
+		case <-ctx.Done():
+			return rollback(ctx.Err())
+		case <-time.After(args.Interval):
+		}
+		if err := waitServingVersionReady(ctx, namespace, args.ServingName, args.ToVersion, args.Type, args.ReadinessTimeout); err != nil {
+			return rollback(err)
+		}
+		if err := analyzeServingVersion(namespace, args); err != nil {
+			return rollback(err)
+		}
+	}
+	log.Infof("Succeed to roll out serving job %v from version %v to %v", args.ServingName, args.FromVersion, args.ToVersion)
+	return nil
+}
+
+// rollbackWeights returns the weights before rollout, all traffic is routed to
+// the version which is shifted from if the traffic is not split before
+func rollbackWeights(previous map[string]int32, fromVersion string) []types.ServingVersionWeight {
+	if len(previous) == 0 {
+		return []types.ServingVersionWeight{{Version: fromVersion, Weight: 100}}
+	}
+	weights := []types.ServingVersionWeight{}
+	for version, weight := range previous {
+		weights = append(weights, types.ServingVersionWeight{Version: version, Weight: int(weight)})
+	}
+	return weights
+}
+
+// waitServingVersionReady waits until all instances of the version are available
+func waitServingVersionReady(ctx context.Context, namespace, name, version string, servingType types.ServingJobType, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		job, err := SearchServingJob(namespace, name, version, servingType)
+		if err != nil {
+			return err
+		}
+		desired, available := job.DesiredInstances(), job.AvailableInstances()
+		if desired > 0 && available >= desired {
+			return nil
+		}
+		if !time.Now().Before(deadline) {
+			return fmt.Errorf("version %v is not ready in %v, %v of %v instances are available", version, timeout, available, desired)
+		}
+		log.Debugf("waiting for version %v to be ready, %v of %v instances are available", version, available, desired)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(rolloutPollInterval):
+		}
+	}
+}
+
+// analyzeServingVersion checks the prometheus queries of the new version do not exceed their thresholds
+func analyzeServingVersion(namespace string, args *types.ServingRolloutArgs) error {
+	for _, analysis := range args.Analysis {
+		query, err := renderRolloutQuery(analysis.Query, namespace, args)
+		if err != nil {
+			return err
+		}
+		metrics, err := prometheus.QueryPrometheusMetrics(config.GetArenaConfiger().GetClientSet(), query)
+		if err != nil {
+			return fmt.Errorf("failed to query %v of version %v: %v", analysis.Name, args.ToVersion, err)
+		}
+		if len(metrics) == 0 {
+			log.Warnf("the %v query of version %v returns no data, skip to check it", analysis.Name, args.ToVersion)
+			continue
+		}
+		value, err := strconv.ParseFloat(metrics[0].Value, 64)
+		if err != nil {
+			return fmt.Errorf("invalid %v value %v of version %v: %v", analysis.Name, metrics[0].Value, args.ToVersion, err)
+		}
+		log.Infof("%v of version %v is %v, threshold is %v", analysis.Name, args.ToVersion, value, analysis.Threshold)
+		if value > analysis.Threshold {
+			return fmt.Errorf("%v of version %v is %v which exceeds %v", analysis.Name, args.ToVersion, value, analysis.Threshold)
+		}
+	}
+	return nil
+}
+
+// renderRolloutQuery replaces {{.Namespace}}, {{.Name}} and {{.Version}} of the query
+func renderRolloutQuery(query, namespace string, args *types.ServingRolloutArgs) (string, error) {
+	t, err := template.New("query").Parse(query)
+	if err != nil {
+		return "", fmt.Errorf("invalid prometheus query %v: %v", query, err)
+	}
+	var buffer bytes.Buffer
+	err = t.Execute(&buffer, map[string]string{
+		"Namespace": namespace,
+		"Name":      args.ServingName,
+		"Version":   args.ToVersion,
+	})
+	if err != nil {
+		return "", fmt.Errorf("invalid prometheus query %v: %v", query, err)
+	}
+	return buffer.String(), nil
+}
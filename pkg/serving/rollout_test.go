@@ -0,0 +1,50 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"testing"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+func TestRenderRolloutQuery(t *testing.T) {
+	args := &types.ServingRolloutArgs{ServingName: "mnist", FromVersion: "v1", ToVersion: "v2"}
+	query, err := renderRolloutQuery(`errors{namespace="{{.Namespace}}",app="{{.Name}}",version="{{.Version}}"}`, "default", args)
+	if err != nil {
+		t.Fatalf("failed to render query: %v", err)
+	}
+	if expected := `errors{namespace="default",app="mnist",version="v2"}`; query != expected {
+		t.Errorf("expected query %v, got %v", expected, query)
+	}
+	if _, err := renderRolloutQuery("errors{{.Version", "default", args); err == nil {
+		t.Errorf("expected an error for the invalid query")
+	}
+}
+
+func TestRollbackWeights(t *testing.T) {
+	weights := rollbackWeights(map[string]int32{}, "v1")
+	if len(weights) != 1 || weights[0].Version != "v1" || weights[0].Weight != 100 {
+		t.Errorf("expected all traffic to v1, got %v", weights)
+	}
+	weights = rollbackWeights(map[string]int32{"v1": 80, "v0": 20}, "v1")
+	total := 0
+	for _, w := range weights {
+		total += w.Weight
+	}
+	if len(weights) != 2 || total != 100 {
+		t.Errorf("expected the previous weights, got %v", weights)
+	}
+}
@@ -41,6 +41,7 @@ const (
 	servingNameLabelKey       = "servingName"
 	servingTypeLabelKey       = "servingType"
 	servingVersionLabelKey    = "servingVersion"
+	servingStageLabelKey      = "servingStage"
 	istioNamespace            = "istio-system"
 	grpcServingPortName       = "grpc-serving"
 	restfulServingPortName    = "http-serving"
@@ -65,6 +66,8 @@ func GetAllProcesser() map[types.ServingJobType]Processer {
 			NewSeldonServingProcesser,
 			NewTritonServingProcesser,
 			NewDistributedServingProcesser,
+			NewVLLMServingProcesser,
+			NewSGLangServingProcesser,
 		}
 		var wg sync.WaitGroup
 		for _, initFunc := range processerInits {
@@ -355,6 +358,7 @@ func (s *servingJob) Convert2JobInfo() types.ServingJobInfo {
 		Instances:         s.Instances(),
 		CreationTimestamp: s.StartTime().Unix(),
 	}
+	servingJobInfo.OpenAIBaseURL = openAIBaseURL(s.servingType, servingJobInfo.IPAddress, servingJobInfo.Endpoints)
 	return servingJobInfo
 }
 
@@ -53,15 +53,18 @@ func SubmitCustomServingJob(namespace string, args *types.CustomServingArgs) (er
 	if err != nil {
 		return err
 	}
-	if err := ValidateJobsBeforeSubmiting(jobs, args.Name); err != nil {
+	if err := ValidateJobsBeforeSubmiting(jobs, args.Name, args.DryRun); err != nil {
 		return err
 	}
 	// the master is also considered as a worker
 	customChart := util.GetChartsFolder() + "/custom-serving"
-	err = workflow.SubmitJob(nameWithVersion, string(types.CustomServingJob), namespace, args, customChart, args.HelmOptions...)
+	err = workflow.SubmitJob(nameWithVersion, string(types.CustomServingJob), namespace, args, customChart, args.DryRun, args.HelmOptions...)
 	if err != nil {
 		return err
 	}
+	if err := applyServingAutoscaler(namespace, args.Name, args.Version, args.Type, args.ServingAutoscaleArgs); err != nil {
+		return err
+	}
 	log.Infof("The Job %s has been submitted successfully", args.Name)
 	log.Infof("You can run `arena serve get %s --type %s -n %s` to check the job status", args.Name, args.Type, args.Namespace)
 	return nil
@@ -69,11 +69,11 @@ func SubmitDistributedServingJob(namespace string, args *types.DistributedServin
 	if err != nil {
 		return err
 	}
-	if err := ValidateJobsBeforeSubmiting(jobs, args.Name); err != nil {
+	if err := ValidateJobsBeforeSubmiting(jobs, args.Name, args.DryRun); err != nil {
 		return err
 	}
 	chart := util.GetChartsFolder() + "/distributed-serving"
-	err = workflow.SubmitJob(nameWithVersion, string(types.DistributedServingJob), namespace, args, chart, args.HelmOptions...)
+	err = workflow.SubmitJob(nameWithVersion, string(types.DistributedServingJob), namespace, args, chart, args.DryRun, args.HelmOptions...)
 	if err != nil {
 		return err
 	}
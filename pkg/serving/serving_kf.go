@@ -53,12 +53,12 @@ func SubmitKFServingJob(namespace string, args *types.KFServingArgs) (err error)
 	if err != nil {
 		return err
 	}
-	if err := ValidateJobsBeforeSubmiting(jobs, args.Name); err != nil {
+	if err := ValidateJobsBeforeSubmiting(jobs, args.Name, args.DryRun); err != nil {
 		return err
 	}
 	// the master is also considered as a worker
 	chart := util.GetChartsFolder() + "/kfserving"
-	err = workflow.SubmitJob(nameWithVersion, string(types.KFServingJob), namespace, args, chart, args.HelmOptions...)
+	err = workflow.SubmitJob(nameWithVersion, string(types.KFServingJob), namespace, args, chart, args.DryRun, args.HelmOptions...)
 	if err != nil {
 		return err
 	}
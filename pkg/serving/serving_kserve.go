@@ -72,12 +72,12 @@ func SubmitKServeJob(namespace string, args *types.KServeArgs) (err error) {
 	if err != nil {
 		return err
 	}
-	if err := ValidateJobsBeforeSubmiting(jobs, args.Name); err != nil {
+	if err := ValidateJobsBeforeSubmiting(jobs, args.Name, args.DryRun); err != nil {
 		return err
 	}
 	// the master is also considered as a worker
 	chart := util.GetChartsFolder() + "/kserve"
-	err = workflow.SubmitJob(args.Name, string(types.KServeJob), namespace, args, chart, args.HelmOptions...)
+	err = workflow.SubmitJob(args.Name, string(types.KServeJob), namespace, args, chart, args.DryRun, args.HelmOptions...)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,96 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"fmt"
+
+	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/util"
+	"github.com/kubeflow/arena/pkg/workflow"
+	log "github.com/sirupsen/logrus"
+)
+
+// LLMServingProcesser use the default processer, it is shared by vllm and sglang serving
+type LLMServingProcesser struct {
+	*processer
+}
+
+func NewVLLMServingProcesser() Processer {
+	return newLLMServingProcesser(types.VLLMServingJob)
+}
+
+func NewSGLangServingProcesser() Processer {
+	return newLLMServingProcesser(types.SGLangServingJob)
+}
+
+func newLLMServingProcesser(servingType types.ServingJobType) Processer {
+	p := &processer{
+		processerType:   servingType,
+		client:          config.GetArenaConfiger().GetClientSet(),
+		enable:          true,
+		useIstioGateway: false,
+	}
+	return &LLMServingProcesser{
+		processer: p,
+	}
+}
+
+// SubmitLLMServingJob submits vllm or sglang serving job with the custom serving chart
+func SubmitLLMServingJob(namespace string, args *types.LLMServingArgs) (err error) {
+	nameWithVersion := fmt.Sprintf("%v-%v", args.Name, args.Version)
+	args.Namespace = namespace
+	processers := GetAllProcesser()
+	processer, ok := processers[args.Type]
+	if !ok {
+		return fmt.Errorf("not found processer whose type is %v", args.Type)
+	}
+	jobs, err := processer.GetServingJobs(args.Namespace, args.Name, args.Version)
+	if err != nil {
+		return err
+	}
+	if err := ValidateJobsBeforeSubmiting(jobs, args.Name, args.DryRun); err != nil {
+		return err
+	}
+	customChart := util.GetChartsFolder() + "/custom-serving"
+	err = workflow.SubmitJob(nameWithVersion, string(args.Type), namespace, args, customChart, args.DryRun, args.HelmOptions...)
+	if err != nil {
+		return err
+	}
+	if err := applyServingAutoscaler(namespace, args.Name, args.Version, args.Type, args.ServingAutoscaleArgs); err != nil {
+		return err
+	}
+	log.Infof("The Job %s has been submitted successfully", args.Name)
+	log.Infof("You can run `arena serve get %s --type %s -n %s` to check the job status", args.Name, args.Type, args.Namespace)
+	return nil
+}
+
+// openAIBaseURL returns the OpenAI-compatible base url of vllm and sglang serving,
+// it is empty for other serving types or if the address is unknown
+func openAIBaseURL(servingType types.ServingJobType, ipAddress string, endpoints []types.Endpoint) string {
+	if servingType != types.VLLMServingJob && servingType != types.SGLangServingJob {
+		return ""
+	}
+	if ipAddress == "" || ipAddress == "N/A" {
+		return ""
+	}
+	for _, e := range endpoints {
+		if e.Name == "RESTFUL" || e.Name == "HTTP" {
+			return fmt.Sprintf("http://%v:%v/v1", ipAddress, e.Port)
+		}
+	}
+	return ""
+}
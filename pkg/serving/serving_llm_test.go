@@ -0,0 +1,58 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/argsbuilder"
+)
+
+func TestOpenAIBaseURL(t *testing.T) {
+	endpoints := []types.Endpoint{{Name: "GRPC", Port: 8500}, {Name: "RESTFUL", Port: 8000}}
+	if url := openAIBaseURL(types.VLLMServingJob, "10.0.0.1", endpoints); url != "http://10.0.0.1:8000/v1" {
+		t.Errorf("expected the restful endpoint, got %v", url)
+	}
+	if url := openAIBaseURL(types.CustomServingJob, "10.0.0.1", endpoints); url != "" {
+		t.Errorf("expected no url for custom serving, got %v", url)
+	}
+	if url := openAIBaseURL(types.SGLangServingJob, "N/A", endpoints); url != "" {
+		t.Errorf("expected no url for unknown address, got %v", url)
+	}
+}
+
+func TestLLMServingCommand(t *testing.T) {
+	args := &types.LLMServingArgs{
+		ServingType:        types.VLLMServingJob,
+		ModelPath:          "/models/llama",
+		ServedModelName:    "llama",
+		TensorParallelSize: 2,
+		MaxModelLen:        8192,
+		ExtraArgs:          []string{"--enable-prefix-caching"},
+	}
+	args.RestfulPort = 8000
+	expected := "vllm serve /models/llama --host 0.0.0.0 --port 8000 --served-model-name llama --tensor-parallel-size 2 --max-model-len 8192 --enable-prefix-caching"
+	if command := strings.Join(argsbuilder.LLMServingCommand(args), " "); command != expected {
+		t.Errorf("expected command %v, got %v", expected, command)
+	}
+	args.ServingType = types.SGLangServingJob
+	args.ExtraArgs = nil
+	expected = "python3 -m sglang.launch_server --model-path /models/llama --host 0.0.0.0 --port 8000 --served-model-name llama --tp-size 2 --context-length 8192"
+	if command := strings.Join(argsbuilder.LLMServingCommand(args), " "); command != expected {
+		t.Errorf("expected command %v, got %v", expected, command)
+	}
+}
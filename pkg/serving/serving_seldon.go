@@ -251,7 +251,7 @@ func SubmitSeldonServingJob(namespace string, args *types.SeldonServingArgs) (er
 	if err != nil {
 		return err
 	}
-	if err := ValidateJobsBeforeSubmiting(jobs, args.Name); err != nil {
+	if err := ValidateJobsBeforeSubmiting(jobs, args.Name, args.DryRun); err != nil {
 		return err
 	}
 	// the master is also considered as a worker
@@ -259,7 +259,7 @@ func SubmitSeldonServingJob(namespace string, args *types.SeldonServingArgs) (er
 	log.Infof("seldon chart path: %s", chart)
 	temp, _ := json.Marshal(args)
 	log.Infof("seldon args: %s", string(temp))
-	err = workflow.SubmitJob(nameWithVersion, string(types.SeldonServingJob), namespace, args, chart, args.HelmOptions...)
+	err = workflow.SubmitJob(nameWithVersion, string(types.SeldonServingJob), namespace, args, chart, args.DryRun, args.HelmOptions...)
 	if err != nil {
 		return err
 	}
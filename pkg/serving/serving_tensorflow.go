@@ -53,15 +53,18 @@ func SubmitTensorflowServingJob(namespace string, args *types.TensorFlowServingA
 	if err != nil {
 		return err
 	}
-	if err := ValidateJobsBeforeSubmiting(jobs, args.Name); err != nil {
+	if err := ValidateJobsBeforeSubmiting(jobs, args.Name, args.DryRun); err != nil {
 		return err
 	}
 	// the master is also considered as a worker
 	chart := util.GetChartsFolder() + "/tfserving"
-	err = workflow.SubmitJob(nameWithVersion, string(types.TFServingJob), namespace, args, chart, args.HelmOptions...)
+	err = workflow.SubmitJob(nameWithVersion, string(types.TFServingJob), namespace, args, chart, args.DryRun, args.HelmOptions...)
 	if err != nil {
 		return err
 	}
+	if err := applyServingAutoscaler(namespace, args.Name, args.Version, args.Type, args.ServingAutoscaleArgs); err != nil {
+		return err
+	}
 	log.Infof("The Job %s has been submitted successfully", args.Name)
 	log.Infof("You can run `arena serve get %s --type %s -n %s` to check the job status", args.Name, args.Type, args.Namespace)
 	return nil
@@ -53,12 +53,12 @@ func SubmitTensorRTServingJob(namespace string, args *types.TensorRTServingArgs)
 	if err != nil {
 		return err
 	}
-	if err := ValidateJobsBeforeSubmiting(jobs, args.Name); err != nil {
+	if err := ValidateJobsBeforeSubmiting(jobs, args.Name, args.DryRun); err != nil {
 		return err
 	}
 	// the master is also considered as a worker
 	chart := util.GetChartsFolder() + "/trtserving"
-	err = workflow.SubmitJob(nameWithVersion, string(types.TRTServingJob), namespace, args, chart, args.HelmOptions...)
+	err = workflow.SubmitJob(nameWithVersion, string(types.TRTServingJob), namespace, args, chart, args.DryRun, args.HelmOptions...)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,50 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"fmt"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+// TrafficRouter routes the traffic of serving job to its versions by weights
+type TrafficRouter interface {
+	// Type returns the traffic router type
+	Type() types.TrafficRouterType
+	// GetVersionWeights returns the weights of versions, it is empty if the traffic is not split
+	GetVersionWeights(namespace, servingName string) (map[string]int32, error)
+	// SetVersionWeights routes the traffic of serving job to the versions by weights
+	SetVersionWeights(namespace, servingName string, weights []types.ServingVersionWeight) error
+}
+
+// trafficRouters stores the constructors of traffic routers
+var trafficRouters = map[types.TrafficRouterType]func(options types.TrafficRouterOptions) (TrafficRouter, error){
+	types.IstioTrafficRouter:      newIstioTrafficRouter,
+	types.GatewayAPITrafficRouter: newGatewayAPITrafficRouter,
+}
+
+// GetTrafficRouter returns the traffic router of the options, istio is used if the router is not set
+func GetTrafficRouter(options types.TrafficRouterOptions) (TrafficRouter, error) {
+	routerType := options.Router
+	if routerType == "" {
+		routerType = types.IstioTrafficRouter
+	}
+	newRouter, ok := trafficRouters[routerType]
+	if !ok {
+		return nil, fmt.Errorf("unknown traffic router %v, the possible option is %v or %v", routerType, types.IstioTrafficRouter, types.GatewayAPITrafficRouter)
+	}
+	return newRouter(options)
+}
@@ -0,0 +1,242 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+
+	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+const httpRouteResource = "httproutes"
+
+// gatewayAPITrafficRouter routes the traffic by the weighted backendRefs of HTTPRoute,
+// each backendRef refers to the service of a serving version
+type gatewayAPITrafficRouter struct {
+	gatewayClient *rest.RESTClient
+	gateway       string
+}
+
+func newGatewayAPITrafficRouter(options types.TrafficRouterOptions) (TrafficRouter, error) {
+	gatewayClient, err := newRESTClient(schema.GroupVersion{
+		Group:   "gateway.networking.k8s.io",
+		Version: "v1",
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &gatewayAPITrafficRouter{
+		gatewayClient: gatewayClient,
+		gateway:       options.Gateway,
+	}, nil
+}
+
+func (r *gatewayAPITrafficRouter) Type() types.TrafficRouterType {
+	return types.GatewayAPITrafficRouter
+}
+
+func (r *gatewayAPITrafficRouter) GetVersionWeights(namespace, servingName string) (map[string]int32, error) {
+	weights := map[string]int32{}
+	data, err := r.gatewayClient.Get().Namespace(namespace).Resource(httpRouteResource).Name(servingName).Do(context.TODO()).Raw()
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return weights, nil
+		}
+		return nil, err
+	}
+	route := types.HTTPRouteCRD{}
+	if err := json.Unmarshal(data, &route); err != nil {
+		return nil, err
+	}
+	services, err := listServingVersionServices(namespace, servingName)
+	if err != nil {
+		return nil, err
+	}
+	versions := map[string]string{}
+	for version, service := range services {
+		versions[service.Name] = version
+	}
+	for _, rule := range route.Spec.Rules {
+		for _, backendRef := range rule.BackendRefs {
+			version, ok := versions[backendRef.Name]
+			if !ok {
+				log.Debugf("backendRef %v of HTTPRoute %v is not a serving version, skip it", backendRef.Name, servingName)
+				continue
+			}
+			weights[version] = backendRef.Weight
+		}
+	}
+	return weights, nil
+}
+
+func (r *gatewayAPITrafficRouter) SetVersionWeights(namespace, servingName string, versionWeights []types.ServingVersionWeight) error {
+	services, err := listServingVersionServices(namespace, servingName)
+	if err != nil {
+		return err
+	}
+	backendRefs := []types.HTTPBackendRef{}
+	for _, vw := range versionWeights {
+		service, ok := services[vw.Version]
+		if !ok {
+			return fmt.Errorf("not found the service of serving job %v with version %v", servingName, vw.Version)
+		}
+		backendRefs = append(backendRefs, types.HTTPBackendRef{
+			Name:   service.Name,
+			Port:   servicePort(service),
+			Weight: int32(vw.Weight),
+		})
+	}
+	request := r.gatewayClient.Get().Namespace(namespace).Resource(httpRouteResource).Name(servingName)
+	data, err := request.Do(context.TODO()).Raw()
+	if k8serrors.IsNotFound(err) {
+		return r.createHTTPRoute(namespace, servingName, backendRefs)
+	}
+	if err != nil {
+		return err
+	}
+	log.Debugf("original httproute: %s", data)
+	// the route is updated as a map to keep the fields which arena does not know, like matches and filters
+	route := map[string]interface{}{}
+	if err := json.Unmarshal(data, &route); err != nil {
+		return err
+	}
+	if err := setHTTPRouteBackendRefs(route, backendRefs); err != nil {
+		return fmt.Errorf("failed to update HTTPRoute %v: %v", servingName, err)
+	}
+	updated, err := json.Marshal(route)
+	if err != nil {
+		return err
+	}
+	log.Debugf("updated httproute: %s", updated)
+	_, err = r.gatewayClient.Put().Namespace(namespace).Resource(httpRouteResource).Name(servingName).Body(updated).Do(context.TODO()).Raw()
+	return err
+}
+
+func (r *gatewayAPITrafficRouter) createHTTPRoute(namespace, servingName string, backendRefs []types.HTTPBackendRef) error {
+	if r.gateway == "" {
+		return fmt.Errorf("not found HTTPRoute %v, the gateway must be set by --gateway or trafficRouterGateway of arena config to create it", servingName)
+	}
+	parentRef := types.GatewayParentReference{Name: r.gateway}
+	if items := strings.SplitN(r.gateway, "/", 2); len(items) == 2 {
+		parentRef = types.GatewayParentReference{Namespace: items[0], Name: items[1]}
+	}
+	route := types.HTTPRouteCRD{
+		Kind:       "HTTPRoute",
+		APIVersion: "gateway.networking.k8s.io/v1",
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      servingName,
+			Namespace: namespace,
+			Labels: map[string]string{
+				"servingName": servingName,
+				"createdBy":   "arena",
+			},
+		},
+		Spec: types.HTTPRouteSpec{
+			ParentRefs: []types.GatewayParentReference{parentRef},
+			Rules: []types.HTTPRouteRule{
+				{BackendRefs: backendRefs},
+			},
+		},
+	}
+	data, err := json.Marshal(route)
+	if err != nil {
+		return err
+	}
+	log.Debugf("create httproute: %s", data)
+	_, err = r.gatewayClient.Post().Namespace(namespace).Resource(httpRouteResource).Body(data).Do(context.TODO()).Raw()
+	return err
+}
+
+// setHTTPRouteBackendRefs replaces the backendRefs of the first rule of HTTPRoute,
+// a rule is added if the HTTPRoute has no rules
+func setHTTPRouteBackendRefs(route map[string]interface{}, backendRefs []types.HTTPBackendRef) error {
+	spec, ok := route["spec"].(map[string]interface{})
+	if !ok {
+		spec = map[string]interface{}{}
+		route["spec"] = spec
+	}
+	refs := []interface{}{}
+	for _, backendRef := range backendRefs {
+		ref := map[string]interface{}{
+			"name":   backendRef.Name,
+			"weight": backendRef.Weight,
+		}
+		if backendRef.Port != 0 {
+			ref["port"] = backendRef.Port
+		}
+		refs = append(refs, ref)
+	}
+	rules, _ := spec["rules"].([]interface{})
+	if len(rules) == 0 {
+		spec["rules"] = []interface{}{map[string]interface{}{"backendRefs": refs}}
+		return nil
+	}
+	rule, ok := rules[0].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("invalid rule %v", rules[0])
+	}
+	rule["backendRefs"] = refs
+	return nil
+}
+
+// listServingVersionServices returns the services of serving job by versions
+func listServingVersionServices(namespace, servingName string) (map[string]*corev1.Service, error) {
+	serviceList, err := config.GetArenaConfiger().GetClientSet().CoreV1().Services(namespace).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("servingName=%v", servingName),
+	})
+	if err != nil {
+		return nil, err
+	}
+	services := map[string]*corev1.Service{}
+	sort.Slice(serviceList.Items, func(i, j int) bool {
+		return serviceList.Items[i].Name < serviceList.Items[j].Name
+	})
+	for i, service := range serviceList.Items {
+		version := service.Labels["servingVersion"]
+		if version == "" {
+			continue
+		}
+		if _, ok := services[version]; ok {
+			continue
+		}
+		services[version] = &serviceList.Items[i]
+	}
+	return services, nil
+}
+
+// servicePort returns the http port of service, the first port is used if no port is named like http
+func servicePort(service *corev1.Service) int32 {
+	if len(service.Spec.Ports) == 0 {
+		return 0
+	}
+	for _, port := range service.Spec.Ports {
+		if strings.Contains(port.Name, "http") || strings.Contains(port.Name, "rest") {
+			return port.Port
+		}
+	}
+	return service.Spec.Ports[0].Port
+}
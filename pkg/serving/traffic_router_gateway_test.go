@@ -0,0 +1,74 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"encoding/json"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+func TestSetHTTPRouteBackendRefs(t *testing.T) {
+	route := map[string]interface{}{}
+	original := `{"spec":{"parentRefs":[{"name":"gw"}],"rules":[{"matches":[{"path":{"type":"PathPrefix","value":"/v1"}}],"backendRefs":[{"name":"old","weight":100}]}]}}`
+	if err := json.Unmarshal([]byte(original), &route); err != nil {
+		t.Fatalf("failed to parse route: %v", err)
+	}
+	backendRefs := []types.HTTPBackendRef{
+		{Name: "mnist-v1", Port: 8501, Weight: 90},
+		{Name: "mnist-v2", Port: 8501, Weight: 10},
+	}
+	if err := setHTTPRouteBackendRefs(route, backendRefs); err != nil {
+		t.Fatalf("failed to set backendRefs: %v", err)
+	}
+	data, _ := json.Marshal(route)
+	parsed := types.HTTPRouteCRD{}
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		t.Fatalf("failed to parse updated route: %v", err)
+	}
+	refs := parsed.Spec.Rules[0].BackendRefs
+	if len(refs) != 2 || refs[1].Name != "mnist-v2" || refs[1].Weight != 10 || refs[0].Port != 8501 {
+		t.Errorf("unexpected backendRefs: %+v", refs)
+	}
+	rules := route["spec"].(map[string]interface{})["rules"].([]interface{})
+	if _, ok := rules[0].(map[string]interface{})["matches"]; !ok {
+		t.Errorf("expected the matches of rule to be kept")
+	}
+
+	empty := map[string]interface{}{}
+	if err := setHTTPRouteBackendRefs(empty, backendRefs); err != nil {
+		t.Fatalf("failed to set backendRefs: %v", err)
+	}
+	if rules, ok := empty["spec"].(map[string]interface{})["rules"].([]interface{}); !ok || len(rules) != 1 {
+		t.Errorf("expected a rule to be added, got %v", empty)
+	}
+}
+
+func TestServicePort(t *testing.T) {
+	service := &corev1.Service{Spec: corev1.ServiceSpec{Ports: []corev1.ServicePort{
+		{Name: "grpc-serving", Port: 8500},
+		{Name: "http-serving", Port: 8501},
+	}}}
+	if port := servicePort(service); port != 8501 {
+		t.Errorf("expected the http port 8501, got %v", port)
+	}
+	service.Spec.Ports = service.Spec.Ports[:1]
+	if port := servicePort(service); port != 8500 {
+		t.Errorf("expected the first port 8500, got %v", port)
+	}
+}
@@ -32,15 +32,45 @@ import (
 )
 
 func RunTrafficRouterSplit(namespace string, args *types.TrafficRouterSplitArgs) (err error) {
-	istioClient, err := initIstioClient()
+	router, err := GetTrafficRouter(args.TrafficRouterOptions)
+	if err != nil {
+		return err
+	}
+	err = router.SetVersionWeights(namespace, args.ServingName, args.VersionWeights)
 	if err != nil {
 		return err
 	}
+	log.Infof("Succeed to split the traffic for serving job %v", args.ServingName)
+	return nil
+}
+
+// istioTrafficRouter routes the traffic by the subsets of DestinationRule and the weights of VirtualService
+type istioTrafficRouter struct {
+	istioClient *rest.RESTClient
+}
+
+func newIstioTrafficRouter(options types.TrafficRouterOptions) (TrafficRouter, error) {
+	istioClient, err := initIstioClient()
+	if err != nil {
+		return nil, err
+	}
+	return &istioTrafficRouter{istioClient: istioClient}, nil
+}
+
+func (r *istioTrafficRouter) Type() types.TrafficRouterType {
+	return types.IstioTrafficRouter
+}
+
+func (r *istioTrafficRouter) GetVersionWeights(namespace, servingName string) (map[string]int32, error) {
+	return getVirtualServiceWeight(r.istioClient, namespace, servingName)
+}
+
+func (r *istioTrafficRouter) SetVersionWeights(namespace, servingName string, versionWeights []types.ServingVersionWeight) error {
 	preprocessObject := types.PreprocesObject{
-		ServiceName:     args.ServingName,
+		ServiceName:     servingName,
 		Namespace:       namespace,
-		DestinationRule: generateDestinationRule(namespace, args.ServingName, args.VersionWeights),
-		VirtualService:  generateVirtualService(namespace, args.ServingName, args.VersionWeights),
+		DestinationRule: generateDestinationRule(namespace, servingName, versionWeights),
+		VirtualService:  generateVirtualService(namespace, servingName, versionWeights),
 	}
 	log.Debugf("serviceName: %s", preprocessObject.ServiceName)
 	jsonDestinationRule, err := json.Marshal(preprocessObject.DestinationRule)
@@ -57,16 +87,11 @@ func RunTrafficRouterSplit(namespace string, args *types.TrafficRouterSplitArgs)
 	log.Debugf("virtualServiceName:%s", virtualServiceName)
 	destinationRuleName := preprocessObject.ServiceName
 	log.Debugf("destinationRuleName:%s", virtualServiceName)
-	err = createOrUpdateDestinationRule(istioClient, preprocessObject, destinationRuleName)
-	if err != nil {
-		return err
-	}
-	err = createOrUpdateVirtualService(namespace, istioClient, preprocessObject, virtualServiceName)
+	err = createOrUpdateDestinationRule(r.istioClient, preprocessObject, destinationRuleName)
 	if err != nil {
 		return err
 	}
-	log.Infof("Succeed to split the traffic for serving job %v", args.ServingName)
-	return nil
+	return createOrUpdateVirtualService(namespace, r.istioClient, preprocessObject, virtualServiceName)
 }
 
 func generateDestinationRule(namespace string, serviceName string, versionWeights []types.ServingVersionWeight) types.DestinationRuleCRD {
@@ -232,18 +257,22 @@ func createOrUpdateVirtualService(namespace string, istioClient *rest.RESTClient
 }
 
 func initIstioClient() (*rest.RESTClient, error) {
-	restConfig := config.GetArenaConfiger().GetRestConfig()
-	istioAPIGroupVersion := schema.GroupVersion{
+	return newRESTClient(schema.GroupVersion{
 		Group:   "networking.istio.io",
 		Version: "v1alpha3",
-	}
-	restConfig.GroupVersion = &istioAPIGroupVersion
+	})
+}
+
+// newRESTClient creates the rest client of the custom resources in the group version
+func newRESTClient(groupVersion schema.GroupVersion) (*rest.RESTClient, error) {
+	restConfig := rest.CopyConfig(config.GetArenaConfiger().GetRestConfig())
+	restConfig.GroupVersion = &groupVersion
 	restConfig.APIPath = "/apis"
 	restConfig.ContentType = runtime.ContentTypeJSON
 	types := runtime.NewScheme()
 	schemeBuilder := runtime.NewSchemeBuilder(
 		func(scheme *runtime.Scheme) error {
-			metav1.AddToGroupVersion(scheme, istioAPIGroupVersion)
+			metav1.AddToGroupVersion(scheme, groupVersion)
 			return nil
 		})
 	err := schemeBuilder.AddToScheme(types)
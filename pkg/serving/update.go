@@ -136,7 +136,10 @@ func UpdateTensorflowServing(args *types.UpdateTensorFlowServingArgs) error {
 		}
 	}
 
-	return updateDeployment(args.Name, args.Version, deploy)
+	if err := updateDeployment(args.Name, args.Version, deploy); err != nil {
+		return err
+	}
+	return applyDeploymentAutoscaler(deploy, args.ServingAutoscaleArgs)
 }
 
 func UpdateTritonServing(args *types.UpdateTritonServingArgs) error {
@@ -216,7 +219,10 @@ func UpdateTritonServing(args *types.UpdateTritonServingArgs) error {
 		}
 	}
 
-	return updateDeployment(args.Name, args.Version, deploy)
+	if err := updateDeployment(args.Name, args.Version, deploy); err != nil {
+		return err
+	}
+	return applyDeploymentAutoscaler(deploy, args.ServingAutoscaleArgs)
 }
 
 func UpdateCustomServing(args *types.UpdateCustomServingArgs) error {
@@ -272,7 +278,10 @@ func UpdateCustomServing(args *types.UpdateCustomServingArgs) error {
 		deploy.Spec.Template.Spec.Tolerations = tolerations
 	}
 
-	return updateDeployment(args.Name, args.Version, deploy)
+	if err := updateDeployment(args.Name, args.Version, deploy); err != nil {
+		return err
+	}
+	return applyDeploymentAutoscaler(deploy, args.ServingAutoscaleArgs)
 }
 
 func UpdateKServe(args *types.UpdateKServeArgs) error {
@@ -670,8 +679,10 @@ func findAndBuildLWSJob(args *types.UpdateDistributedServingArgs) (*lwsv1.Leader
 }
 
 func updateDeployment(name, version string, deploy *appsv1.Deployment) error {
+	initServingRevisions(deploy)
 	err := kubectl.UpdateDeployment(deploy)
 	if err == nil {
+		recordServingRevision(deploy, "update")
 		log.Infof("The serving job %s with version %s has been updated successfully", name, version)
 	} else {
 		log.Errorf("The serving job %s with version %s update failed", name, version)
@@ -680,23 +691,25 @@ func updateDeployment(name, version string, deploy *appsv1.Deployment) error {
 }
 
 func updateInferenceService(name, version string, inferenceService *kservev1beta1.InferenceService) error {
+	initServingRevisions(inferenceService)
 	err := kubectl.UpdateInferenceService(inferenceService)
 	if err != nil {
 		log.Errorf("The serving job %s with version %s update failed", name, version)
 		return err
 	}
-
+	recordServingRevision(inferenceService, "update")
 	log.Infof("The serving job %s with version %s has been updated successfully", name, version)
 	return nil
 }
 
 func updateLWSJob(name, version string, lwsJob *lwsv1.LeaderWorkerSet) error {
+	initServingRevisions(lwsJob)
 	err := kubectl.UpdateLWSJob(lwsJob)
 	if err != nil {
 		log.Errorf("The serving job %s with version %s update failed", name, version)
 		return err
 	}
-
+	recordServingRevision(lwsJob, "update")
 	log.Infof("The serving job %s with version %s has been updated successfully", name, version)
 	return nil
 }
@@ -66,7 +66,11 @@ func CheckJobIsOwnedByProcesser(labels map[string]string) bool {
 	return true
 }
 
-func ValidateJobsBeforeSubmiting(jobs []ServingJob, name string) error {
+func ValidateJobsBeforeSubmiting(jobs []ServingJob, name string, dryRun types.DryRunStrategy) error {
+	// the existing jobs are not checked when the job is only rendered by the client dry run
+	if dryRun == types.DryRunClient {
+		return nil
+	}
 	if len(jobs) == 0 {
 		log.Debugf("not found serving job %v,we will submit it", name)
 		return nil
@@ -0,0 +1,139 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/k8saccesser"
+)
+
+// watchCoalescePeriod is the period to collect the changes of resources before listing jobs again
+const watchCoalescePeriod = 500 * time.Millisecond
+
+// WatchServingJobs emits the ADDED, MODIFIED and DELETED events of serving jobs until the context is done,
+// all existing jobs are emitted as ADDED at first. The jobs are listed from the cache of k8saccesser again
+// when the pods, deployments or statefulsets are changed, so the cache must be enabled
+func WatchServingJobs(ctx context.Context, namespace string, allNamespace bool, servingType types.ServingJobType, handler func(*types.ServingJobEvent)) error {
+	accesser := k8saccesser.GetK8sResourceAccesser()
+	if accesser == nil || !accesser.IsCacheEnabled() {
+		return fmt.Errorf("watching serving jobs requires the cache of arena client, please create the arena client in daemon mode")
+	}
+	changed, err := accesser.NotifyOnChange(ctx, &corev1.Pod{}, &appsv1.Deployment{}, &appsv1.StatefulSet{})
+	if err != nil {
+		return err
+	}
+	lastJobs := map[string]*types.ServingJobInfo{}
+	lastFingerprints := map[string]string{}
+	for {
+		jobs, err := ListServingJobs(namespace, allNamespace, servingType)
+		if err != nil {
+			return err
+		}
+		currentJobs := map[string]*types.ServingJobInfo{}
+		for _, job := range jobs {
+			jobInfo := job.Convert2JobInfo()
+			key := genServingJobKey(jobInfo)
+			currentJobs[key] = &jobInfo
+			fingerprint := servingJobFingerprint(jobInfo)
+			lastFingerprint, ok := lastFingerprints[key]
+			lastFingerprints[key] = fingerprint
+			switch {
+			case !ok:
+				handler(&types.ServingJobEvent{Type: types.JobAdded, Job: &jobInfo})
+			case lastFingerprint != fingerprint:
+				handler(&types.ServingJobEvent{Type: types.JobModified, Job: &jobInfo})
+			}
+		}
+		deletedKeys := []string{}
+		for key := range lastJobs {
+			if _, ok := currentJobs[key]; !ok {
+				deletedKeys = append(deletedKeys, key)
+			}
+		}
+		sort.Strings(deletedKeys)
+		for _, key := range deletedKeys {
+			handler(&types.ServingJobEvent{Type: types.JobDeleted, Job: lastJobs[key]})
+			delete(lastFingerprints, key)
+		}
+		lastJobs = currentJobs
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-changed:
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(watchCoalescePeriod):
+		}
+	}
+}
+
+// servingJobFingerprint returns a string which is changed only if the job is changed,
+// the ages which are changed over time are ignored
+func servingJobFingerprint(jobInfo types.ServingJobInfo) string {
+	jobInfo.Age = ""
+	instances := []types.ServingInstance{}
+	for _, instance := range jobInfo.Instances {
+		instance.Age = ""
+		instances = append(instances, instance)
+	}
+	jobInfo.Instances = instances
+	data, err := json.Marshal(jobInfo)
+	if err != nil {
+		log.Debugf("failed to marshal the job info of %v: %v", jobInfo.Name, err)
+	}
+	return string(data)
+}
+
+// PrintServingJobEventHeader prints the header of the serving job event table
+func PrintServingJobEventHeader(w io.Writer, allNamespace bool) {
+	header := []string{"EVENT"}
+	if allNamespace {
+		header = append(header, "NAMESPACE")
+	}
+	PrintLine(w, append(header, servingJobListFields...)...)
+}
+
+// PrintServingJobEvent prints the serving job event as a table row
+// or a line of json if the format is json
+func PrintServingJobEvent(w io.Writer, event *types.ServingJobEvent, format types.FormatStyle, allNamespace bool) error {
+	if format == types.JsonFormat {
+		data, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(data))
+		return err
+	}
+	line := []string{string(event.Type)}
+	if allNamespace {
+		line = append(line, event.Job.Namespace)
+	}
+	PrintLine(w, append(line, servingJobListRow(*event.Job)...)...)
+	return nil
+}
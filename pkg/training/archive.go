@@ -0,0 +1,246 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/util/kubeclient"
+)
+
+var (
+	// archiverMountPath is the mount path of pvc in the archiver pod
+	archiverMountPath = "/archive"
+	// archiverTimeout is the timeout of waiting the archiver pod to be running
+	archiverTimeout = 5 * time.Minute
+	// archiverPollInterval is the interval of checking the status of archiver pod
+	archiverPollInterval = 2 * time.Second
+)
+
+// archiveTrainingJob writes the job info, the stored app configmap and the logs of training job into a gzipped tarball,
+// the logs of the previous instance are also written if the container has restarted
+func archiveTrainingJob(job TrainingJob, w io.Writer) error {
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+	info, err := json.MarshalIndent(BuildJobInfo(job, false, nil, nil), "", "    ")
+	if err != nil {
+		return err
+	}
+	if err := addArchiveFile(tw, "job.json", info); err != nil {
+		return err
+	}
+	configmap, err := kubeclient.GetConfigMap(job.Namespace(), fmt.Sprintf("%v-%v", job.Name(), job.Trainer()))
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get the app configmap of job %v: %v", job.Name(), err)
+	}
+	if configmap != nil {
+		keys := []string{}
+		for key := range configmap.Data {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if err := addArchiveFile(tw, path.Join("configmap", key), []byte(configmap.Data[key])); err != nil {
+				return err
+			}
+		}
+	}
+	client := config.GetArenaConfiger().GetClientSet()
+	for _, pod := range job.AllPods() {
+		containers := append([]corev1.Container{}, pod.Spec.InitContainers...)
+		containers = append(containers, pod.Spec.Containers...)
+		restarted := map[string]bool{}
+		statuses := append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...)
+		statuses = append(statuses, pod.Status.ContainerStatuses...)
+		for _, status := range statuses {
+			restarted[status.Name] = status.LastTerminationState.Terminated != nil
+		}
+		for _, container := range containers {
+			logs, err := client.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{Container: container.Name}).DoRaw(context.TODO())
+			if err != nil {
+				log.Debugf("failed to get logs of container %v in pod %v: %v", container.Name, pod.Name, err)
+				continue
+			}
+			if err := addArchiveFile(tw, path.Join("logs", pod.Name, container.Name+".log"), logs); err != nil {
+				return err
+			}
+			if !restarted[container.Name] {
+				continue
+			}
+			// the previous instance of container is kept by kubelet after it restarts
+			logs, err = client.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{Container: container.Name, Previous: true}).DoRaw(context.TODO())
+			if err != nil {
+				log.Debugf("failed to get previous logs of container %v in pod %v: %v", container.Name, pod.Name, err)
+				continue
+			}
+			if err := addArchiveFile(tw, path.Join("logs", pod.Name, container.Name+".previous.log"), logs); err != nil {
+				return err
+			}
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gw.Close()
+}
+
+func addArchiveFile(tw *tar.Writer, name string, data []byte) error {
+	header := &tar.Header{
+		Name:    name,
+		Mode:    0644,
+		Size:    int64(len(data)),
+		ModTime: time.Now(),
+	}
+	if err := tw.WriteHeader(header); err != nil {
+		return err
+	}
+	_, err := tw.Write(data)
+	return err
+}
+
+// archiveFileName returns the file name of the archive of training job
+func archiveFileName(job TrainingJob) string {
+	return fmt.Sprintf("%v-%v-%v-%v.tar.gz", job.Namespace(), job.Name(), job.Trainer(), time.Now().Format("20060102150405"))
+}
+
+// archiveTrainingJobToDir archives the training job into the local directory and returns the path of archive
+func archiveTrainingJobToDir(job TrainingJob, dir string) (string, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+	fileName := filepath.Join(dir, archiveFileName(job))
+	file, err := os.Create(fileName)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	if err := archiveTrainingJob(job, file); err != nil {
+		_ = os.Remove(fileName)
+		return "", err
+	}
+	return fileName, nil
+}
+
+// pvcArchiver writes the archives of training jobs into a pvc through an archiver pod in each namespace
+type pvcArchiver struct {
+	pvc   string
+	image string
+	pods  map[string]*corev1.Pod
+}
+
+func newPVCArchiver(pvc, image string) *pvcArchiver {
+	if image == "" {
+		image = types.DefaultPruneArchiveImage
+	}
+	return &pvcArchiver{pvc: pvc, image: image, pods: map[string]*corev1.Pod{}}
+}
+
+// archive archives the training job into the pvc and returns the path of archive in the pvc
+func (p *pvcArchiver) archive(job TrainingJob) (string, error) {
+	pod, err := p.archiverPod(job.Namespace())
+	if err != nil {
+		return "", err
+	}
+	var buffer bytes.Buffer
+	if err := archiveTrainingJob(job, &buffer); err != nil {
+		return "", err
+	}
+	fileName := archiveFileName(job)
+	command := []string{"sh", "-c", `cat > "$0"`, path.Join(archiverMountPath, fileName)}
+	if _, err := kubeclient.ExecInPod(pod, pod.Spec.Containers[0].Name, command, &buffer); err != nil {
+		return "", fmt.Errorf("failed to write archive %v into pvc %v: %v", fileName, p.pvc, err)
+	}
+	return fmt.Sprintf("pvc://%v/%v", p.pvc, fileName), nil
+}
+
+// archiverPod creates the archiver pod which mounts the pvc and waits it to be running
+func (p *pvcArchiver) archiverPod(namespace string) (*corev1.Pod, error) {
+	if pod, ok := p.pods[namespace]; ok {
+		return pod, nil
+	}
+	client := config.GetArenaConfiger().GetClientSet()
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "arena-archiver-",
+			Namespace:    namespace,
+			Labels:       map[string]string{"createdBy": "arena", "app": "arena-archiver"},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy: corev1.RestartPolicyNever,
+			Containers: []corev1.Container{{
+				Name:         "archiver",
+				Image:        p.image,
+				Command:      []string{"sleep", "infinity"},
+				VolumeMounts: []corev1.VolumeMount{{Name: "archive", MountPath: archiverMountPath}},
+			}},
+			Volumes: []corev1.Volume{{
+				Name: "archive",
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: p.pvc},
+				},
+			}},
+		},
+	}
+	pod, err := client.CoreV1().Pods(namespace).Create(context.TODO(), pod, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create archiver pod in namespace %v: %v", namespace, err)
+	}
+	p.pods[namespace] = pod
+	deadline := time.Now().Add(archiverTimeout)
+	for {
+		current, err := client.CoreV1().Pods(namespace).Get(context.TODO(), pod.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		if current.Status.Phase == corev1.PodRunning {
+			p.pods[namespace] = current
+			return current, nil
+		}
+		if current.Status.Phase == corev1.PodFailed || time.Now().After(deadline) {
+			return nil, fmt.Errorf("archiver pod %v is not running with pvc %v, please check it by 'kubectl describe pod %v -n %v'",
+				pod.Name, p.pvc, pod.Name, namespace)
+		}
+		time.Sleep(archiverPollInterval)
+	}
+}
+
+// close deletes the archiver pods
+func (p *pvcArchiver) close() {
+	client := config.GetArenaConfiger().GetClientSet()
+	for namespace, pod := range p.pods {
+		err := client.CoreV1().Pods(namespace).Delete(context.TODO(), pod.Name, metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			log.Warnf("failed to delete archiver pod %v: %v", pod.Name, err)
+		}
+	}
+}
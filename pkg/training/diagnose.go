@@ -0,0 +1,284 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+// maxFailureEvidence is the max number of evidence kept for each cause
+const maxFailureEvidence = 5
+
+// failureWeights ranks the causes, a cause which usually leads to the others has a higher weight,
+// e.g. when a worker is OOMKilled, the other workers fail with NCCL errors and non-zero exit codes
+var failureWeights = map[types.TrainingJobFailureReason]int{
+	types.OOMKilledFailure:             100,
+	types.CUDAOutOfMemoryFailure:       95,
+	types.ImagePullFailure:             90,
+	types.InsufficientGPUFailure:       85,
+	types.InsufficientGPUMemoryFailure: 85,
+	types.EvictedFailure:               80,
+	types.UnschedulableFailure:         70,
+	types.NCCLFailure:                  60,
+	types.CUDAFailure:                  55,
+	types.NonZeroExitCodeFailure:       30,
+}
+
+var failureSuggestions = map[types.TrainingJobFailureReason]string{
+	types.OOMKilledFailure:             "The container exceeded its memory limit, increase the memory of the job or reduce the batch size.",
+	types.CUDAOutOfMemoryFailure:       "The GPU memory is exhausted, reduce the batch size or the model size, or use the GPUs with more memory.",
+	types.ImagePullFailure:             "The image can not be pulled, check the image name and the image pull secrets.",
+	types.InsufficientGPUFailure:       "No node has enough nvidia.com/gpu, reduce the gpus of the job or wait for the running jobs to finish.",
+	types.InsufficientGPUMemoryFailure: "No node has enough aliyun.com/gpu-mem for gpushare, reduce the gpu memory of the job.",
+	types.EvictedFailure:               "The pod was evicted by kubelet, usually because the node ran out of memory or disk.",
+	types.UnschedulableFailure:         "The pod can not be scheduled, check the node selectors, tolerations and resources of the job.",
+	types.NCCLFailure:                  "The collective communication failed, check the network between workers or whether another worker crashed first.",
+	types.CUDAFailure:                  "The CUDA runtime reported an error, check the driver and CUDA versions of the image and the node.",
+	types.NonZeroExitCodeFailure:       "The training process exited with error, check the logs of the instance.",
+}
+
+// failureLogSignatures are matched with the log tail of containers, a line only matches the first signature
+var failureLogSignatures = []struct {
+	reason  types.TrainingJobFailureReason
+	pattern *regexp.Regexp
+}{
+	{types.CUDAOutOfMemoryFailure, regexp.MustCompile(`CUDA out of memory|CUDA error: out of memory|cudaErrorMemoryAllocation|CUBLAS_STATUS_ALLOC_FAILED|OutOfMemoryError`)},
+	{types.NCCLFailure, regexp.MustCompile(`NCCL (WARN|ERROR)|NCCL error|nccl(System|Internal|UnhandledCuda|Remote|InvalidUsage)Error|Watchdog caught collective operation timeout`)},
+	{types.CUDAFailure, regexp.MustCompile(`CUDA error|cudaError[A-Z]\w*|CUDNN_STATUS_[A-Z_]+|CUBLAS_STATUS_[A-Z_]+|device-side assert triggered|no CUDA-capable device`)},
+}
+
+// exitCodeMeanings explains the common exit codes of containers
+var exitCodeMeanings = map[int32]string{
+	1:   "general error",
+	134: "aborted",
+	137: "killed by SIGKILL",
+	139: "segmentation fault",
+	143: "terminated by SIGTERM",
+}
+
+// DiagnoseTrainingJob inspects the pods, events and log tails of training job and returns the likely causes of failure
+func DiagnoseTrainingJob(jobName, namespace string, jobType types.TrainingJobType, tailLines int64) (*types.TrainingJobDiagnosis, error) {
+	job, err := SearchTrainingJob(jobName, namespace, jobType)
+	if err != nil {
+		return nil, err
+	}
+	client := config.GetArenaConfiger().GetClientSet()
+	pods := job.AllPods()
+	uids := map[string]bool{}
+	for _, pod := range pods {
+		uids[string(pod.UID)] = true
+	}
+	events := []corev1.Event{}
+	eventList, err := client.CoreV1().Events(namespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		log.Debugf("failed to list events of job %v: %v", jobName, err)
+	} else {
+		for _, e := range eventList.Items {
+			if uids[string(e.InvolvedObject.UID)] {
+				events = append(events, e)
+			}
+		}
+	}
+	logs := map[string]string{}
+	for _, pod := range pods {
+		statuses := append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...)
+		statuses = append(statuses, pod.Status.ContainerStatuses...)
+		for _, status := range statuses {
+			if status.State.Waiting != nil && status.LastTerminationState.Terminated == nil {
+				continue
+			}
+			// the logs of the crashed instance are kept as the previous logs after the container restarts
+			previous := status.State.Terminated == nil && status.LastTerminationState.Terminated != nil
+			options := &corev1.PodLogOptions{Container: status.Name, TailLines: &tailLines, Previous: previous}
+			content, err := client.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, options).DoRaw(context.TODO())
+			if err != nil {
+				log.Debugf("failed to get logs of container %v in pod %v: %v", status.Name, pod.Name, err)
+				continue
+			}
+			logs[pod.Name+"/"+status.Name] = string(content)
+		}
+	}
+	return &types.TrainingJobDiagnosis{
+		Name:      job.Name(),
+		Namespace: job.Namespace(),
+		Trainer:   job.Trainer(),
+		Status:    types.TrainingJobStatus(GetJobRealStatus(job)),
+		Causes:    diagnoseTrainingPods(pods, events, logs),
+	}, nil
+}
+
+// diagnoseTrainingPods finds the likely causes from the status, the events and the logs of pods,
+// the logs are indexed by <pod>/<container>
+func diagnoseTrainingPods(pods []*corev1.Pod, events []corev1.Event, logs map[string]string) []types.TrainingJobFailureCause {
+	causes := []types.TrainingJobFailureCause{}
+	add := func(reason types.TrainingJobFailureReason, instance, evidence string) {
+		for i := range causes {
+			if causes[i].Reason != reason {
+				continue
+			}
+			if !containsString(causes[i].Instances, instance) {
+				causes[i].Instances = append(causes[i].Instances, instance)
+			}
+			if !containsString(causes[i].Evidence, evidence) && len(causes[i].Evidence) < maxFailureEvidence {
+				causes[i].Evidence = append(causes[i].Evidence, evidence)
+			}
+			return
+		}
+		causes = append(causes, types.TrainingJobFailureCause{
+			Reason:     reason,
+			Suggestion: failureSuggestions[reason],
+			Instances:  []string{instance},
+			Evidence:   []string{evidence},
+		})
+	}
+	for _, pod := range pods {
+		if pod.Status.Reason == "Evicted" {
+			add(types.EvictedFailure, pod.Name, fmt.Sprintf("pod/%v: %v", pod.Name, pod.Status.Message))
+		}
+		for _, c := range pod.Status.Conditions {
+			if c.Type == corev1.PodScheduled && c.Status == corev1.ConditionFalse && c.Message != "" {
+				add(unschedulableFailure(c.Message), pod.Name, fmt.Sprintf("pod/%v: %v", pod.Name, c.Message))
+			}
+		}
+		for _, e := range events {
+			if e.InvolvedObject.UID == pod.UID && e.Reason == "FailedScheduling" {
+				add(unschedulableFailure(e.Message), pod.Name, fmt.Sprintf("pod/%v: %v", pod.Name, strings.TrimSpace(e.Message)))
+			}
+		}
+		statuses := append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...)
+		statuses = append(statuses, pod.Status.ContainerStatuses...)
+		for _, status := range statuses {
+			prefix := fmt.Sprintf("pod/%v container %v", pod.Name, status.Name)
+			if waiting := status.State.Waiting; waiting != nil {
+				switch waiting.Reason {
+				case "ErrImagePull", "ImagePullBackOff", "InvalidImageName":
+					add(types.ImagePullFailure, pod.Name, fmt.Sprintf("%v: %v %v", prefix, waiting.Reason, waiting.Message))
+				}
+			}
+			terminated := status.State.Terminated
+			if terminated == nil {
+				terminated = status.LastTerminationState.Terminated
+			}
+			switch {
+			case terminated == nil:
+			case terminated.Reason == "OOMKilled":
+				add(types.OOMKilledFailure, pod.Name, fmt.Sprintf("%v: terminated with OOMKilled, exit code %v", prefix, terminated.ExitCode))
+			case terminated.ExitCode != 0:
+				evidence := fmt.Sprintf("%v: exited with code %v", prefix, terminated.ExitCode)
+				if meaning, ok := exitCodeMeanings[terminated.ExitCode]; ok {
+					evidence = fmt.Sprintf("%v (%v)", evidence, meaning)
+				}
+				add(types.NonZeroExitCodeFailure, pod.Name, evidence)
+			}
+			scanner := bufio.NewScanner(strings.NewReader(logs[pod.Name+"/"+status.Name]))
+			scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+			for scanner.Scan() {
+				line := strings.TrimSpace(scanner.Text())
+				for _, signature := range failureLogSignatures {
+					if signature.pattern.MatchString(line) {
+						add(signature.reason, pod.Name, fmt.Sprintf("%v: %v", prefix, truncateLogLine(line)))
+						break
+					}
+				}
+			}
+		}
+	}
+	for i := range causes {
+		bonus := len(causes[i].Instances)
+		if bonus > 4 {
+			bonus = 4
+		}
+		causes[i].Score = failureWeights[causes[i].Reason] + bonus
+	}
+	sort.SliceStable(causes, func(i, j int) bool {
+		return causes[i].Score > causes[j].Score
+	})
+	return causes
+}
+
+// unschedulableFailure classifies the message of scheduler
+func unschedulableFailure(message string) types.TrainingJobFailureReason {
+	switch {
+	case strings.Contains(message, "Insufficient "+types.NvidiaGPUResourceName):
+		return types.InsufficientGPUFailure
+	case strings.Contains(message, "Insufficient "+types.GPUShareResourceName):
+		return types.InsufficientGPUMemoryFailure
+	}
+	return types.UnschedulableFailure
+}
+
+func truncateLogLine(line string) string {
+	if len(line) > 300 {
+		return line[:300] + "..."
+	}
+	return line
+}
+
+func containsString(items []string, item string) bool {
+	for _, i := range items {
+		if i == item {
+			return true
+		}
+	}
+	return false
+}
+
+// PrintTrainingJobDiagnosis prints the likely causes of the failure of training job
+func PrintTrainingJobDiagnosis(diagnosis *types.TrainingJobDiagnosis, format types.FormatStyle) {
+	switch format {
+	case types.JsonFormat:
+		data, _ := json.MarshalIndent(diagnosis, "", "    ")
+		fmt.Printf("%v\n", string(data))
+		return
+	case types.YamlFormat:
+		data, _ := yaml.Marshal(diagnosis)
+		fmt.Printf("%v", string(data))
+		return
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Name:\t%v\n", diagnosis.Name)
+	fmt.Fprintf(w, "Namespace:\t%v\n", diagnosis.Namespace)
+	fmt.Fprintf(w, "Type:\t%v\n", diagnosis.Trainer)
+	fmt.Fprintf(w, "Status:\t%v\n", diagnosis.Status)
+	_ = w.Flush()
+	fmt.Printf("\nLikely Causes:\n")
+	if len(diagnosis.Causes) == 0 {
+		fmt.Printf("  No known failure signature is found, please check the logs by 'arena logs %v'.\n", diagnosis.Name)
+		return
+	}
+	for i, cause := range diagnosis.Causes {
+		fmt.Printf("  %v. %v (score: %v, instances: %v)\n", i+1, cause.Reason, cause.Score, strings.Join(cause.Instances, ","))
+		fmt.Printf("     %v\n", cause.Suggestion)
+		for _, evidence := range cause.Evidence {
+			fmt.Printf("     - %v\n", evidence)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+func TestDiagnoseTrainingPods(t *testing.T) {
+	terminatedPod := func(name string, terminated *corev1.ContainerStateTerminated) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodFailed,
+				ContainerStatuses: []corev1.ContainerStatus{{
+					Name:  "pytorch",
+					State: corev1.ContainerState{Terminated: terminated},
+				}},
+			},
+		}
+	}
+	pods := []*corev1.Pod{
+		terminatedPod("job-master-0", &corev1.ContainerStateTerminated{Reason: "Error", ExitCode: 1}),
+		terminatedPod("job-worker-0", &corev1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137}),
+		terminatedPod("job-worker-1", &corev1.ContainerStateTerminated{Reason: "Error", ExitCode: 1}),
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "job-worker-2", UID: "uid-2"},
+			Status:     corev1.PodStatus{Phase: corev1.PodPending},
+		},
+	}
+	events := []corev1.Event{{
+		InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "job-worker-2", UID: "uid-2"},
+		Reason:         "FailedScheduling",
+		Message:        "0/4 nodes are available: 4 Insufficient nvidia.com/gpu.",
+	}}
+	logs := map[string]string{
+		"job-master-0/pytorch": "epoch 3\n[E ProcessGroupNCCL.cpp:828] Watchdog caught collective operation timeout\n",
+		"job-worker-1/pytorch": "NCCL WARN NET/Socket : peer closed connection\nRuntimeError: NCCL error: unhandled system error\n",
+	}
+	causes := diagnoseTrainingPods(pods, events, logs)
+	expected := []struct {
+		reason    types.TrainingJobFailureReason
+		instances int
+	}{
+		{types.OOMKilledFailure, 1},
+		{types.InsufficientGPUFailure, 1},
+		{types.NCCLFailure, 2},
+		{types.NonZeroExitCodeFailure, 2},
+	}
+	if len(causes) != len(expected) {
+		t.Fatalf("expected %v causes, got %+v", len(expected), causes)
+	}
+	for i, e := range expected {
+		if causes[i].Reason != e.reason || len(causes[i].Instances) != e.instances {
+			t.Errorf("cause %v: expected %v with %v instances, got %+v", i, e.reason, e.instances, causes[i])
+		}
+	}
+	if len(causes[2].Evidence) != 3 {
+		t.Errorf("expected 3 log lines as the evidence of NCCL error, got %v", causes[2].Evidence)
+	}
+}
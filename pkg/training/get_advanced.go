@@ -21,9 +21,11 @@ import (
 	"github.com/kubeflow/arena/pkg/apis/types"
 	"github.com/kubeflow/arena/pkg/apis/utils"
 	"github.com/kubeflow/arena/pkg/prometheus"
+	"github.com/kubeflow/arena/pkg/queue"
 	"github.com/kubeflow/arena/pkg/util/kubeclient"
 	log "github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -158,7 +160,8 @@ func getPriorityClass(job TrainingJob) string {
 }
 
 // Get real job status
-// WHen has pods being pending, tfJob still show in Running state, it should be Pending
+// WHen has pods being pending, tfJob still show in Running state, it should be Pending,
+// and the pending job which is waiting for the admission of kueue is Queuing
 func GetJobRealStatus(job TrainingJob) string {
 	hasPendingPod := false
 	jobStatus := job.GetStatus()
@@ -175,9 +178,22 @@ func GetJobRealStatus(job TrainingJob) string {
 			jobStatus = "PENDING"
 		}
 	}
+	// kueue suspends the jobs until they are admitted
+	if (jobStatus == string(types.TrainingJobPending) || jobStatus == string(types.TrainingJobSuspended)) && isQueuedByKueue(job) {
+		jobStatus = string(types.TrainingJobQueuing)
+	}
 	return jobStatus
 }
 
+// isQueuedByKueue returns true if the job is submitted to a kueue LocalQueue and not admitted yet
+func isQueuedByKueue(job TrainingJob) bool {
+	obj, ok := job.GetTrainJob().(metav1.Object)
+	if !ok || obj.GetLabels()[types.KueueQueueNameLabel] == "" {
+		return false
+	}
+	return queue.IsJobQueuing(job.Namespace(), job.Uid())
+}
+
 func GetJobGpuMetric(client *kubernetes.Clientset, job TrainingJob) (jobMetric prometheus.JobGpuMetric, err error) {
 	runningPods := []string{}
 	jobStatus := job.GetStatus()
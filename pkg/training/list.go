@@ -108,43 +108,9 @@ func DisplayTrainingJobList(jobInfoList []TrainingJob, format string, allNamespa
 			jobInfos = append(jobInfos, BuildJobInfo(jobInfo, false, services, nodes))
 		}
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		header := []string{}
-		if allNamespaces {
-			header = append(header, "NAMESPACE")
-		}
-		header = append(header, []string{"NAME", "STATUS", "TRAINER", "DURATION", "GPU(Requested)", "GPU(Allocated)", "NODE"}...)
-		PrintLine(w, header...)
+		PrintLine(w, trainingJobListHeader(allNamespaces)...)
 		for _, jobInfo := range jobInfos {
-			hostIP := "N/A"
-			for _, i := range jobInfo.Instances {
-				if i.IsChief {
-					hostIP = i.NodeIP
-				}
-			}
-			items := []string{}
-			if allNamespaces {
-				items = append(items, jobInfo.Namespace)
-			}
-			jobInfo.Duration = strings.ReplaceAll(jobInfo.Duration, "s", "")
-			duration, err := strconv.ParseInt(jobInfo.Duration, 10, 64)
-			if err != nil {
-				log.Debugf("failed to parse duration: %v", err)
-
-			}
-			allocatedGPUs := "N/A"
-			if jobInfo.Status == types.TrainingJobPending || jobInfo.Status == types.TrainingJobRunning {
-				allocatedGPUs = fmt.Sprintf("%v", jobInfo.AllocatedGPU)
-			}
-			items = append(items, []string{
-				jobInfo.Name,
-				fmt.Sprintf("%v", jobInfo.Status),
-				strings.ToUpper(string(jobInfo.Trainer)),
-				util.ShortHumanDuration(time.Duration(duration) * time.Second),
-				fmt.Sprintf("%v", jobInfo.RequestGPU),
-				allocatedGPUs,
-				hostIP,
-			}...)
-			PrintLine(w, items...)
+			PrintLine(w, trainingJobListRow(jobInfo, allNamespaces)...)
 		}
 		_ = w.Flush()
 		return
@@ -152,6 +118,46 @@ func DisplayTrainingJobList(jobInfoList []TrainingJob, format string, allNamespa
 	}
 }
 
+// trainingJobListHeader returns the header of the training job table
+func trainingJobListHeader(allNamespaces bool) []string {
+	header := []string{}
+	if allNamespaces {
+		header = append(header, "NAMESPACE")
+	}
+	return append(header, []string{"NAME", "STATUS", "TRAINER", "DURATION", "GPU(Requested)", "GPU(Allocated)", "NODE"}...)
+}
+
+// trainingJobListRow returns the row of the training job table
+func trainingJobListRow(jobInfo *types.TrainingJobInfo, allNamespaces bool) []string {
+	hostIP := "N/A"
+	for _, i := range jobInfo.Instances {
+		if i.IsChief {
+			hostIP = i.NodeIP
+		}
+	}
+	items := []string{}
+	if allNamespaces {
+		items = append(items, jobInfo.Namespace)
+	}
+	duration, err := strconv.ParseInt(strings.ReplaceAll(jobInfo.Duration, "s", ""), 10, 64)
+	if err != nil {
+		log.Debugf("failed to parse duration: %v", err)
+	}
+	allocatedGPUs := "N/A"
+	if jobInfo.Status == types.TrainingJobPending || jobInfo.Status == types.TrainingJobRunning {
+		allocatedGPUs = fmt.Sprintf("%v", jobInfo.AllocatedGPU)
+	}
+	return append(items, []string{
+		jobInfo.Name,
+		fmt.Sprintf("%v", jobInfo.Status),
+		strings.ToUpper(string(jobInfo.Trainer)),
+		util.ShortHumanDuration(time.Duration(duration) * time.Second),
+		fmt.Sprintf("%v", jobInfo.RequestGPU),
+		allocatedGPUs,
+		hostIP,
+	}...)
+}
+
 func PrintLine(w io.Writer, fields ...string) {
 	//w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 	buffer := strings.Join(fields, "\t")
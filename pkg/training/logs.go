@@ -37,6 +37,9 @@ func AcceptJobLog(jobName string, trainingType types.TrainingJobType, args *type
 	if err != nil {
 		return err
 	}
+	if args.AllInstances || args.AllContainers || args.Role != "" || args.Grep != "" {
+		return streamJobLogs(job, args)
+	}
 	// 3.if instance name not set,set the chief pod name to instance name
 	if args.InstanceName == "" {
 		name, err := getInstanceName(job)
@@ -0,0 +1,171 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+// ExportTrainingJobLogs writes the job info and the logs of all the instances of training job into a tarball,
+// the tarball is stored into the local directory or the s3 url like s3://bucket/prefix and its location is returned
+func ExportTrainingJobLogs(name, namespace string, jobType types.TrainingJobType, to string) (string, error) {
+	if to == "" {
+		return "", fmt.Errorf("not set the destination of logs, please set it by --to")
+	}
+	job, err := SearchTrainingJob(name, namespace, jobType)
+	if err != nil {
+		return "", err
+	}
+	if len(job.AllPods()) == 0 {
+		return "", fmt.Errorf("not found instances of the job %v, the logs may be garbage-collected", name)
+	}
+	return exportTrainingJobLogs(job, to)
+}
+
+func exportTrainingJobLogs(job TrainingJob, to string) (string, error) {
+	if !isS3Destination(to) {
+		return archiveTrainingJobToDir(job, to)
+	}
+	dest, err := parseS3Destination(to)
+	if err != nil {
+		return "", err
+	}
+	reader, writer := io.Pipe()
+	go func() {
+		writer.CloseWithError(archiveTrainingJob(job, writer))
+	}()
+	location, err := dest.upload(archiveFileName(job), reader)
+	// stop the archiving if the uploading fails
+	_ = reader.Close()
+	return location, err
+}
+
+// exportLogsTarget returns the destination set by the option --export-logs-to when the job is submitted,
+// it is read from the job first and then from the pods of job
+func exportLogsTarget(job TrainingJob) string {
+	if obj, ok := job.GetTrainJob().(metav1.Object); ok && obj.GetAnnotations()[types.ExportLogsToAnnotation] != "" {
+		return obj.GetAnnotations()[types.ExportLogsToAnnotation]
+	}
+	for _, pod := range job.AllPods() {
+		if to := pod.Annotations[types.ExportLogsToAnnotation]; to != "" {
+			return to
+		}
+	}
+	return ""
+}
+
+// exportLogsOnWait exports the logs of the finished training job seen by 'arena wait' if it's submitted
+// with --export-logs-to, the location of exported logs is returned
+func exportLogsOnWait(job TrainingJob) string {
+	to := exportLogsTarget(job)
+	if to == "" {
+		return ""
+	}
+	location, err := exportTrainingJobLogs(job, to)
+	if err != nil {
+		log.Warnf("failed to export the logs of job %v to %v: %v", job.Name(), to, err)
+		return ""
+	}
+	log.Debugf("the logs of job %v are exported to %v", job.Name(), location)
+	return location
+}
+
+func isS3Destination(to string) bool {
+	return strings.Contains(to, "://")
+}
+
+// s3Destination is the bucket and the key prefix of s3-compatible storage which stores the exported logs
+type s3Destination struct {
+	endpoint string
+	bucket   string
+	prefix   string
+}
+
+// parseS3Destination parses the url like s3://bucket/prefix, the endpoint of s3-compatible storages
+// like minio is read from the environment variable AWS_ENDPOINT_URL if it is set
+func parseS3Destination(to string) (*s3Destination, error) {
+	u, err := url.Parse(to)
+	if err != nil {
+		return nil, fmt.Errorf("invalid destination %v: %v", to, err)
+	}
+	if u.Scheme != "s3" {
+		return nil, fmt.Errorf("unsupported destination %v, only support an absolute path or s3://bucket/prefix", to)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("not set the bucket in destination %v", to)
+	}
+	return &s3Destination{
+		endpoint: os.Getenv("AWS_ENDPOINT_URL"),
+		bucket:   u.Host,
+		prefix:   strings.Trim(u.Path, "/"),
+	}, nil
+}
+
+func (d *s3Destination) key(fileName string) string {
+	return path.Join(d.prefix, fileName)
+}
+
+// upload uploads the content with the credentials of the default aws credential chain, like the environment
+// variables AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY, and returns the s3 url of the object
+func (d *s3Destination) upload(fileName string, body io.Reader) (string, error) {
+	ctx := context.TODO()
+	options := []func(*awsconfig.LoadOptions) error{}
+	if os.Getenv("AWS_REGION") == "" {
+		region := os.Getenv("AWS_DEFAULT_REGION")
+		if region == "" {
+			region = "us-east-1"
+		}
+		options = append(options, awsconfig.WithRegion(region))
+	}
+	cfg, err := awsconfig.LoadDefaultConfig(ctx, options...)
+	if err != nil {
+		return "", fmt.Errorf("failed to load aws config: %v", err)
+	}
+	client := s3.NewFromConfig(cfg, func(o *s3.Options) {
+		if d.endpoint == "" {
+			return
+		}
+		// the s3-compatible storages like minio are accessed by path style,
+		// and some of them do not accept the checksums which are not required
+		o.BaseEndpoint = aws.String(d.endpoint)
+		o.UsePathStyle = true
+		o.RequestChecksumCalculation = aws.RequestChecksumCalculationWhenRequired
+	})
+	key := d.key(fileName)
+	_, err = manager.NewUploader(client).Upload(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(d.bucket),
+		Key:    aws.String(key),
+		Body:   body,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to upload %v into bucket %v: %v", key, d.bucket, err)
+	}
+	return fmt.Sprintf("s3://%v/%v", d.bucket, key), nil
+}
@@ -0,0 +1,71 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"testing"
+)
+
+func TestParseS3Destination(t *testing.T) {
+	t.Setenv("AWS_ENDPOINT_URL", "http://minio.minio:9000")
+	testcases := []struct {
+		to       string
+		expected *s3Destination
+		key      string
+		hasErr   bool
+	}{
+		{
+			to:       "s3://logs/arena/jobs",
+			expected: &s3Destination{endpoint: "http://minio.minio:9000", bucket: "logs", prefix: "arena/jobs"},
+			key:      "arena/jobs/job.tar.gz",
+		},
+		{
+			to:       "s3://logs",
+			expected: &s3Destination{endpoint: "http://minio.minio:9000", bucket: "logs"},
+			key:      "job.tar.gz",
+		},
+		{
+			to:     "http://minio.minio:9000/logs/arena/",
+			hasErr: true,
+		},
+		{
+			to:     "s3:///arena",
+			hasErr: true,
+		},
+		{
+			to:     "ftp://logs/arena",
+			hasErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		dest, err := parseS3Destination(tc.to)
+		if tc.hasErr {
+			if err == nil {
+				t.Errorf("%v: expected error, got %+v", tc.to, dest)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v: unexpected error: %v", tc.to, err)
+			continue
+		}
+		if *dest != *tc.expected {
+			t.Errorf("%v: expected %+v, got %+v", tc.to, tc.expected, dest)
+		}
+		if key := dest.key("job.tar.gz"); key != tc.key {
+			t.Errorf("%v: expected key %v, got %v", tc.to, tc.key, key)
+		}
+	}
+}
@@ -0,0 +1,309 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+var (
+	// logStreamPollInterval is the interval of checking the restarted containers and the new instances when following logs
+	logStreamPollInterval = 3 * time.Second
+	// logPrefixColors are the ANSI colors of the instance prefixes
+	logPrefixColors = []int{32, 33, 34, 35, 36, 91, 92, 93, 94, 95, 96}
+)
+
+// jobLogStreamer streams the logs of all the instances of a training job and interleaves their lines with prefixes
+type jobLogStreamer struct {
+	job       TrainingJob
+	args      *types.LogArgs
+	clientset kubernetes.Interface
+	grep      *regexp.Regexp
+	colored   bool
+
+	// locker protects the writer and the states of streams
+	locker  sync.Mutex
+	streams map[string]bool
+	colors  map[string]int
+	active  int
+	wg      sync.WaitGroup
+}
+
+// streamJobLogs streams the logs of the instances of the job with prefixes, the new instances and
+// the restarted containers are streamed too when following the logs
+func streamJobLogs(job TrainingJob, args *types.LogArgs) error {
+	if args.AllInstances && args.InstanceName != "" {
+		return fmt.Errorf("--instance can not be used with --all")
+	}
+	// only the chief instance is streamed if neither --all nor --role is set
+	if !args.AllInstances && args.Role == "" && args.InstanceName == "" {
+		name, err := getInstanceName(job)
+		if err != nil {
+			return err
+		}
+		args.InstanceName = name
+	}
+	streamer := &jobLogStreamer{
+		job:       job,
+		args:      args,
+		clientset: config.GetArenaConfiger().GetClientSet(),
+		streams:   map[string]bool{},
+		colors:    map[string]int{},
+	}
+	if args.Grep != "" {
+		grep, err := regexp.Compile(args.Grep)
+		if err != nil {
+			return fmt.Errorf("invalid --grep %v: %v", args.Grep, err)
+		}
+		streamer.grep = grep
+	}
+	if file, ok := args.WriterCloser.(*os.File); ok {
+		if stat, err := file.Stat(); err == nil && stat.Mode()&os.ModeCharDevice != 0 {
+			streamer.colored = true
+		}
+	}
+	if streamer.start(job.AllPods()) == 0 {
+		switch {
+		case args.InstanceName != "":
+			return fmt.Errorf("invalid instance name %v in job %v,please use 'arena get %v' to make sure instance name",
+				args.InstanceName, job.Name(), job.Name())
+		case args.Role != "":
+			return fmt.Errorf("not found instances with role %v in the job %v", args.Role, job.Name())
+		case !args.Follow:
+			return fmt.Errorf("not found instances of the job %v", job.Name())
+		}
+	}
+	if args.Follow {
+		streamer.watch()
+	}
+	streamer.wg.Wait()
+	return nil
+}
+
+// watch starts the streams of new instances until the job is finished and all the streams are ended
+func (s *jobLogStreamer) watch() {
+	for {
+		time.Sleep(logStreamPollInterval)
+		job, err := SearchTrainingJob(s.job.Name(), s.job.Namespace(), s.job.Trainer())
+		if err != nil {
+			log.Debugf("stop watching the instances of job %v: %v", s.job.Name(), err)
+			return
+		}
+		s.start(job.AllPods())
+		status := types.TrainingJobStatus(GetJobRealStatus(job))
+		s.locker.Lock()
+		active := s.active
+		s.locker.Unlock()
+		if active == 0 && (status == types.TrainingJobSucceeded || status == types.TrainingJobFailed) {
+			return
+		}
+	}
+}
+
+// start starts the streams of the containers which are not streamed yet and returns the number of matched instances
+func (s *jobLogStreamer) start(pods []*corev1.Pod) int {
+	matched := 0
+	for _, pod := range pods {
+		if s.args.InstanceName != "" && pod.Name != s.args.InstanceName {
+			continue
+		}
+		if !matchInstanceRole(pod, s.job.Name(), s.args.Role) {
+			continue
+		}
+		matched++
+		containers := logContainers(pod, s.args.ContainerName, s.args.AllContainers)
+		for _, container := range containers {
+			key := string(pod.UID) + "/" + container
+			s.locker.Lock()
+			if s.streams[key] {
+				s.locker.Unlock()
+				continue
+			}
+			s.streams[key] = true
+			s.active++
+			prefix := instanceLabel(s.job.Name(), pod.Name)
+			if len(containers) > 1 {
+				prefix = prefix + "/" + container
+			}
+			if _, ok := s.colors[prefix]; !ok {
+				s.colors[prefix] = logPrefixColors[len(s.colors)%len(logPrefixColors)]
+			}
+			color := s.colors[prefix]
+			s.locker.Unlock()
+			s.wg.Add(1)
+			go s.stream(pod, container, prefix, color)
+		}
+	}
+	return matched
+}
+
+// stream copies the logs of the container, and streams it again after the container restarts when following
+func (s *jobLogStreamer) stream(pod *corev1.Pod, container, prefix string, color int) {
+	defer func() {
+		s.locker.Lock()
+		s.active--
+		s.locker.Unlock()
+		s.wg.Done()
+	}()
+	options := &corev1.PodLogOptions{
+		Container:    container,
+		Follow:       s.args.Follow,
+		Timestamps:   s.args.Timestamps,
+		SinceSeconds: s.args.SinceSeconds,
+		SinceTime:    s.args.SinceTime,
+		TailLines:    s.args.Tail,
+	}
+	for {
+		reader, err := s.clientset.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, options).Stream(context.TODO())
+		if err != nil {
+			log.Debugf("failed to stream logs of container %v in pod %v: %v", container, pod.Name, err)
+		} else {
+			s.copyLines(reader, prefix, color)
+			reader.Close()
+		}
+		if !s.args.Follow {
+			return
+		}
+		// the stream is ended when the container terminates, continue from now on after it restarts
+		since := metav1.Now()
+		if !s.waitContainerRunning(pod, container) {
+			return
+		}
+		options.SinceSeconds = nil
+		options.TailLines = nil
+		options.SinceTime = &since
+	}
+}
+
+// waitContainerRunning returns true when the container is running again, or false if it will never run again
+func (s *jobLogStreamer) waitContainerRunning(pod *corev1.Pod, container string) bool {
+	for {
+		current, err := s.clientset.CoreV1().Pods(pod.Namespace).Get(context.TODO(), pod.Name, metav1.GetOptions{})
+		if k8serrors.IsNotFound(err) || (err == nil && current.UID != pod.UID) {
+			// the new instance with the same name is streamed by watch
+			return false
+		}
+		if err == nil {
+			if current.Status.Phase == corev1.PodSucceeded || current.Status.Phase == corev1.PodFailed {
+				return false
+			}
+			statuses := append([]corev1.ContainerStatus{}, current.Status.InitContainerStatuses...)
+			statuses = append(statuses, current.Status.ContainerStatuses...)
+			for _, status := range statuses {
+				if status.Name != container {
+					continue
+				}
+				if status.State.Running != nil {
+					return true
+				}
+				if status.State.Terminated != nil && current.Spec.RestartPolicy == corev1.RestartPolicyNever {
+					return false
+				}
+			}
+		} else {
+			log.Debugf("failed to get pod %v: %v", pod.Name, err)
+		}
+		time.Sleep(logStreamPollInterval)
+	}
+}
+
+// copyLines writes the lines which match --grep with the prefix of instance
+func (s *jobLogStreamer) copyLines(reader io.Reader, prefix string, color int) {
+	label := fmt.Sprintf("[%v]", prefix)
+	if s.colored {
+		label = fmt.Sprintf("\x1b[%dm%v\x1b[0m", color, label)
+	}
+	buffered := bufio.NewReader(reader)
+	for {
+		line, err := buffered.ReadString('\n')
+		if line != "" && (s.grep == nil || s.grep.MatchString(line)) {
+			if !strings.HasSuffix(line, "\n") {
+				line += "\n"
+			}
+			s.locker.Lock()
+			_, _ = fmt.Fprintf(s.args.WriterCloser, "%v %v", label, line)
+			s.locker.Unlock()
+		}
+		if err != nil {
+			if err != io.EOF {
+				log.Debugf("failed to read logs of %v: %v", prefix, err)
+			}
+			return
+		}
+	}
+}
+
+// instanceLabel returns the short name of instance, like worker-3 for the pod pytorch-dist-worker-3
+func instanceLabel(jobName, podName string) string {
+	if label := strings.TrimPrefix(podName, jobName+"-"); label != "" {
+		return label
+	}
+	return podName
+}
+
+// matchInstanceRole checks the replica type labels of the instance, or the role in its name
+func matchInstanceRole(pod *corev1.Pod, jobName, role string) bool {
+	if role == "" {
+		return true
+	}
+	for _, key := range []string{TrainingReplicaTypeLabel, pytorchReplicaTypeLabel, tfReplicaTypeLabel} {
+		if value, ok := pod.Labels[key]; ok {
+			return strings.EqualFold(value, role)
+		}
+	}
+	label := instanceLabel(jobName, pod.Name)
+	return label == role || strings.HasPrefix(label, role+"-")
+}
+
+// logContainers returns the containers to stream logs, the default container is the first container
+func logContainers(pod *corev1.Pod, container string, allContainers bool) []string {
+	if container != "" {
+		return []string{container}
+	}
+	if !allContainers {
+		if name := pod.Annotations["kubectl.kubernetes.io/default-container"]; name != "" {
+			return []string{name}
+		}
+		if len(pod.Spec.Containers) == 0 {
+			return nil
+		}
+		return []string{pod.Spec.Containers[0].Name}
+	}
+	containers := []string{}
+	for _, c := range pod.Spec.InitContainers {
+		containers = append(containers, c.Name)
+	}
+	for _, c := range pod.Spec.Containers {
+		containers = append(containers, c.Name)
+	}
+	return containers
+}
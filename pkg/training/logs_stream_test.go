@@ -0,0 +1,71 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+type nopWriteCloser struct {
+	*bytes.Buffer
+}
+
+func (nopWriteCloser) Close() error { return nil }
+
+func TestJobLogStreamerCopyLines(t *testing.T) {
+	out := nopWriteCloser{&bytes.Buffer{}}
+	streamer := &jobLogStreamer{
+		args: &types.LogArgs{WriterCloser: out},
+		grep: regexp.MustCompile(`loss`),
+	}
+	streamer.copyLines(strings.NewReader("step 1 loss 0.9\nsaving checkpoint\nstep 2 loss 0.7"), "worker-3", 32)
+	expected := "[worker-3] step 1 loss 0.9\n[worker-3] step 2 loss 0.7\n"
+	if out.String() != expected {
+		t.Errorf("expected %q, got %q", expected, out.String())
+	}
+}
+
+func TestMatchInstanceRole(t *testing.T) {
+	newPod := func(name string, labels map[string]string) *corev1.Pod {
+		return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+	}
+	testcases := []struct {
+		pod      *corev1.Pod
+		role     string
+		expected bool
+	}{
+		{newPod("dist-worker-3", map[string]string{TrainingReplicaTypeLabel: "worker"}), "worker", true},
+		{newPod("dist-master-0", map[string]string{TrainingReplicaTypeLabel: "master"}), "worker", false},
+		{newPod("dist-launcher-x7kq2", nil), "launcher", true},
+		{newPod("dist-worker-1", nil), "work", false},
+		{newPod("dist-worker-1", nil), "", true},
+	}
+	for _, testcase := range testcases {
+		if matched := matchInstanceRole(testcase.pod, "dist", testcase.role); matched != testcase.expected {
+			t.Errorf("%v with role %q: expected %v, got %v", testcase.pod.Name, testcase.role, testcase.expected, matched)
+		}
+	}
+	if label := instanceLabel("dist", "dist-worker-3"); label != "worker-3" {
+		t.Errorf("expected instance label worker-3, got %v", label)
+	}
+}
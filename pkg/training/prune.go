@@ -16,47 +16,203 @@ package training
 
 import (
 	"fmt"
+	"sort"
 	"time"
 
 	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/labels"
 
+	"github.com/kubeflow/arena/pkg/apis/types"
 	"github.com/kubeflow/arena/pkg/util"
 )
 
+// PruneTrainingJobs deletes the not running training jobs which live longer than since
 func PruneTrainingJobs(namespace string, allNamespaces bool, since time.Duration) error {
-	jobs := []TrainingJob{}
-	trainers := GetAllTrainers()
-	for _, trainer := range trainers {
-		if !trainer.IsEnabled() {
+	if since < 0 {
+		return fmt.Errorf("you need to specify the relative duration live time of the job that need to be cleaned by --since. Like --since 10h")
+	}
+	_, err := PruneTrainingJobsWithPolicy(namespace, &types.PruneArgs{AllNamespaces: allNamespaces, Since: &since})
+	return err
+}
+
+// PruneTrainingJobsWithPolicy deletes the training jobs selected by the retention policy,
+// the jobs are archived before deletion if the archive directory or pvc is set, otherwise the logs
+// of the jobs submitted with --export-logs-to are exported to the destination
+func PruneTrainingJobsWithPolicy(namespace string, args *types.PruneArgs) ([]types.PrunedTrainingJob, error) {
+	if err := validatePruneArgs(args); err != nil {
+		return nil, err
+	}
+	selector, err := labels.Parse(args.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid label selector %v: %v", args.Selector, err)
+	}
+	jobs, err := ListTrainingJobs(namespace, args.AllNamespaces, args.Type)
+	if err != nil {
+		return nil, err
+	}
+	filtered := []TrainingJob{}
+	for _, job := range jobs {
+		if selector.Matches(labels.Set(job.GetLabels())) {
+			filtered = append(filtered, job)
+		}
+	}
+	var archiver *pvcArchiver
+	if args.ArchivePVC != "" && !args.DryRun {
+		archiver = newPVCArchiver(args.ArchivePVC, args.ArchiveImage)
+		defer archiver.close()
+	}
+	archive := func(job TrainingJob) (string, error) {
+		return archivePrunedTrainingJob(job, args, archiver)
+	}
+	deleteJob := func(job TrainingJob) error {
+		return DeleteTrainingJob(job.Name(), job.Namespace(), job.Trainer())
+	}
+	pruned := pruneCandidates(selectPrunedTrainingJobs(filtered, args), args.DryRun, archive, deleteJob)
+	if len(pruned) == 0 {
+		fmt.Println("No job need to be deleted")
+	}
+	return pruned, nil
+}
+
+// pruneCandidates archives and deletes the candidates one by one, the job is kept if it fails to be archived
+func pruneCandidates(candidates []pruneCandidate, dryRun bool, archive func(TrainingJob) (string, error), deleteJob func(TrainingJob) error) []types.PrunedTrainingJob {
+	pruned := []types.PrunedTrainingJob{}
+	for _, candidate := range candidates {
+		job := candidate.job
+		result := types.PrunedTrainingJob{
+			Name:      job.Name(),
+			Namespace: job.Namespace(),
+			Type:      job.Trainer(),
+			Status:    candidate.status,
+			Age:       util.ShortHumanDuration(job.Age()),
+			Reason:    candidate.reason,
+		}
+		if dryRun {
+			fmt.Printf("Would delete %s %s with Age %s (%s)\n", job.Trainer(), job.Name(), result.Age, result.Reason)
+			pruned = append(pruned, result)
 			continue
 		}
-		trainingJobs, err := trainer.ListTrainingJobs(namespace, allNamespaces)
+		archived, err := archive(job)
 		if err != nil {
-			log.Debugf("failed to list jobs of tainer %v,reason: %v", trainer.Type(), err)
+			fmt.Printf("Failed to archive %s %s, skip to delete it, err: %v\n", job.Trainer(), job.Name(), err)
+			pruned = append(pruned, result)
 			continue
 		}
-		jobs = append(jobs, trainingJobs...)
+		result.Archive = archived
+		fmt.Printf("Delete %s %s with Age %s (%s)\n", job.Trainer(), job.Name(), result.Age, result.Reason)
+		if result.Archive != "" {
+			fmt.Printf("  archived to %s\n", result.Archive)
+		}
+		if err := deleteJob(job); err != nil {
+			fmt.Printf("Failed to delete %s %s, err: %++v\n", job.Trainer(), job.Name(), err)
+		} else {
+			result.Deleted = true
+		}
+		pruned = append(pruned, result)
 	}
-	deleted := false
-	if since == -1 {
-		return fmt.Errorf("you need to specify the relative duration live time of the job that need to be cleaned by --since. Like --since 10h")
+	return pruned
+}
+
+// archivePrunedTrainingJob archives the job to the archive directory or pvc, or exports its logs to the
+// destination given by --export-logs-to, it returns an empty location if there is nothing to archive
+func archivePrunedTrainingJob(job TrainingJob, args *types.PruneArgs, archiver *pvcArchiver) (string, error) {
+	switch {
+	case args.ArchiveDir != "":
+		return archiveTrainingJobToDir(job, args.ArchiveDir)
+	case archiver != nil:
+		return archiver.archive(job)
+	case exportLogsTarget(job) != "":
+		return exportTrainingJobLogs(job, exportLogsTarget(job))
+	}
+	return "", nil
+}
+
+// validatePruneArgs returns an error if no retention policy is given
+func validatePruneArgs(args *types.PruneArgs) error {
+	if args.Since != nil && *args.Since < 0 {
+		return fmt.Errorf("--since %v is invalid", *args.Since)
 	}
+	if args.Since == nil && args.SucceededTTL <= 0 && args.FailedTTL <= 0 && args.KeepLast <= 0 {
+		return fmt.Errorf("you need to specify the retention policy by --since, --succeeded-ttl, --failed-ttl or --keep-last. Like --failed-ttl 24h")
+	}
+	return nil
+}
+
+type pruneCandidate struct {
+	job    TrainingJob
+	status types.TrainingJobStatus
+	reason string
+}
+
+// selectPrunedTrainingJobs returns the training jobs selected by any policy of the retention policy
+func selectPrunedTrainingJobs(jobs []TrainingJob, args *types.PruneArgs) []pruneCandidate {
+	statuses := map[TrainingJob]types.TrainingJobStatus{}
+	groups := map[string][]TrainingJob{}
 	for _, job := range jobs {
-		if GetJobRealStatus(job) == "RUNNING" {
-			continue
+		status := types.TrainingJobStatus(GetJobRealStatus(job))
+		statuses[job] = status
+		if status == types.TrainingJobSucceeded || status == types.TrainingJobFailed {
+			key := job.Namespace() + "/" + pruneGroupKey(job, args.KeepLastBy)
+			groups[key] = append(groups[key], job)
 		}
-		if job.Age() < since {
-			continue
+	}
+	// the finished jobs out of the latest N jobs of their group are pruned
+	exceeded := map[TrainingJob]string{}
+	if args.KeepLast > 0 {
+		for _, group := range groups {
+			sort.SliceStable(group, func(i, j int) bool {
+				return group[i].Age() < group[j].Age()
+			})
+			for i := args.KeepLast; i < len(group); i++ {
+				exceeded[group[i]] = fmt.Sprintf("out of the last %v finished jobs of %v %v",
+					args.KeepLast, pruneKeepLastBy(args.KeepLastBy), defaultPruneGroup(pruneGroupKey(group[i], args.KeepLastBy)))
+			}
 		}
-		deleted = true
-		fmt.Printf("Delete %s %s with Age %s \n", job.Trainer(), job.Name(), util.ShortHumanDuration(job.Age()))
-		err := DeleteTrainingJob(job.Name(), job.Namespace(), job.Trainer())
-		if err != nil {
-			fmt.Printf("Failed to delete %s %s, err: %++v", job.Trainer(), job.Name(), err)
+	}
+	candidates := []pruneCandidate{}
+	for _, job := range jobs {
+		status := statuses[job]
+		reason := ""
+		switch {
+		case args.Since != nil && status != types.TrainingJobRunning && status != types.TrainingJobSuspended && job.Age() >= *args.Since:
+			reason = fmt.Sprintf("older than %v", *args.Since)
+		case args.SucceededTTL > 0 && status == types.TrainingJobSucceeded && job.Age() >= args.SucceededTTL:
+			reason = fmt.Sprintf("succeeded and older than %v", args.SucceededTTL)
+		case args.FailedTTL > 0 && status == types.TrainingJobFailed && job.Age() >= args.FailedTTL:
+			reason = fmt.Sprintf("failed and older than %v", args.FailedTTL)
+		case exceeded[job] != "":
+			reason = exceeded[job]
+		default:
+			log.Debugf("keep %v %v with status %v", job.Trainer(), job.Name(), status)
+			continue
 		}
+		candidates = append(candidates, pruneCandidate{job: job, status: status, reason: reason})
 	}
-	if !deleted {
-		fmt.Println("No job need to be deleted")
+	return candidates
+}
+
+// pruneGroupKey returns the owner or the label value of training job to group it
+func pruneGroupKey(job TrainingJob, keepLastBy string) string {
+	return job.GetLabels()[pruneKeepLastLabel(keepLastBy)]
+}
+
+func pruneKeepLastLabel(keepLastBy string) string {
+	if keepLastBy == "" || keepLastBy == types.PruneKeepLastByUser {
+		return types.UserNameIdLabel
 	}
-	return nil
+	return keepLastBy
+}
+
+func pruneKeepLastBy(keepLastBy string) string {
+	if keepLastBy == "" {
+		return types.PruneKeepLastByUser
+	}
+	return keepLastBy
+}
+
+func defaultPruneGroup(group string) string {
+	if group == "" {
+		return "<none>"
+	}
+	return group
 }
@@ -0,0 +1,150 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+	pytorchv1 "github.com/kubeflow/arena/pkg/operators/pytorch-operator/apis/pytorch/v1"
+	commonv1 "github.com/kubeflow/arena/pkg/operators/tf-operator/apis/common/v1"
+)
+
+func newPruneTestJob(name, user string, conditionType commonv1.JobConditionType, age time.Duration) TrainingJob {
+	return &PyTorchJob{
+		BasicJobInfo: &BasicJobInfo{name: name},
+		pytorchjob: &pytorchv1.PyTorchJob{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         "default",
+				Labels:            map[string]string{types.UserNameIdLabel: user},
+				CreationTimestamp: metav1.NewTime(time.Now().Add(-age)),
+			},
+			Status: commonv1.JobStatus{
+				Conditions: []commonv1.JobCondition{{Type: conditionType, Status: corev1.ConditionTrue}},
+			},
+		},
+		trainerType: types.PytorchTrainingJob,
+	}
+}
+
+func TestSelectPrunedTrainingJobs(t *testing.T) {
+	jobs := []TrainingJob{
+		newPruneTestJob("alice-1", "alice", commonv1.JobSucceeded, 1*time.Hour),
+		newPruneTestJob("alice-2", "alice", commonv1.JobSucceeded, 2*time.Hour),
+		newPruneTestJob("alice-3", "alice", commonv1.JobFailed, 3*time.Hour),
+		newPruneTestJob("alice-4", "alice", commonv1.JobRunning, 4*time.Hour),
+		newPruneTestJob("bob-1", "bob", commonv1.JobFailed, 30*time.Hour),
+		newPruneTestJob("bob-2", "bob", commonv1.JobSucceeded, 31*time.Hour),
+	}
+	testcases := []struct {
+		name     string
+		args     types.PruneArgs
+		expected []string
+	}{
+		{"since", types.PruneArgs{Since: pruneDuration(150 * time.Minute)}, []string{"alice-3", "bob-1", "bob-2"}},
+		{"since zero", types.PruneArgs{Since: pruneDuration(0)}, []string{"alice-1", "alice-2", "alice-3", "bob-1", "bob-2"}},
+		{"failed ttl", types.PruneArgs{FailedTTL: 24 * time.Hour}, []string{"bob-1"}},
+		{"succeeded ttl", types.PruneArgs{SucceededTTL: 90 * time.Minute}, []string{"alice-2", "bob-2"}},
+		{"keep last per user", types.PruneArgs{KeepLast: 1}, []string{"alice-2", "alice-3", "bob-2"}},
+		{"keep last and failed ttl", types.PruneArgs{KeepLast: 2, FailedTTL: 2 * time.Hour}, []string{"alice-3", "bob-1"}},
+	}
+	for _, testcase := range testcases {
+		candidates := selectPrunedTrainingJobs(jobs, &testcase.args)
+		names := []string{}
+		for _, c := range candidates {
+			names = append(names, c.job.Name())
+		}
+		if len(names) != len(testcase.expected) {
+			t.Errorf("%v: expected %v, got %v", testcase.name, testcase.expected, names)
+			continue
+		}
+		for i := range names {
+			if names[i] != testcase.expected[i] {
+				t.Errorf("%v: expected %v, got %v", testcase.name, testcase.expected, names)
+				break
+			}
+		}
+	}
+}
+
+func TestValidatePruneArgs(t *testing.T) {
+	testcases := []struct {
+		name   string
+		args   types.PruneArgs
+		hasErr bool
+	}{
+		{"no policy", types.PruneArgs{}, true},
+		{"since zero", types.PruneArgs{Since: pruneDuration(0)}, false},
+		{"since", types.PruneArgs{Since: pruneDuration(time.Hour)}, false},
+		{"failed ttl", types.PruneArgs{FailedTTL: time.Hour}, false},
+	}
+	for _, testcase := range testcases {
+		err := validatePruneArgs(&testcase.args)
+		if (err != nil) != testcase.hasErr {
+			t.Errorf("%v: expected error %v, got %v", testcase.name, testcase.hasErr, err)
+		}
+	}
+}
+
+func TestPruneCandidatesAfterFailedExport(t *testing.T) {
+	candidates := []pruneCandidate{
+		{job: newPruneTestJob("exported", "alice", commonv1.JobSucceeded, 2*time.Hour)},
+		{job: newPruneTestJob("no-target", "alice", commonv1.JobSucceeded, 3*time.Hour)},
+	}
+	archive := func(job TrainingJob) (string, error) {
+		if job.Name() == "exported" {
+			return "", fmt.Errorf("failed to upload")
+		}
+		return "", nil
+	}
+	deleted := []string{}
+	deleteJob := func(job TrainingJob) error {
+		deleted = append(deleted, job.Name())
+		return nil
+	}
+	pruned := pruneCandidates(candidates, false, archive, deleteJob)
+	if len(pruned) != 2 || pruned[0].Deleted || !pruned[1].Deleted {
+		t.Errorf("expected only no-target to be deleted, got %+v", pruned)
+	}
+	if len(deleted) != 1 || deleted[0] != "no-target" {
+		t.Errorf("expected to delete [no-target], got %v", deleted)
+	}
+}
+
+func TestSelectPrunedTrainingJobsKeepPending(t *testing.T) {
+	jobs := []TrainingJob{
+		newPruneTestJob("pending-1", "alice", commonv1.JobCreated, 5*time.Hour),
+		newPruneTestJob("queuing-1", "alice", commonv1.JobQueuing, 6*time.Hour),
+		newPruneTestJob("alice-1", "alice", commonv1.JobSucceeded, 1*time.Hour),
+		newPruneTestJob("alice-2", "alice", commonv1.JobSucceeded, 2*time.Hour),
+	}
+	for _, keepLast := range []int{1, 2, 5} {
+		for _, c := range selectPrunedTrainingJobs(jobs, &types.PruneArgs{KeepLast: keepLast}) {
+			if c.status != types.TrainingJobSucceeded && c.status != types.TrainingJobFailed {
+				t.Errorf("keep last %v: expected only finished jobs to be selected, got %v with status %v", keepLast, c.job.Name(), c.status)
+			}
+		}
+	}
+}
+
+func pruneDuration(d time.Duration) *time.Duration {
+	return &d
+}
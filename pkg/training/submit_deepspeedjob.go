@@ -40,21 +40,12 @@ func SubmitDeepSpeedJob(namespace string, submitArgs *types.SubmitDeepSpeedJobAr
 	if !ok {
 		return fmt.Errorf("not found trainer whose type is %v", submitArgs.TrainingType)
 	}
-	job, err := trainer.GetTrainingJob(submitArgs.Name, namespace)
-	// if job has been existed,skip to create it and return an error
-	if err == nil && job != nil {
-		return fmt.Errorf("the job %s is already exist, please delete it first. use 'arena delete %s'", submitArgs.Name, submitArgs.Name)
-	}
-	// if error is unknown,return an error
-	if err != types.ErrTrainingJobNotFound {
-		if err == types.ErrNoPrivilegesToOperateJob {
-			return fmt.Errorf("the job %s is already exist and it owned by other user,you have no privileges to operate it", submitArgs.Name)
-		}
+	if err := checkTrainingJobNotExist(trainer, submitArgs.Name, namespace, submitArgs.DryRun); err != nil {
 		return err
 	}
 	// the master is also considered as a worker
 	deepspeedjobChart := util.GetChartsFolder() + "/etjob"
-	err = workflow.SubmitJob(submitArgs.Name, string(types.DeepSpeedTrainingJob), namespace, submitArgs, deepspeedjobChart, submitArgs.HelmOptions...)
+	err = workflow.SubmitJob(submitArgs.Name, string(types.DeepSpeedTrainingJob), namespace, submitArgs, deepspeedjobChart, submitArgs.DryRun, submitArgs.HelmOptions...)
 	if err != nil {
 		return err
 	}
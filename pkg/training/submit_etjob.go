@@ -46,21 +46,12 @@ func SubmitETJob(namespace string, submitArgs *types.SubmitETJobArgs) (err error
 	if !ok {
 		return fmt.Errorf("not found trainer whose type is %v", submitArgs.TrainingType)
 	}
-	job, err := trainer.GetTrainingJob(submitArgs.Name, namespace)
-	// if job has been existed,skip to create it and return an error
-	if err == nil && job != nil {
-		return fmt.Errorf("the job %s is already exist, please delete it first. use 'arena delete %s'", submitArgs.Name, submitArgs.Name)
-	}
-	// if error is unknown,return an error
-	if err != types.ErrTrainingJobNotFound {
-		if err == types.ErrNoPrivilegesToOperateJob {
-			return fmt.Errorf("the job %s is already exist and it owned by other user,you have no privileges to operate it", submitArgs.Name)
-		}
+	if err := checkTrainingJobNotExist(trainer, submitArgs.Name, namespace, submitArgs.DryRun); err != nil {
 		return err
 	}
 	// the master is also considered as a worker
 	etjobChart := util.GetChartsFolder() + "/etjob"
-	err = workflow.SubmitJob(submitArgs.Name, string(types.ETTrainingJob), namespace, submitArgs, etjobChart, submitArgs.HelmOptions...)
+	err = workflow.SubmitJob(submitArgs.Name, string(types.ETTrainingJob), namespace, submitArgs, etjobChart, submitArgs.DryRun, submitArgs.HelmOptions...)
 	if err != nil {
 		return err
 	}
@@ -95,7 +86,7 @@ func SubmitScaleInETJob(namespace string, submitArgs *types.ScaleInETJobArgs) er
 	scaleName := fmt.Sprintf("%s-%d", etjobName, time.Now().Unix())
 	log.Debugf("submitArgs: %v", submitArgs)
 	scaleinETChart := util.GetChartsFolder() + "/scalein"
-	err = workflow.SubmitOps(scaleName, "scalein", namespace, submitArgs, scaleinETChart)
+	err = workflow.SubmitOps(scaleName, "scalein", namespace, submitArgs, scaleinETChart, submitArgs.DryRun)
 	if err != nil {
 		return err
 	}
@@ -129,7 +120,7 @@ func SubmitScaleOutETJob(namespace string, submitArgs *types.ScaleOutETJobArgs)
 	scaleName := fmt.Sprintf("%s-%d", etjobName, time.Now().Unix())
 	log.Debugf("submitArgs: %v", submitArgs)
 	scaleoutETChart := util.GetChartsFolder() + "/scaleout"
-	err = workflow.SubmitOps(scaleName, "scaleout", namespace, submitArgs, scaleoutETChart)
+	err = workflow.SubmitOps(scaleName, "scaleout", namespace, submitArgs, scaleoutETChart, submitArgs.DryRun)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,100 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"context"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/util/kubeclient"
+)
+
+// suspendPatches stores the merge patches to suspend or resume the training jobs whose operators support suspension
+var suspendPatches = map[types.TrainingJobType]string{
+	types.TFTrainingJob:      `{"spec":{"runPolicy":{"suspend":%v}}}`,
+	types.PytorchTrainingJob: `{"spec":{"runPolicy":{"suspend":%v}}}`,
+	types.TrainJob:           `{"spec":{"suspend":%v}}`,
+	types.JobSetTrainingJob:  `{"spec":{"suspend":%v}}`,
+}
+
+// SuspendTrainingJob suspends or resumes the training job, the operator deletes the pods of job
+// when it is suspended and creates them again when it is resumed
+func SuspendTrainingJob(name, namespace string, jobType types.TrainingJobType, suspend bool) error {
+	job, err := SearchTrainingJob(name, namespace, jobType)
+	if err != nil {
+		return err
+	}
+	patch, ok := suspendPatches[job.Trainer()]
+	if !ok {
+		return fmt.Errorf("the training job %v with type %v does not support suspension, only [%v,%v,%v,%v] are supported",
+			name, job.Trainer(), types.TFTrainingJob, types.PytorchTrainingJob, types.TrainJob, types.JobSetTrainingJob)
+	}
+	if err := checkSuspendable(name, namespace, job.Trainer(), types.TrainingJobStatus(GetJobRealStatus(job)), suspend); err != nil {
+		return err
+	}
+	if err := patchSuspend(trainingJobResources[job.Trainer()], name, namespace, patch, suspend); err != nil {
+		return fmt.Errorf("failed to patch %v %v: %v", job.Trainer(), name, err)
+	}
+	logSuspended(name, suspend)
+	return nil
+}
+
+// checkSuspendable returns an error if the user can not operate the job or the status of job does not allow the operation
+func checkSuspendable(name, namespace string, jobType types.TrainingJobType, status types.TrainingJobStatus, suspend bool) error {
+	canOperate, err := kubeclient.CheckJobIsOwnedByUser(namespace, name, jobType)
+	if err != nil && err != kubeclient.ErrConfigMapNotFound {
+		return err
+	}
+	if err == nil && !canOperate {
+		return types.ErrNoPrivilegesToOperateJob
+	}
+	switch {
+	case status == types.TrainingJobSucceeded || status == types.TrainingJobFailed:
+		return fmt.Errorf("the training job %v is %v, it can not be suspended or resumed", name, status)
+	case suspend && status == types.TrainingJobSuspended:
+		return fmt.Errorf("the training job %v has been suspended", name)
+	case !suspend && status != types.TrainingJobSuspended:
+		return fmt.Errorf("the training job %v is %v, only the suspended job can be resumed", name, status)
+	}
+	return nil
+}
+
+func patchSuspend(resource schema.GroupVersionResource, name, namespace, patch string, suspend bool) error {
+	client := dynamic.NewForConfigOrDie(config.GetArenaConfiger().GetRestConfig())
+	_, err := client.Resource(resource).Namespace(namespace).Patch(
+		context.TODO(),
+		name,
+		k8stypes.MergePatchType,
+		[]byte(fmt.Sprintf(patch, suspend)),
+		metav1.PatchOptions{},
+	)
+	return err
+}
+
+func logSuspended(name string, suspend bool) {
+	if suspend {
+		log.Infof("The training job %v has been suspended, its pods will be deleted", name)
+	} else {
+		log.Infof("The training job %v has been resumed", name)
+	}
+}
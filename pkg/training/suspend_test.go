@@ -0,0 +1,36 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+	commonv1 "github.com/kubeflow/arena/pkg/operators/tf-operator/apis/common/v1"
+)
+
+func TestSuspendedTrainingJobStatus(t *testing.T) {
+	job := newWaitTestJob(commonv1.JobSuspended)
+	if status := GetJobRealStatus(job); status != string(types.TrainingJobSuspended) {
+		t.Errorf("expected status %v, got %v", types.TrainingJobSuspended, status)
+	}
+	if _, ok := suspendPatches[types.MPITrainingJob]; ok {
+		t.Errorf("mpijob v1alpha1 does not support suspension")
+	}
+	if patch := fmt.Sprintf(suspendPatches[job.Trainer()], false); patch != `{"spec":{"runPolicy":{"suspend":false}}}` {
+		t.Errorf("unexpected resume patch %v", patch)
+	}
+}
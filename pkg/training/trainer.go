@@ -46,6 +46,8 @@ func GetAllTrainers() map[types.TrainingJobType]Trainer {
 			NewSparkJobTrainer,
 			NewDeepSpeedJobTrainer,
 			NewRayJobTrainer,
+			NewTrainJobTrainer,
+			NewJobSetTrainer,
 		}
 		var wg sync.WaitGroup
 		for _, initFunc := range trainerInits {
@@ -64,6 +66,27 @@ func GetAllTrainers() map[types.TrainingJobType]Trainer {
 	return trainers
 }
 
+// checkTrainingJobNotExist returns an error if the job to submit has been existed,
+// it is skipped when the job is only rendered by the client dry run
+func checkTrainingJobNotExist(trainer Trainer, name, namespace string, dryRun types.DryRunStrategy) error {
+	if dryRun == types.DryRunClient {
+		return nil
+	}
+	job, err := trainer.GetTrainingJob(name, namespace)
+	// if job has been existed,skip to create it and return an error
+	if err == nil && job != nil {
+		return fmt.Errorf("the job %s is already exist, please delete it first. use 'arena delete %s'", name, name)
+	}
+	// if error is unknown,return an error
+	if err != types.ErrTrainingJobNotFound {
+		if err == types.ErrNoPrivilegesToOperateJob {
+			return fmt.Errorf("the job %s is already exist and it owned by other user,you have no privileges to operate it", name)
+		}
+		return err
+	}
+	return nil
+}
+
 type orderedTrainingJob []TrainingJob
 
 func (jobs orderedTrainingJob) Len() int {
@@ -0,0 +1,350 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/apis/utils"
+	"github.com/kubeflow/arena/pkg/k8saccesser"
+	jobsetv1alpha2 "github.com/kubeflow/arena/pkg/operators/jobset-operator/apis/jobset/v1alpha2"
+)
+
+// JobSet Information
+type JobSet struct {
+	*BasicJobInfo
+	jobSet       *jobsetv1alpha2.JobSet
+	pods         []*corev1.Pod // all the pods of the replicated jobs
+	chiefPod     *corev1.Pod   // the first pod of the first replicated job
+	requestedGPU int64
+	allocatedGPU int64
+	trainerType  types.TrainingJobType // return trainer type: jobset
+}
+
+// JobSet implements the TrainingJob interface.
+var _ TrainingJob = &JobSet{}
+
+// Name returns the JobSet name
+func (js *JobSet) Name() string {
+	return js.name
+}
+
+func (js *JobSet) Uid() string {
+	return string(js.jobSet.UID)
+}
+
+// Get the chief Pod of the Job.
+func (js *JobSet) ChiefPod() *corev1.Pod {
+	return js.chiefPod
+}
+
+func (js *JobSet) Trainer() types.TrainingJobType {
+	return js.trainerType
+}
+
+// Get all the pods of the Training Job
+func (js *JobSet) AllPods() []*corev1.Pod {
+	return js.pods
+}
+
+func (js *JobSet) GetTrainJob() interface{} {
+	return js.jobSet
+}
+
+func (js *JobSet) GetLabels() map[string]string {
+	return js.jobSet.Labels
+}
+
+// Get the Status of the JobSet: PENDING, RUNNING, SUSPENDED, SUCCEEDED, FAILED
+func (js *JobSet) GetStatus() string {
+	conditions := js.jobSet.Status.Conditions
+	switch {
+	case meta.IsStatusConditionTrue(conditions, jobsetv1alpha2.JobSetFailed):
+		return string(types.TrainingJobFailed)
+	case meta.IsStatusConditionTrue(conditions, jobsetv1alpha2.JobSetCompleted):
+		return string(types.TrainingJobSucceeded)
+	case meta.IsStatusConditionTrue(conditions, jobsetv1alpha2.JobSetSuspended):
+		return string(types.TrainingJobSuspended)
+	}
+	for _, pod := range js.pods {
+		if pod.Status.Phase == corev1.PodRunning {
+			return string(types.TrainingJobRunning)
+		}
+	}
+	return string(types.TrainingJobPending)
+}
+
+// Get the start time
+func (js *JobSet) StartTime() *metav1.Time {
+	return &js.jobSet.CreationTimestamp
+}
+
+// Get the Job Age
+func (js *JobSet) Age() time.Duration {
+	job := js.jobSet
+
+	// use creation timestamp
+	if job.CreationTimestamp.IsZero() {
+		return 0
+	}
+	return metav1.Now().Sub(job.CreationTimestamp.Time)
+}
+
+// Get the Job Training Duration.
+func (js *JobSet) Duration() time.Duration {
+	job := js.jobSet
+
+	if job.CreationTimestamp.IsZero() {
+		return 0
+	}
+	for _, conditionType := range []string{jobsetv1alpha2.JobSetCompleted, jobsetv1alpha2.JobSetFailed} {
+		cond := meta.FindStatusCondition(job.Status.Conditions, conditionType)
+		if cond != nil && cond.Status == metav1.ConditionTrue && !cond.LastTransitionTime.IsZero() {
+			return cond.LastTransitionTime.Sub(job.CreationTimestamp.Time)
+		}
+	}
+	return metav1.Now().Sub(job.CreationTimestamp.Time)
+}
+
+// Get Dashboard url of the job
+func (js *JobSet) GetJobDashboards(client *kubernetes.Clientset, namespace, arenaNamespace string) ([]string, error) {
+	urls := []string{}
+	dashboardURL, err := dashboard(client, namespace, "kubernetes-dashboard")
+
+	if err != nil {
+		log.Debugf("Get dashboard failed due to %v", err)
+		// retry for the existing customers, will be deprecated in the future
+		dashboardURL, err = dashboard(client, arenaNamespace, "kubernetes-dashboard")
+		if err != nil {
+			log.Debugf("Get dashboard failed due to %v", err)
+		}
+	}
+
+	if err != nil {
+		log.Debugf("Get dashboard failed due to %v", err)
+		// retry for the existing customers, will be deprecated in the future
+		dashboardURL, err = dashboard(client, "kube-system", "kubernetes-dashboard")
+		if err != nil {
+			log.Debugf("Get dashboard failed due to %v", err)
+		}
+	}
+
+	if dashboardURL == "" {
+		return urls, fmt.Errorf("no LOGVIEWER Installed")
+	}
+
+	if js.chiefPod == nil || len(js.chiefPod.Spec.Containers) == 0 {
+		return urls, fmt.Errorf("chief pod is not ready")
+	}
+
+	url := fmt.Sprintf("%s/#!/log/%s/%s/%s?namespace=%s\n",
+		dashboardURL,
+		js.chiefPod.Namespace,
+		js.chiefPod.Name,
+		js.chiefPod.Spec.Containers[0].Name,
+		js.chiefPod.Namespace)
+
+	urls = append(urls, url)
+
+	return urls, nil
+}
+
+// Requested GPU count of the Job
+func (js *JobSet) RequestedGPU() int64 {
+	if js.requestedGPU > 0 {
+		return js.requestedGPU
+	}
+	requestGPUs := getRequestGPUsOfJobFromPodAnnotation(js.pods)
+	if requestGPUs > 0 {
+		return requestGPUs
+	}
+	for _, pod := range js.pods {
+		js.requestedGPU += gpuInPod(*pod)
+	}
+	return js.requestedGPU
+}
+
+// Requested GPU count of the Job
+func (js *JobSet) AllocatedGPU() int64 {
+	if js.allocatedGPU > 0 {
+		return js.allocatedGPU
+	}
+	for _, pod := range js.pods {
+		js.allocatedGPU += gpuInActivePod(*pod)
+	}
+	return js.allocatedGPU
+}
+
+// Get the hostIP of the chief Pod
+func (js *JobSet) HostIPOfChief() (hostIP string) {
+	hostIP = "N/A"
+	if js.GetStatus() == string(types.TrainingJobRunning) && js.chiefPod != nil {
+		hostIP = js.chiefPod.Status.HostIP
+	}
+	return hostIP
+}
+
+func (js *JobSet) Namespace() string {
+	return js.jobSet.Namespace
+}
+
+// Get PriorityClass, return the PriorityClassName of chief pod
+func (js *JobSet) GetPriorityClass() string {
+	if js.chiefPod == nil {
+		return ""
+	}
+	return js.chiefPod.Spec.PriorityClassName
+}
+
+// JobSet trainer
+type JobSetTrainer struct {
+	client        *kubernetes.Clientset
+	dynamicClient dynamic.Interface
+	trainerType   types.TrainingJobType
+	// check if it's enabled
+	enabled bool
+}
+
+// JobSetTrainer implements the Trainer interface.
+var _ Trainer = &JobSetTrainer{}
+
+// NewJobSetTrainer
+func NewJobSetTrainer() Trainer {
+	enable := false
+	dynamicClient := dynamic.NewForConfigOrDie(config.GetArenaConfiger().GetRestConfig())
+	_, err := config.GetArenaConfiger().GetAPIExtensionClientSet().ApiextensionsV1().CustomResourceDefinitions().Get(context.TODO(), k8saccesser.JobSetCRDName, metav1.GetOptions{})
+	if err == nil {
+		log.Debugf("JobSetTrainer is enabled")
+		enable = true
+	} else {
+		log.Debugf("JobSetTrainer is disabled,reason: %v", err)
+	}
+	log.Debugf("Succeed to init JobSetTrainer")
+	return &JobSetTrainer{
+		dynamicClient: dynamicClient,
+		client:        config.GetArenaConfiger().GetClientSet(),
+		trainerType:   types.JobSetTrainingJob,
+		enabled:       enable,
+	}
+}
+
+// IsEnabled is used to get the trainer is enable or not
+func (jst *JobSetTrainer) IsEnabled() bool {
+	return jst.enabled
+}
+
+// Get the type
+func (jst *JobSetTrainer) Type() types.TrainingJobType {
+	return jst.trainerType
+}
+
+// check if it's jobset
+func (jst *JobSetTrainer) IsSupported(name, ns string) bool {
+	if !jst.enabled {
+		return false
+	}
+	_, err := jst.GetTrainingJob(name, ns)
+	return err == nil
+}
+
+// Get the training job from cache or directly
+func (jst *JobSetTrainer) GetTrainingJob(name, namespace string) (TrainingJob, error) {
+	jobSet, err := k8saccesser.GetK8sResourceAccesser().GetJobSet(jst.dynamicClient, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := CheckJobIsOwnedByTrainer(jobSet.Labels); err != nil {
+		return nil, err
+	}
+	pods, err := k8saccesser.GetK8sResourceAccesser().ListPods(namespace, fmt.Sprintf("release=%v,app=%v", name, jst.Type()), "", nil)
+	if err != nil {
+		return nil, err
+	}
+	filterPods, chiefPod := getPodsOfJobSet(jobSet, pods)
+	return &JobSet{
+		BasicJobInfo: &BasicJobInfo{
+			resources: podResources(filterPods),
+			name:      name,
+		},
+		jobSet:      jobSet,
+		chiefPod:    chiefPod,
+		pods:        filterPods,
+		trainerType: jst.Type(),
+	}, nil
+}
+
+func (jst *JobSetTrainer) ListTrainingJobs(namespace string, allNamespace bool) ([]TrainingJob, error) {
+	if allNamespace {
+		namespace = metav1.NamespaceAll
+	}
+	trainingJobs := []TrainingJob{}
+	jobLabels := GetTrainingJobLabels(jst.Type())
+	jobSets, err := k8saccesser.GetK8sResourceAccesser().ListJobSets(jst.dynamicClient, namespace, jobLabels)
+	if err != nil {
+		return trainingJobs, err
+	}
+	pods, err := k8saccesser.GetK8sResourceAccesser().ListPods(namespace, fmt.Sprintf("release,app=%v", jst.Type()), "", nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, jobSet := range jobSets {
+		filterPods, chiefPod := getPodsOfJobSet(jobSet, pods)
+		trainingJobs = append(trainingJobs, &JobSet{
+			BasicJobInfo: &BasicJobInfo{
+				resources: podResources(filterPods),
+				name:      jobSet.Name,
+			},
+			jobSet:      jobSet,
+			chiefPod:    chiefPod,
+			pods:        filterPods,
+			trainerType: jst.Type(),
+		})
+	}
+	return trainingJobs, nil
+}
+
+// isJobSetChiefPod returns true if the pod is the first pod of the first job of the chief replicated job
+func isJobSetChiefPod(chiefReplicatedJob string, pod *corev1.Pod) bool {
+	if pod.Labels[utils.JobSetReplicatedJobLabel] != chiefReplicatedJob {
+		return false
+	}
+	if pod.Labels[utils.JobSetJobIndexLabel] != "0" {
+		return false
+	}
+	return pod.Labels[utils.JobCompletionIndexLabel] == "0"
+}
+
+// filter out all pods and chief pod of JobSet from pods in current system,
+// the first replicated job is the chief
+func getPodsOfJobSet(jobSet *jobsetv1alpha2.JobSet, podList []*corev1.Pod) ([]*corev1.Pod, *corev1.Pod) {
+	chiefReplicatedJob := ""
+	if len(jobSet.Spec.ReplicatedJobs) != 0 {
+		chiefReplicatedJob = jobSet.Spec.ReplicatedJobs[0].Name
+	}
+	return getPodsOfTrainingJob(jobSet.Name, jobSet.Namespace, podList, utils.IsJobSetPod, func(pod *corev1.Pod) bool {
+		return isJobSetChiefPod(chiefReplicatedJob, pod)
+	})
+}
@@ -0,0 +1,101 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	jobsetv1alpha2 "github.com/kubeflow/arena/pkg/operators/jobset-operator/apis/jobset/v1alpha2"
+)
+
+func TestJobSetGetStatus(t *testing.T) {
+	runningPod := &corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodRunning}}
+	testcases := []struct {
+		conditions []metav1.Condition
+		pods       []*corev1.Pod
+		expected   string
+	}{
+		{
+			expected: "PENDING",
+		},
+		{
+			pods:     []*corev1.Pod{runningPod},
+			expected: "RUNNING",
+		},
+		{
+			conditions: []metav1.Condition{{Type: jobsetv1alpha2.JobSetSuspended, Status: metav1.ConditionTrue}},
+			expected:   "SUSPENDED",
+		},
+		{
+			conditions: []metav1.Condition{{Type: jobsetv1alpha2.JobSetCompleted, Status: metav1.ConditionTrue}},
+			pods:       []*corev1.Pod{runningPod},
+			expected:   "SUCCEEDED",
+		},
+		{
+			conditions: []metav1.Condition{{Type: jobsetv1alpha2.JobSetFailed, Status: metav1.ConditionTrue}},
+			expected:   "FAILED",
+		},
+	}
+	for i, tc := range testcases {
+		job := &JobSet{
+			jobSet: &jobsetv1alpha2.JobSet{Status: jobsetv1alpha2.JobSetStatus{Conditions: tc.conditions}},
+			pods:   tc.pods,
+		}
+		if status := job.GetStatus(); status != tc.expected {
+			t.Errorf("case %d: expected status %v, got %v", i, tc.expected, status)
+		}
+	}
+}
+
+func TestGetPodsOfJobSet(t *testing.T) {
+	newPod := func(name, replicatedJob, jobIndex string) *corev1.Pod {
+		return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels: map[string]string{
+				"release":                                  "test",
+				"app":                                      "jobset",
+				"jobset.sigs.k8s.io/jobset-name":           "test",
+				"jobset.sigs.k8s.io/replicatedjob-name":    replicatedJob,
+				"jobset.sigs.k8s.io/job-index":             jobIndex,
+				"batch.kubernetes.io/job-completion-index": "0",
+			},
+		}}
+	}
+	jobSet := &jobsetv1alpha2.JobSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+		Spec: jobsetv1alpha2.JobSetSpec{ReplicatedJobs: []jobsetv1alpha2.ReplicatedJob{
+			{Name: "driver"},
+			{Name: "worker"},
+		}},
+	}
+	otherPod := newPod("other-driver-0-0", "driver", "0")
+	otherPod.Labels["release"] = "other"
+	pods, chiefPod := getPodsOfJobSet(jobSet, []*corev1.Pod{
+		newPod("test-worker-0-0", "worker", "0"),
+		newPod("test-driver-0-0", "driver", "0"),
+		newPod("test-worker-1-0", "worker", "1"),
+		otherPod,
+	})
+	if len(pods) != 3 {
+		t.Errorf("expected 3 pods of jobset, got %v", len(pods))
+	}
+	if chiefPod.Name != "test-driver-0-0" {
+		t.Errorf("expected the first pod of first replicated job is chief pod, got %v", chiefPod.Name)
+	}
+}
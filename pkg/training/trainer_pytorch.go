@@ -82,7 +82,7 @@ func (pj *PyTorchJob) GetLabels() map[string]string {
 	return pj.pytorchjob.Labels
 }
 
-// GetStatus returns the status of the Job i.e. QUEUING, PENDING, RUNNING, SUCCEEDED and FAILED.
+// GetStatus returns the status of the Job i.e. QUEUING, PENDING, RUNNING, SUSPENDED, SUCCEEDED and FAILED.
 func (pj *PyTorchJob) GetStatus() string {
 	status := string(types.TrainingJobPending)
 	defer log.Debugf("Get status of PyTorchJob %s: %s", pj.pytorchjob.Name, status)
@@ -90,7 +90,7 @@ func (tj *TensorFlowJob) GetLabels() map[string]string {
 	return tj.tfjob.Labels
 }
 
-// GetStatus returns the status of the Job i.e. QUEUING, PENDING, RUNNING, SUCCEEDED and FAILED.
+// GetStatus returns the status of the Job i.e. QUEUING, PENDING, RUNNING, SUSPENDED, SUCCEEDED and FAILED.
 func (tj *TensorFlowJob) GetStatus() string {
 	status := string(types.TrainingJobPending)
 	defer log.Debugf("Get status of TFJob %s: %s", tj.tfjob.Name, status)
@@ -433,6 +433,8 @@ func getStatus(status commonv1.JobStatus) string {
 		s = types.TrainingJobSucceeded
 	} else if hasCondition(status, commonv1.JobFailed) {
 		s = types.TrainingJobFailed
+	} else if hasCondition(status, commonv1.JobSuspended) {
+		s = types.TrainingJobSuspended
 	} else if hasCondition(status, commonv1.JobRunning) {
 		s = types.TrainingJobRunning
 	} else if hasCondition(status, commonv1.JobQueuing) {
@@ -0,0 +1,355 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/apis/utils"
+	"github.com/kubeflow/arena/pkg/k8saccesser"
+	trainerv1alpha1 "github.com/kubeflow/arena/pkg/operators/trainer-operator/apis/trainer/v1alpha1"
+)
+
+// trainJobNodeReplicatedJob is the name of replicated job which runs the training nodes in the runtimes
+const trainJobNodeReplicatedJob = "node"
+
+// TrainJob of Kubeflow Trainer v2
+type TrainJob struct {
+	*BasicJobInfo
+	trainJob     *trainerv1alpha1.TrainJob
+	pods         []*corev1.Pod // all the pods including the initializers and the training nodes
+	chiefPod     *corev1.Pod   // the pod of the first training node
+	requestedGPU int64
+	allocatedGPU int64
+	trainerType  types.TrainingJobType // return trainer type: trainjob
+}
+
+// TrainJob implements the TrainingJob interface.
+var _ TrainingJob = &TrainJob{}
+
+// Name returns the TrainJob name
+func (tj *TrainJob) Name() string {
+	return tj.name
+}
+
+func (tj *TrainJob) Uid() string {
+	return string(tj.trainJob.UID)
+}
+
+// Get the chief Pod of the Job.
+func (tj *TrainJob) ChiefPod() *corev1.Pod {
+	return tj.chiefPod
+}
+
+func (tj *TrainJob) Trainer() types.TrainingJobType {
+	return tj.trainerType
+}
+
+// Get all the pods of the Training Job
+func (tj *TrainJob) AllPods() []*corev1.Pod {
+	return tj.pods
+}
+
+func (tj *TrainJob) GetTrainJob() interface{} {
+	return tj.trainJob
+}
+
+func (tj *TrainJob) GetLabels() map[string]string {
+	return tj.trainJob.Labels
+}
+
+// Get the Status of the TrainJob: PENDING, RUNNING, SUSPENDED, SUCCEEDED, FAILED
+func (tj *TrainJob) GetStatus() string {
+	conditions := tj.trainJob.Status.Conditions
+	switch {
+	case meta.IsStatusConditionTrue(conditions, trainerv1alpha1.TrainJobFailed):
+		return string(types.TrainingJobFailed)
+	case meta.IsStatusConditionTrue(conditions, trainerv1alpha1.TrainJobComplete):
+		return string(types.TrainingJobSucceeded)
+	case meta.IsStatusConditionTrue(conditions, trainerv1alpha1.TrainJobSuspended):
+		return string(types.TrainingJobSuspended)
+	}
+	for _, pod := range tj.pods {
+		if pod.Status.Phase == corev1.PodRunning {
+			return string(types.TrainingJobRunning)
+		}
+	}
+	return string(types.TrainingJobPending)
+}
+
+// Get the start time
+func (tj *TrainJob) StartTime() *metav1.Time {
+	return &tj.trainJob.CreationTimestamp
+}
+
+// Get the Job Age
+func (tj *TrainJob) Age() time.Duration {
+	job := tj.trainJob
+
+	// use creation timestamp
+	if job.CreationTimestamp.IsZero() {
+		return 0
+	}
+	return metav1.Now().Sub(job.CreationTimestamp.Time)
+}
+
+// Get the Job Training Duration.
+func (tj *TrainJob) Duration() time.Duration {
+	job := tj.trainJob
+
+	if job.CreationTimestamp.IsZero() {
+		return 0
+	}
+	for _, conditionType := range []string{trainerv1alpha1.TrainJobComplete, trainerv1alpha1.TrainJobFailed} {
+		cond := meta.FindStatusCondition(job.Status.Conditions, conditionType)
+		if cond != nil && cond.Status == metav1.ConditionTrue && !cond.LastTransitionTime.IsZero() {
+			return cond.LastTransitionTime.Sub(job.CreationTimestamp.Time)
+		}
+	}
+	return metav1.Now().Sub(job.CreationTimestamp.Time)
+}
+
+// Get Dashboard url of the job
+func (tj *TrainJob) GetJobDashboards(client *kubernetes.Clientset, namespace, arenaNamespace string) ([]string, error) {
+	urls := []string{}
+	dashboardURL, err := dashboard(client, namespace, "kubernetes-dashboard")
+
+	if err != nil {
+		log.Debugf("Get dashboard failed due to %v", err)
+		// retry for the existing customers, will be deprecated in the future
+		dashboardURL, err = dashboard(client, arenaNamespace, "kubernetes-dashboard")
+		if err != nil {
+			log.Debugf("Get dashboard failed due to %v", err)
+		}
+	}
+
+	if err != nil {
+		log.Debugf("Get dashboard failed due to %v", err)
+		// retry for the existing customers, will be deprecated in the future
+		dashboardURL, err = dashboard(client, "kube-system", "kubernetes-dashboard")
+		if err != nil {
+			log.Debugf("Get dashboard failed due to %v", err)
+		}
+	}
+
+	if dashboardURL == "" {
+		return urls, fmt.Errorf("no LOGVIEWER Installed")
+	}
+
+	if tj.chiefPod == nil || len(tj.chiefPod.Spec.Containers) == 0 {
+		return urls, fmt.Errorf("chief pod is not ready")
+	}
+
+	url := fmt.Sprintf("%s/#!/log/%s/%s/%s?namespace=%s\n",
+		dashboardURL,
+		tj.chiefPod.Namespace,
+		tj.chiefPod.Name,
+		tj.chiefPod.Spec.Containers[0].Name,
+		tj.chiefPod.Namespace)
+
+	urls = append(urls, url)
+
+	return urls, nil
+}
+
+// Requested GPU count of the Job
+func (tj *TrainJob) RequestedGPU() int64 {
+	if tj.requestedGPU > 0 {
+		return tj.requestedGPU
+	}
+	// the pods may be not created, so count the gpus of trainer spec firstly
+	trainer := tj.trainJob.Spec.Trainer
+	if trainer != nil && trainer.ResourcesPerNode != nil {
+		gpus, ok := trainer.ResourcesPerNode.Limits[corev1.ResourceName(types.NvidiaGPUResourceName)]
+		if ok {
+			numNodes := int64(1)
+			if trainer.NumNodes != nil {
+				numNodes = int64(*trainer.NumNodes)
+			}
+			tj.requestedGPU = gpus.Value() * numNodes
+			return tj.requestedGPU
+		}
+	}
+	for _, pod := range tj.pods {
+		tj.requestedGPU += gpuInPod(*pod)
+	}
+	return tj.requestedGPU
+}
+
+// Requested GPU count of the Job
+func (tj *TrainJob) AllocatedGPU() int64 {
+	if tj.allocatedGPU > 0 {
+		return tj.allocatedGPU
+	}
+	for _, pod := range tj.pods {
+		tj.allocatedGPU += gpuInActivePod(*pod)
+	}
+	return tj.allocatedGPU
+}
+
+// Get the hostIP of the chief Pod
+func (tj *TrainJob) HostIPOfChief() (hostIP string) {
+	hostIP = "N/A"
+	if tj.GetStatus() == string(types.TrainingJobRunning) && tj.chiefPod != nil {
+		hostIP = tj.chiefPod.Status.HostIP
+	}
+	return hostIP
+}
+
+func (tj *TrainJob) Namespace() string {
+	return tj.trainJob.Namespace
+}
+
+// Get PriorityClass, the priority class is set by the runtime, so return it of chief pod
+func (tj *TrainJob) GetPriorityClass() string {
+	if tj.chiefPod == nil {
+		return ""
+	}
+	return tj.chiefPod.Spec.PriorityClassName
+}
+
+// TrainJob trainer
+type TrainJobTrainer struct {
+	client        *kubernetes.Clientset
+	dynamicClient dynamic.Interface
+	trainerType   types.TrainingJobType
+	// check if it's enabled
+	enabled bool
+}
+
+// TrainJobTrainer implements the Trainer interface.
+var _ Trainer = &TrainJobTrainer{}
+
+// NewTrainJobTrainer
+func NewTrainJobTrainer() Trainer {
+	enable := false
+	dynamicClient := dynamic.NewForConfigOrDie(config.GetArenaConfiger().GetRestConfig())
+	_, err := config.GetArenaConfiger().GetAPIExtensionClientSet().ApiextensionsV1().CustomResourceDefinitions().Get(context.TODO(), k8saccesser.TrainJobCRDName, metav1.GetOptions{})
+	if err == nil {
+		log.Debugf("TrainJobTrainer is enabled")
+		enable = true
+	} else {
+		log.Debugf("TrainJobTrainer is disabled,reason: %v", err)
+	}
+	log.Debugf("Succeed to init TrainJobTrainer")
+	return &TrainJobTrainer{
+		dynamicClient: dynamicClient,
+		client:        config.GetArenaConfiger().GetClientSet(),
+		trainerType:   types.TrainJob,
+		enabled:       enable,
+	}
+}
+
+// IsEnabled is used to get the trainer is enable or not
+func (tjt *TrainJobTrainer) IsEnabled() bool {
+	return tjt.enabled
+}
+
+// Get the type
+func (tjt *TrainJobTrainer) Type() types.TrainingJobType {
+	return tjt.trainerType
+}
+
+// check if it's trainjob
+func (tjt *TrainJobTrainer) IsSupported(name, ns string) bool {
+	if !tjt.enabled {
+		return false
+	}
+	_, err := tjt.GetTrainingJob(name, ns)
+	return err == nil
+}
+
+// Get the training job from cache or directly
+func (tjt *TrainJobTrainer) GetTrainingJob(name, namespace string) (TrainingJob, error) {
+	trainJob, err := k8saccesser.GetK8sResourceAccesser().GetTrainJob(tjt.dynamicClient, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := CheckJobIsOwnedByTrainer(trainJob.Labels); err != nil {
+		return nil, err
+	}
+	pods, err := k8saccesser.GetK8sResourceAccesser().ListPods(namespace, fmt.Sprintf("%v=%v", utils.JobSetNameLabel, name), "", nil)
+	if err != nil {
+		return nil, err
+	}
+	filterPods, chiefPod := getPodsOfTrainJob(trainJob, pods)
+	return &TrainJob{
+		BasicJobInfo: &BasicJobInfo{
+			resources: podResources(filterPods),
+			name:      name,
+		},
+		trainJob:    trainJob,
+		chiefPod:    chiefPod,
+		pods:        filterPods,
+		trainerType: tjt.Type(),
+	}, nil
+}
+
+func (tjt *TrainJobTrainer) ListTrainingJobs(namespace string, allNamespace bool) ([]TrainingJob, error) {
+	if allNamespace {
+		namespace = metav1.NamespaceAll
+	}
+	trainingJobs := []TrainingJob{}
+	jobLabels := GetTrainingJobLabels(tjt.Type())
+	trainJobs, err := k8saccesser.GetK8sResourceAccesser().ListTrainJobs(tjt.dynamicClient, namespace, jobLabels)
+	if err != nil {
+		return trainingJobs, err
+	}
+	pods, err := k8saccesser.GetK8sResourceAccesser().ListPods(namespace, utils.JobSetNameLabel, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, trainJob := range trainJobs {
+		filterPods, chiefPod := getPodsOfTrainJob(trainJob, pods)
+		trainingJobs = append(trainingJobs, &TrainJob{
+			BasicJobInfo: &BasicJobInfo{
+				resources: podResources(filterPods),
+				name:      trainJob.Name,
+			},
+			trainJob:    trainJob,
+			chiefPod:    chiefPod,
+			pods:        filterPods,
+			trainerType: tjt.Type(),
+		})
+	}
+	return trainingJobs, nil
+}
+
+// isTrainJobChiefPod returns true if the pod is the first pod of the first training node job
+func isTrainJobChiefPod(pod *corev1.Pod) bool {
+	if pod.Labels[utils.JobSetReplicatedJobLabel] != trainJobNodeReplicatedJob {
+		return false
+	}
+	if pod.Labels[utils.JobSetJobIndexLabel] != "0" {
+		return false
+	}
+	return pod.Labels[utils.JobCompletionIndexLabel] == "0"
+}
+
+// filter out all pods and chief pod of TrainJob from pods in current system
+func getPodsOfTrainJob(trainJob *trainerv1alpha1.TrainJob, podList []*corev1.Pod) ([]*corev1.Pod, *corev1.Pod) {
+	return getPodsOfTrainingJob(trainJob.Name, trainJob.Namespace, podList, utils.IsTrainJobPod, isTrainJobChiefPod)
+}
@@ -0,0 +1,92 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	trainerv1alpha1 "github.com/kubeflow/arena/pkg/operators/trainer-operator/apis/trainer/v1alpha1"
+)
+
+func TestTrainJobGetStatus(t *testing.T) {
+	runningPod := &corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodRunning}}
+	pendingPod := &corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodPending}}
+	testcases := []struct {
+		conditions []metav1.Condition
+		pods       []*corev1.Pod
+		expected   string
+	}{
+		{
+			conditions: []metav1.Condition{{Type: trainerv1alpha1.TrainJobCreated, Status: metav1.ConditionTrue}},
+			pods:       []*corev1.Pod{pendingPod},
+			expected:   "PENDING",
+		},
+		{
+			conditions: []metav1.Condition{{Type: trainerv1alpha1.TrainJobCreated, Status: metav1.ConditionTrue}},
+			pods:       []*corev1.Pod{pendingPod, runningPod},
+			expected:   "RUNNING",
+		},
+		{
+			conditions: []metav1.Condition{{Type: trainerv1alpha1.TrainJobSuspended, Status: metav1.ConditionTrue}},
+			expected:   "SUSPENDED",
+		},
+		{
+			conditions: []metav1.Condition{
+				{Type: trainerv1alpha1.TrainJobCreated, Status: metav1.ConditionTrue},
+				{Type: trainerv1alpha1.TrainJobComplete, Status: metav1.ConditionTrue},
+			},
+			pods:     []*corev1.Pod{runningPod},
+			expected: "SUCCEEDED",
+		},
+		{
+			conditions: []metav1.Condition{
+				{Type: trainerv1alpha1.TrainJobCreated, Status: metav1.ConditionTrue},
+				{Type: trainerv1alpha1.TrainJobFailed, Status: metav1.ConditionTrue},
+			},
+			expected: "FAILED",
+		},
+	}
+	for i, tc := range testcases {
+		job := &TrainJob{
+			trainJob: &trainerv1alpha1.TrainJob{Status: trainerv1alpha1.TrainJobStatus{Conditions: tc.conditions}},
+			pods:     tc.pods,
+		}
+		if status := job.GetStatus(); status != tc.expected {
+			t.Errorf("case %d: expected status %v, got %v", i, tc.expected, status)
+		}
+	}
+}
+
+func TestIsTrainJobChiefPod(t *testing.T) {
+	newPod := func(replicatedJob, jobIndex, completionIndex string) *corev1.Pod {
+		return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{
+			"jobset.sigs.k8s.io/replicatedjob-name":    replicatedJob,
+			"jobset.sigs.k8s.io/job-index":             jobIndex,
+			"batch.kubernetes.io/job-completion-index": completionIndex,
+		}}}
+	}
+	if !isTrainJobChiefPod(newPod("node", "0", "0")) {
+		t.Errorf("the first pod of node should be chief pod")
+	}
+	if isTrainJobChiefPod(newPod("node", "0", "1")) {
+		t.Errorf("the second pod of node should not be chief pod")
+	}
+	if isTrainJobChiefPod(newPod("dataset-initializer", "0", "0")) {
+		t.Errorf("the pod of initializer should not be chief pod")
+	}
+}
@@ -0,0 +1,230 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/apis/utils"
+	etv1alpha1 "github.com/kubeflow/arena/pkg/operators/et-operator/api/v1alpha1"
+	jobsetv1alpha2 "github.com/kubeflow/arena/pkg/operators/jobset-operator/apis/jobset/v1alpha2"
+	mpiv1alpha1 "github.com/kubeflow/arena/pkg/operators/mpi-operator/apis/kubeflow/v1alpha1"
+	pytorchv1 "github.com/kubeflow/arena/pkg/operators/pytorch-operator/apis/pytorch/v1"
+	sparkv1beta2 "github.com/kubeflow/arena/pkg/operators/spark-operator/apis/sparkoperator.k8s.io/v1beta2"
+	tfv1 "github.com/kubeflow/arena/pkg/operators/tf-operator/apis/tensorflow/v1"
+	trainerv1alpha1 "github.com/kubeflow/arena/pkg/operators/trainer-operator/apis/trainer/v1alpha1"
+	volcanov1alpha1 "github.com/kubeflow/arena/pkg/operators/volcano-operator/apis/batch/v1alpha1"
+	"github.com/kubeflow/arena/pkg/util"
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+)
+
+// waitResyncPeriod is the resync period of the informers used by WaitTrainingJob,
+// the job is checked again after it in case the status of job is changed without any event of pods
+const waitResyncPeriod = 30 * time.Second
+
+// trainingJobResources stores the custom resources of training jobs which are watched when waiting
+var trainingJobResources = map[types.TrainingJobType]schema.GroupVersionResource{
+	types.TFTrainingJob:        tfv1.SchemeGroupVersion.WithResource("tfjobs"),
+	types.MPITrainingJob:       mpiv1alpha1.SchemeGroupVersion.WithResource("mpijobs"),
+	types.PytorchTrainingJob:   pytorchv1.SchemeGroupVersion.WithResource("pytorchjobs"),
+	types.ETTrainingJob:        etv1alpha1.SchemeGroupVersion.WithResource("trainingjobs"),
+	types.DeepSpeedTrainingJob: etv1alpha1.SchemeGroupVersion.WithResource("trainingjobs"),
+	types.VolcanoTrainingJob:   volcanov1alpha1.SchemeGroupVersion.WithResource("jobs"),
+	types.SparkTrainingJob:     sparkv1beta2.SchemeGroupVersion.WithResource("sparkapplications"),
+	types.RayJob:               rayv1.GroupVersion.WithResource("rayjobs"),
+	types.TrainJob:             trainerv1alpha1.SchemeGroupVersion.WithResource("trainjobs"),
+	types.JobSetTrainingJob:    jobsetv1alpha2.SchemeGroupVersion.WithResource("jobsets"),
+}
+
+// WaitTrainingJob blocks until the training job meets the condition or reaches a terminal state,
+// the job and its pods are watched by informers and the job is checked again when they are changed
+func WaitTrainingJob(ctx context.Context, name, namespace string, jobType types.TrainingJobType, condition types.TrainingJobWaitCondition) (*types.TrainingJobWaitResult, error) {
+	if condition != types.WaitForSucceeded && condition != types.WaitForFailed && condition != types.WaitForRunning {
+		return nil, fmt.Errorf("unknown wait condition %v, only support: [%v,%v,%v]", condition, types.WaitForSucceeded, types.WaitForFailed, types.WaitForRunning)
+	}
+	job, err := SearchTrainingJob(name, namespace, jobType)
+	if err != nil {
+		return nil, err
+	}
+	if result, done := checkWaitCondition(job, condition); done {
+		return withExportedLogs(job, result), nil
+	}
+	jobType = job.Trainer()
+
+	changed := make(chan struct{}, 1)
+	notify := func() {
+		select {
+		case changed <- struct{}{}:
+		default:
+		}
+	}
+	handler := cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj interface{}) { notify() },
+		UpdateFunc: func(oldObj, newObj interface{}) { notify() },
+		DeleteFunc: func(obj interface{}) { notify() },
+	}
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	if err := startWaitInformers(name, namespace, jobType, handler, stopCh); err != nil {
+		return nil, err
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return nil, fmt.Errorf("timed out waiting for the training job %v to be %v, the current status is %v", name, condition, job.GetStatus())
+			}
+			return nil, fmt.Errorf("canceled waiting for the training job %v to be %v, the current status is %v", name, condition, job.GetStatus())
+		case <-changed:
+		}
+		job, err = SearchTrainingJob(name, namespace, jobType)
+		if err != nil {
+			if err == types.ErrTrainingJobNotFound {
+				return nil, fmt.Errorf("the training job %v is deleted when waiting for it to be %v", name, condition)
+			}
+			return nil, err
+		}
+		if result, done := checkWaitCondition(job, condition); done {
+			return withExportedLogs(job, result), nil
+		}
+	}
+}
+
+// withExportedLogs exports the logs of the finished job if it is submitted with --export-logs-to
+func withExportedLogs(job TrainingJob, result *types.TrainingJobWaitResult) *types.TrainingJobWaitResult {
+	if result.Status == types.TrainingJobSucceeded || result.Status == types.TrainingJobFailed {
+		result.LogsExport = exportLogsOnWait(job)
+	}
+	return result
+}
+
+// startWaitInformers starts the informers of the pods and the custom resource of the training job
+func startWaitInformers(name, namespace string, jobType types.TrainingJobType, handler cache.ResourceEventHandler, stopCh chan struct{}) error {
+	arenaConfiger := config.GetArenaConfiger()
+	podInformerFactory := informers.NewSharedInformerFactoryWithOptions(
+		arenaConfiger.GetClientSet(),
+		waitResyncPeriod,
+		informers.WithNamespace(namespace),
+		informers.WithTweakListOptions(func(options *metav1.ListOptions) {
+			options.LabelSelector = waitPodLabelSelector(name, jobType)
+		}),
+	)
+	if _, err := podInformerFactory.Core().V1().Pods().Informer().AddEventHandler(handler); err != nil {
+		return err
+	}
+	podInformerFactory.Start(stopCh)
+
+	gvr, ok := trainingJobResources[jobType]
+	if !ok {
+		log.Debugf("the custom resource of %v is unknown, only watch the pods of job %v", jobType, name)
+		return nil
+	}
+	dynamicClient, err := dynamic.NewForConfig(arenaConfiger.GetRestConfig())
+	if err != nil {
+		return err
+	}
+	jobInformerFactory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(
+		dynamicClient,
+		waitResyncPeriod,
+		namespace,
+		func(options *metav1.ListOptions) {
+			options.FieldSelector = fields.OneTermEqualSelector("metadata.name", name).String()
+		},
+	)
+	if _, err := jobInformerFactory.ForResource(gvr).Informer().AddEventHandler(handler); err != nil {
+		return err
+	}
+	jobInformerFactory.Start(stopCh)
+	return nil
+}
+
+// waitPodLabelSelector returns the label selector of the pods of training job,
+// the pods of trainjob are created by the jobset and have no release label
+func waitPodLabelSelector(name string, jobType types.TrainingJobType) string {
+	if jobType == types.TrainJob {
+		return fmt.Sprintf("%v=%v", utils.JobSetNameLabel, name)
+	}
+	return fmt.Sprintf("release=%v", name)
+}
+
+// checkWaitCondition returns true if the job meets the condition or can not meet it any more
+func checkWaitCondition(job TrainingJob, condition types.TrainingJobWaitCondition) (*types.TrainingJobWaitResult, bool) {
+	status := types.TrainingJobStatus(job.GetStatus())
+	var met, done bool
+	switch status {
+	case types.TrainingJobSucceeded:
+		met = condition == types.WaitForSucceeded
+		done = true
+	case types.TrainingJobFailed:
+		met = condition == types.WaitForFailed
+		done = true
+	case types.TrainingJobRunning:
+		met = condition == types.WaitForRunning
+		done = met
+	}
+	log.Debugf("the status of training job %v is %v, wait for %v, condition met: %v", job.Name(), status, condition, met)
+	if !done {
+		return nil, false
+	}
+	return &types.TrainingJobWaitResult{
+		Name:           job.Name(),
+		Namespace:      job.Namespace(),
+		Trainer:        job.Trainer(),
+		Condition:      condition,
+		ConditionMet:   met,
+		Status:         status,
+		ChiefExitCodes: getContainerExitCodes(job.ChiefPod()),
+		Duration:       util.ShortHumanDuration(job.Duration()),
+	}, true
+}
+
+// getContainerExitCodes returns the exit codes of the terminated containers in the pod
+func getContainerExitCodes(pod *corev1.Pod) []types.ContainerExitCode {
+	exitCodes := []types.ContainerExitCode{}
+	if pod == nil {
+		return exitCodes
+	}
+	for _, status := range pod.Status.ContainerStatuses {
+		terminated := status.State.Terminated
+		if terminated == nil {
+			terminated = status.LastTerminationState.Terminated
+		}
+		if terminated == nil {
+			continue
+		}
+		exitCodes = append(exitCodes, types.ContainerExitCode{
+			Pod:       pod.Name,
+			Container: status.Name,
+			ExitCode:  terminated.ExitCode,
+			Reason:    terminated.Reason,
+		})
+	}
+	return exitCodes
+}
@@ -0,0 +1,86 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+	pytorchv1 "github.com/kubeflow/arena/pkg/operators/pytorch-operator/apis/pytorch/v1"
+	commonv1 "github.com/kubeflow/arena/pkg/operators/tf-operator/apis/common/v1"
+)
+
+func newWaitTestJob(conditionType commonv1.JobConditionType) *PyTorchJob {
+	return &PyTorchJob{
+		BasicJobInfo: &BasicJobInfo{name: "demo"},
+		pytorchjob: &pytorchv1.PyTorchJob{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default"},
+			Status: commonv1.JobStatus{
+				Conditions: []commonv1.JobCondition{
+					{Type: conditionType, Status: corev1.ConditionTrue},
+				},
+			},
+		},
+		chiefPod: &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo-master-0"},
+			Status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{
+					{
+						Name: "pytorch",
+						State: corev1.ContainerState{
+							Terminated: &corev1.ContainerStateTerminated{ExitCode: 137, Reason: "OOMKilled"},
+						},
+					},
+				},
+			},
+		},
+		trainerType: types.PytorchTrainingJob,
+	}
+}
+
+func TestCheckWaitCondition(t *testing.T) {
+	testcases := []struct {
+		conditionType commonv1.JobConditionType
+		condition     types.TrainingJobWaitCondition
+		done          bool
+		met           bool
+	}{
+		{commonv1.JobRunning, types.WaitForSucceeded, false, false},
+		{commonv1.JobRunning, types.WaitForRunning, true, true},
+		{commonv1.JobSucceeded, types.WaitForSucceeded, true, true},
+		{commonv1.JobSucceeded, types.WaitForRunning, true, false},
+		{commonv1.JobFailed, types.WaitForSucceeded, true, false},
+		{commonv1.JobFailed, types.WaitForFailed, true, true},
+	}
+	for _, testcase := range testcases {
+		result, done := checkWaitCondition(newWaitTestJob(testcase.conditionType), testcase.condition)
+		if done != testcase.done {
+			t.Errorf("%v/%v: expected done %v, got %v", testcase.conditionType, testcase.condition, testcase.done, done)
+			continue
+		}
+		if !done {
+			continue
+		}
+		if result.ConditionMet != testcase.met {
+			t.Errorf("%v/%v: expected condition met %v, got %v", testcase.conditionType, testcase.condition, testcase.met, result.ConditionMet)
+		}
+		if len(result.ChiefExitCodes) != 1 || result.ChiefExitCodes[0].ExitCode != 137 {
+			t.Errorf("%v/%v: unexpected exit codes %+v", testcase.conditionType, testcase.condition, result.ChiefExitCodes)
+		}
+	}
+}
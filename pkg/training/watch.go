@@ -0,0 +1,164 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/k8saccesser"
+	etv1alpha1 "github.com/kubeflow/arena/pkg/operators/et-operator/api/v1alpha1"
+	jobsetv1alpha2 "github.com/kubeflow/arena/pkg/operators/jobset-operator/apis/jobset/v1alpha2"
+	mpiv1alpha1 "github.com/kubeflow/arena/pkg/operators/mpi-operator/apis/kubeflow/v1alpha1"
+	pytorchv1 "github.com/kubeflow/arena/pkg/operators/pytorch-operator/apis/pytorch/v1"
+	sparkv1beta2 "github.com/kubeflow/arena/pkg/operators/spark-operator/apis/sparkoperator.k8s.io/v1beta2"
+	tfv1 "github.com/kubeflow/arena/pkg/operators/tf-operator/apis/tensorflow/v1"
+	trainerv1alpha1 "github.com/kubeflow/arena/pkg/operators/trainer-operator/apis/trainer/v1alpha1"
+	volcanov1alpha1 "github.com/kubeflow/arena/pkg/operators/volcano-operator/apis/batch/v1alpha1"
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+)
+
+// watchCoalescePeriod is the period to collect the changes of resources before listing jobs again
+const watchCoalescePeriod = 500 * time.Millisecond
+
+// trainingJobObjects stores the custom resources of training jobs which are watched in watch mode
+var trainingJobObjects = map[types.TrainingJobType]func() client.Object{
+	types.TFTrainingJob:        func() client.Object { return &tfv1.TFJob{} },
+	types.MPITrainingJob:       func() client.Object { return &mpiv1alpha1.MPIJob{} },
+	types.PytorchTrainingJob:   func() client.Object { return &pytorchv1.PyTorchJob{} },
+	types.ETTrainingJob:        func() client.Object { return &etv1alpha1.TrainingJob{} },
+	types.DeepSpeedTrainingJob: func() client.Object { return &etv1alpha1.TrainingJob{} },
+	types.VolcanoTrainingJob:   func() client.Object { return &volcanov1alpha1.Job{} },
+	types.SparkTrainingJob:     func() client.Object { return &sparkv1beta2.SparkApplication{} },
+	types.RayJob:               func() client.Object { return &rayv1.RayJob{} },
+	types.TrainJob:             func() client.Object { return &trainerv1alpha1.TrainJob{} },
+	types.JobSetTrainingJob:    func() client.Object { return &jobsetv1alpha2.JobSet{} },
+}
+
+// WatchTrainingJobs emits the ADDED, MODIFIED and DELETED events of training jobs until the context is done,
+// all existing jobs are emitted as ADDED at first. The jobs are listed from the cache of k8saccesser again
+// when the jobs or their pods are changed, so the cache must be enabled
+func WatchTrainingJobs(ctx context.Context, namespace string, allNamespaces bool, jobType types.TrainingJobType, handler func(*types.TrainingJobEvent)) error {
+	accesser := k8saccesser.GetK8sResourceAccesser()
+	if accesser == nil || !accesser.IsCacheEnabled() {
+		return fmt.Errorf("watching training jobs requires the cache of arena client, please create the arena client in daemon mode")
+	}
+	objs := []client.Object{&corev1.Pod{}}
+	for trainerType, trainer := range GetAllTrainers() {
+		newObj, ok := trainingJobObjects[trainerType]
+		if !ok || !trainer.IsEnabled() || !isNeededTrainingType(trainerType, jobType) {
+			continue
+		}
+		objs = append(objs, newObj())
+	}
+	changed, err := accesser.NotifyOnChange(ctx, objs...)
+	if err != nil {
+		return err
+	}
+	lastJobs := map[string]*types.TrainingJobInfo{}
+	lastFingerprints := map[string]string{}
+	for {
+		jobs, err := ListTrainingJobs(namespace, allNamespaces, jobType)
+		if err != nil {
+			return err
+		}
+		services, nodes := PrepareServicesAndNodesForTensorboard(jobs, allNamespaces)
+		currentJobs := map[string]*types.TrainingJobInfo{}
+		for _, job := range jobs {
+			jobInfo := BuildJobInfo(job, false, services, nodes)
+			key := fmt.Sprintf("%v/%v/%v", jobInfo.Namespace, jobInfo.Trainer, jobInfo.Name)
+			currentJobs[key] = jobInfo
+			fingerprint := trainingJobFingerprint(jobInfo)
+			lastFingerprint, ok := lastFingerprints[key]
+			lastFingerprints[key] = fingerprint
+			switch {
+			case !ok:
+				handler(&types.TrainingJobEvent{Type: types.JobAdded, Job: jobInfo})
+			case lastFingerprint != fingerprint:
+				handler(&types.TrainingJobEvent{Type: types.JobModified, Job: jobInfo})
+			}
+		}
+		deletedKeys := []string{}
+		for key := range lastJobs {
+			if _, ok := currentJobs[key]; !ok {
+				deletedKeys = append(deletedKeys, key)
+			}
+		}
+		sort.Strings(deletedKeys)
+		for _, key := range deletedKeys {
+			handler(&types.TrainingJobEvent{Type: types.JobDeleted, Job: lastJobs[key]})
+			delete(lastFingerprints, key)
+		}
+		lastJobs = currentJobs
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-changed:
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(watchCoalescePeriod):
+		}
+	}
+}
+
+// trainingJobFingerprint returns a string which is changed only if the job is changed,
+// the durations and ages which are changed over time are ignored
+func trainingJobFingerprint(jobInfo *types.TrainingJobInfo) string {
+	copyJobInfo := *jobInfo
+	copyJobInfo.Duration = ""
+	copyJobInfo.Instances = []types.TrainingJobInstance{}
+	for _, instance := range jobInfo.Instances {
+		instance.Age = ""
+		instance.GPUMetrics = nil
+		copyJobInfo.Instances = append(copyJobInfo.Instances, instance)
+	}
+	data, err := json.Marshal(copyJobInfo)
+	if err != nil {
+		log.Debugf("failed to marshal the job info of %v: %v", jobInfo.Name, err)
+	}
+	return string(data)
+}
+
+// PrintTrainingJobEventHeader prints the header of the training job event table
+func PrintTrainingJobEventHeader(w io.Writer, allNamespaces bool) {
+	PrintLine(w, append([]string{"EVENT"}, trainingJobListHeader(allNamespaces)...)...)
+}
+
+// PrintTrainingJobEvent prints the training job event as a table row
+// or a line of json if the format is json
+func PrintTrainingJobEvent(w io.Writer, event *types.TrainingJobEvent, format types.FormatStyle, allNamespaces bool) error {
+	if format == types.JsonFormat {
+		data, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(data))
+		return err
+	}
+	PrintLine(w, append([]string{string(event.Type)}, trainingJobListRow(event.Job, allNamespaces)...)...)
+	return nil
+}
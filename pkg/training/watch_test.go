@@ -0,0 +1,44 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"testing"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+func TestTrainingJobFingerprint(t *testing.T) {
+	jobInfo := &types.TrainingJobInfo{
+		Name:     "demo",
+		Status:   types.TrainingJobRunning,
+		Duration: "10s",
+		Instances: []types.TrainingJobInstance{
+			{Name: "demo-master-0", Status: "Running", Age: "10s"},
+		},
+	}
+	fingerprint := trainingJobFingerprint(jobInfo)
+
+	jobInfo.Duration = "20s"
+	jobInfo.Instances[0].Age = "20s"
+	if trainingJobFingerprint(jobInfo) != fingerprint {
+		t.Error("expected the fingerprint not to be changed by duration and age")
+	}
+
+	jobInfo.Status = types.TrainingJobSucceeded
+	if trainingJobFingerprint(jobInfo) == fingerprint {
+		t.Error("expected the fingerprint to be changed by status")
+	}
+}
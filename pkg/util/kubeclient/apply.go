@@ -0,0 +1,439 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubeclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/restmapper"
+
+	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+// fieldManager is the field manager of the objects applied by arena
+const fieldManager = "arena"
+
+// GetTrainingJobCRDName returns the resource name (kind.group) of the training job type,
+// like it is printed by kubectl. Returns empty string if the training type is not supported
+func GetTrainingJobCRDName(trainingType string) string {
+	switch trainingType {
+	case string(types.TFTrainingJob):
+		return "tfjob.kubeflow.org"
+	case string(types.PytorchTrainingJob):
+		return "pytorchjob.kubeflow.org"
+	case string(types.MPITrainingJob), string(types.HorovodTrainingJob):
+		return "mpijob.kubeflow.org"
+	case string(types.ETTrainingJob), string(types.DeepSpeedTrainingJob):
+		return "trainingjob.kai.alibabacloud.com"
+	case string(types.VolcanoTrainingJob):
+		return "job.batch.volcano.sh"
+	case string(types.SparkTrainingJob):
+		return "sparkapplication.sparkoperator.k8s.io"
+	case string(types.RayJob):
+		return "rayjob.ray.io"
+	case string(types.TrainJob):
+		return "trainjob.trainer.kubeflow.org"
+	case string(types.JobSetTrainingJob):
+		return "jobset.jobset.x-k8s.io"
+	default:
+		return ""
+	}
+}
+
+// ParseManifests parses the rendered multi-document yaml (or json) into objects,
+// the List objects are flattened into their items
+func ParseManifests(data []byte) ([]*unstructured.Unstructured, error) {
+	objs := []*unstructured.Unstructured{}
+	decoder := utilyaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), 4096)
+	for {
+		content := map[string]interface{}{}
+		err := decoder.Decode(&content)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse manifests: %v", err)
+		}
+		if len(content) == 0 {
+			continue
+		}
+		obj := &unstructured.Unstructured{Object: content}
+		if obj.GetKind() == "" || obj.GetAPIVersion() == "" {
+			return nil, fmt.Errorf("failed to parse manifests: object %v has no kind or apiVersion", obj.GetName())
+		}
+		if !obj.IsList() {
+			objs = append(objs, obj)
+			continue
+		}
+		err = obj.EachListItem(func(item runtime.Object) error {
+			objs = append(objs, item.(*unstructured.Unstructured))
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return objs, nil
+}
+
+// AppInfoOf returns the app info of the object in the format of kubectl output,
+// like "service/foo" or "pytorchjob.kubeflow.org/foo"
+func AppInfoOf(obj *unstructured.Unstructured) string {
+	gvk := obj.GroupVersionKind()
+	resource := strings.ToLower(gvk.Kind)
+	if gvk.Group != "" {
+		resource = fmt.Sprintf("%v.%v", resource, gvk.Group)
+	}
+	return fmt.Sprintf("%v/%v", resource, obj.GetName())
+}
+
+/**
+* generate kubernetes App Info for delete in future without kubectl binary,
+* the content is same as kubectl.SaveAppInfo
+**/
+func SaveAppInfo(fileName, namespace string) (configFileName string, err error) {
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return "", err
+	}
+	objs, err := ParseManifests(data)
+	if err != nil {
+		return "", err
+	}
+	result := []string{}
+	for _, obj := range objs {
+		result = append(result, AppInfoOf(obj))
+	}
+
+	configFile, err := os.CreateTemp("", "config")
+	if err != nil {
+		log.Errorf("Failed to create tmp file due to %v", err)
+		return "", err
+	}
+	defer configFile.Close()
+	configFileName = configFile.Name()
+	log.Debugf("Save the config file %s", configFileName)
+
+	_, err = configFile.Write([]byte(strings.Join(result, "\n")))
+	if err != nil {
+		log.Errorf("Failed to write app info to %s due to %v", configFileName, err)
+		return configFileName, err
+	}
+	return configFileName, nil
+}
+
+/**
+* Apply kubernetes config to install app by server-side apply,
+* the output is same as 'kubectl apply --server-side'
+**/
+func InstallApps(fileName, namespace string) (output string, err error) {
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return "", err
+	}
+	objs, err := ParseManifests(data)
+	if err != nil {
+		return "", err
+	}
+	applier, err := newAppClient()
+	if err != nil {
+		return "", err
+	}
+	lines := []string{}
+	errs := []string{}
+	for _, obj := range objs {
+		if _, err := applier.apply(obj, namespace, false); err != nil {
+			log.Debugf("Failed to apply %v due to %v", AppInfoOf(obj), err)
+			errs = append(errs, err.Error())
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("%v serverside-applied\n", AppInfoOf(obj)))
+	}
+	output = strings.Join(lines, "")
+	if len(errs) != 0 {
+		return output, fmt.Errorf("%v", strings.Join(errs, "\n"))
+	}
+	return output, nil
+}
+
+// DryRunApps validates the objects of the file by server-side apply with dry run,
+// returns the objects which are returned by the api server in yaml format
+func DryRunApps(fileName, namespace string) (string, error) {
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return "", err
+	}
+	objs, err := ParseManifests(data)
+	if err != nil {
+		return "", err
+	}
+	applier, err := newAppClient()
+	if err != nil {
+		return "", err
+	}
+	docs := []string{}
+	errs := []string{}
+	for _, obj := range objs {
+		result, err := applier.apply(obj, namespace, true)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%v: %v", AppInfoOf(obj), err))
+			continue
+		}
+		result.SetManagedFields(nil)
+		content, err := yaml.Marshal(result.Object)
+		if err != nil {
+			return "", err
+		}
+		docs = append(docs, "---\n"+string(content))
+	}
+	if len(errs) != 0 {
+		return strings.Join(docs, ""), fmt.Errorf("%v", strings.Join(errs, "\n"))
+	}
+	return strings.Join(docs, ""), nil
+}
+
+/**
+* Delete the resources listed in the app info file to uninstall app,
+* the NotFound errors are ignored
+**/
+func UninstallAppsWithAppInfoFile(appInfoFile, namespace string) error {
+	data, err := os.ReadFile(appInfoFile)
+	if err != nil {
+		return err
+	}
+	return UninstallAppsWithAppInfo(string(data), namespace)
+}
+
+// UninstallAppsWithAppInfo deletes the resources of the app info which is stored in the app configmap
+func UninstallAppsWithAppInfo(appInfo, namespace string) error {
+	client, err := newAppClient()
+	if err != nil {
+		return err
+	}
+	var wg sync.WaitGroup
+	locker := new(sync.Mutex)
+	errs := []string{}
+	for _, r := range strings.Split(appInfo, "\n") {
+		resource := strings.TrimSpace(r)
+		if resource == "" {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := client.delete(resource, namespace)
+			if err != nil && !k8serrors.IsNotFound(err) {
+				locker.Lock()
+				errs = append(errs, err.Error())
+				locker.Unlock()
+				return
+			}
+			log.Debugf("%v deleted", resource)
+		}()
+	}
+	wg.Wait()
+	if len(errs) != 0 {
+		log.Debugf("Failed to uninstall app with app info,reason: %v", errs)
+		return fmt.Errorf("%v", strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// PatchOwnerReferenceWithAppInfoFile patch training job ownerReference for all resources created by Arena
+func PatchOwnerReferenceWithAppInfoFile(name, trainingType, appInfoFile, namespace string) error {
+	data, err := os.ReadFile(appInfoFile)
+	if err != nil {
+		return err
+	}
+	resources := strings.Split(string(data), "\n")
+
+	// cron tfjob skip patch ownerReference
+	if len(resources) == 1 && resources[0] == "cron.apps.kubedl.io/"+name {
+		log.Debugf("resource: %s is cron tfjob, skip patch ownerReference", resources[0])
+		return nil
+	}
+
+	crdResourceName := GetTrainingJobCRDName(trainingType)
+	if crdResourceName == "" {
+		return fmt.Errorf("unsupported training job type: %s", trainingType)
+	}
+	client, err := newAppClient()
+	if err != nil {
+		return err
+	}
+	trainingJobResourceName := fmt.Sprintf("%s/%s", crdResourceName, name)
+	job, err := client.get(trainingJobResourceName, namespace)
+	if err != nil {
+		return fmt.Errorf("failed to get training job: %v", err)
+	}
+	patch, err := json.Marshal([]map[string]interface{}{
+		{
+			"op":   "add",
+			"path": "/metadata/ownerReferences",
+			"value": []metav1.OwnerReference{
+				*metav1.NewControllerRef(job, job.GroupVersionKind()),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	// add configmap
+	resources = append(resources, fmt.Sprintf("configmap/%v-%v", name, trainingType))
+	errs := []string{}
+	for _, resource := range resources {
+		// skip the training job CRD itself
+		if resource == "" || resource == trainingJobResourceName {
+			continue
+		}
+		if err := client.patch(resource, namespace, patch); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) != 0 {
+		log.Debugf("Failed to patch ownerReference,reason: %v", errs)
+		return fmt.Errorf("%v", strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// appClient operates the resources of apps by the dynamic client,
+// the resources are resolved by the discovery of api server
+type appClient struct {
+	dynamicClient dynamic.Interface
+	mapper        meta.RESTMapper
+}
+
+func newAppClient() (*appClient, error) {
+	restConfig := config.GetArenaConfiger().GetRestConfig()
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+	dynamicClient, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &appClient{
+		dynamicClient: dynamicClient,
+		mapper:        restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(discoveryClient)),
+	}, nil
+}
+
+// resourceInterface returns the dynamic client of the resource type,
+// namespace is ignored for the cluster scoped resources
+func (c *appClient) resourceInterface(mapping *meta.RESTMapping, namespace string) dynamic.ResourceInterface {
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		return c.dynamicClient.Resource(mapping.Resource).Namespace(namespace)
+	}
+	return c.dynamicClient.Resource(mapping.Resource)
+}
+
+// resolve parses the app info like "deployment.apps/foo" to the rest mapping and name
+func (c *appClient) resolve(resource string) (*meta.RESTMapping, string, error) {
+	items := strings.SplitN(resource, "/", 2)
+	if len(items) != 2 || items[1] == "" {
+		return nil, "", fmt.Errorf("invalid resource %v, it should be <type>/<name>", resource)
+	}
+	groupResource := schema.ParseGroupResource(items[0])
+	gvk, err := c.mapper.KindFor(groupResource.WithVersion(""))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to find the resource type of %v: %v", resource, err)
+	}
+	mapping, err := c.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, "", err
+	}
+	return mapping, items[1], nil
+}
+
+// apply creates or updates the object by server-side apply, the object is not persisted if dryRun is true
+func (c *appClient) apply(obj *unstructured.Unstructured, namespace string, dryRun bool) (*unstructured.Unstructured, error) {
+	gvk := obj.GroupVersionKind()
+	mapping, err := c.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find the resource type of %v: %v", AppInfoOf(obj), err)
+	}
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		if obj.GetNamespace() == "" {
+			obj.SetNamespace(namespace)
+		}
+		namespace = obj.GetNamespace()
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	force := true
+	options := metav1.PatchOptions{
+		FieldManager: fieldManager,
+		Force:        &force,
+	}
+	if dryRun {
+		options.DryRun = []string{metav1.DryRunAll}
+	}
+	return c.resourceInterface(mapping, namespace).Patch(context.TODO(), obj.GetName(), k8stypes.ApplyPatchType, data, options)
+}
+
+func (c *appClient) get(resource, namespace string) (*unstructured.Unstructured, error) {
+	mapping, name, err := c.resolve(resource)
+	if err != nil {
+		return nil, err
+	}
+	return c.resourceInterface(mapping, namespace).Get(context.TODO(), name, metav1.GetOptions{})
+}
+
+func (c *appClient) delete(resource, namespace string) error {
+	mapping, name, err := c.resolve(resource)
+	if err != nil {
+		return err
+	}
+	propagationPolicy := metav1.DeletePropagationBackground
+	return c.resourceInterface(mapping, namespace).Delete(context.TODO(), name, metav1.DeleteOptions{
+		PropagationPolicy: &propagationPolicy,
+	})
+}
+
+func (c *appClient) patch(resource, namespace string, patch []byte) error {
+	mapping, name, err := c.resolve(resource)
+	if err != nil {
+		return err
+	}
+	_, err = c.resourceInterface(mapping, namespace).Patch(context.TODO(), name, k8stypes.JSONPatchType, patch, metav1.PatchOptions{})
+	return err
+}
@@ -0,0 +1,74 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubeclient
+
+import (
+	"testing"
+)
+
+const renderedManifests = `
+---
+# Source: pytorchjob/templates/service.yaml
+apiVersion: v1
+kind: Service
+metadata:
+  name: test-tensorboard
+  namespace: default
+---
+# Source: pytorchjob/templates/empty.yaml
+---
+apiVersion: kubeflow.org/v1
+kind: PyTorchJob
+metadata:
+  name: test
+---
+apiVersion: v1
+kind: List
+items:
+- apiVersion: apps/v1
+  kind: Deployment
+  metadata:
+    name: test-deploy
+`
+
+func TestParseManifests(t *testing.T) {
+	objs, err := ParseManifests([]byte(renderedManifests))
+	if err != nil {
+		t.Fatalf("failed to parse manifests: %v", err)
+	}
+	expected := []string{
+		"service/test-tensorboard",
+		"pytorchjob.kubeflow.org/test",
+		"deployment.apps/test-deploy",
+	}
+	if len(objs) != len(expected) {
+		t.Fatalf("expected %d objects, got %d", len(expected), len(objs))
+	}
+	for i, obj := range objs {
+		if appInfo := AppInfoOf(obj); appInfo != expected[i] {
+			t.Errorf("expected app info %v, got %v", expected[i], appInfo)
+		}
+	}
+	if GetTrainingJobCRDName("pytorchjob")+"/test" != AppInfoOf(objs[1]) {
+		t.Errorf("app info of training job does not match its crd name")
+	}
+}
+
+func TestParseManifestsWithoutKind(t *testing.T) {
+	_, err := ParseManifests([]byte("metadata:\n  name: test\n"))
+	if err == nil {
+		t.Errorf("expected error for the object without kind")
+	}
+}
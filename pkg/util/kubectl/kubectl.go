@@ -33,7 +33,7 @@ import (
 	lwsClient "sigs.k8s.io/lws/client-go/clientset/versioned"
 
 	"github.com/kubeflow/arena/pkg/apis/config"
-	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/util/kubeclient"
 )
 
 var kubectlCmd = []string{"arena-kubectl"}
@@ -386,32 +386,9 @@ func UpdateLWSJob(lwsJob *lwsv1.LeaderWorkerSet) error {
 	return err
 }
 
-// getTrainingJobCRDName returns the CRD resource name for a given training job type
-// Returns empty string if the training type is not supported
-func getTrainingJobCRDName(trainingType string) string {
-	switch trainingType {
-	case string(types.TFTrainingJob):
-		return "tfjob.kubeflow.org"
-	case string(types.PytorchTrainingJob):
-		return "pytorchjob.kubeflow.org"
-	case string(types.MPITrainingJob), string(types.HorovodTrainingJob):
-		return "mpijob.kubeflow.org"
-	case string(types.ETTrainingJob), string(types.DeepSpeedTrainingJob):
-		return "trainingjob.kai.alibabacloud.com"
-	case string(types.VolcanoTrainingJob):
-		return "job.batch.volcano.sh"
-	case string(types.SparkTrainingJob):
-		return "sparkapplication.sparkoperator.k8s.io"
-	case string(types.RayJob):
-		return "rayjob.ray.io"
-	default:
-		return ""
-	}
-}
-
 // getTrainingJobCRDResourceName returns the full resource name (kind.apiVersion) for skipping owner reference patching
 func getTrainingJobCRDResourceName(trainingType, name string) string {
-	crdName := getTrainingJobCRDName(trainingType)
+	crdName := kubeclient.GetTrainingJobCRDName(trainingType)
 	return fmt.Sprintf("%s/%s", crdName, name)
 }
 
@@ -436,7 +413,7 @@ func PatchOwnerReferenceWithAppInfoFile(name, trainingType, appInfoFile, namespa
 	errs := []string{}
 
 	// get training job CRD resource name
-	crdResourceName := getTrainingJobCRDName(trainingType)
+	crdResourceName := kubeclient.GetTrainingJobCRDName(trainingType)
 	if crdResourceName == "" {
 		return fmt.Errorf("unsupported training job type: %s", trainingType)
 	}
@@ -140,6 +140,32 @@ func AddTrainingJobLabel(job *training.Job, key string, value string) error {
 		if err != nil {
 			return err
 		}
+	case types.TrainJob:
+		args := job.Args().(*types.SubmitTrainJobArgs)
+		_, err := kubectl([]string{
+			"label",
+			"-n",
+			args.Namespace,
+			"trainjobs.trainer.kubeflow.org",
+			args.Name,
+			fmt.Sprintf("%s=%s", key, value),
+		})
+		if err != nil {
+			return err
+		}
+	case types.JobSetTrainingJob:
+		args := job.Args().(*types.SubmitJobSetArgs)
+		_, err := kubectl([]string{
+			"label",
+			"-n",
+			args.Namespace,
+			"jobsets.jobset.x-k8s.io",
+			args.Name,
+			fmt.Sprintf("%s=%s", key, value),
+		})
+		if err != nil {
+			return err
+		}
 	}
 	return nil
 }
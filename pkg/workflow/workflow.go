@@ -15,9 +15,11 @@
 package workflow
 
 import (
+	"errors"
 	"fmt"
 	"os"
 
+	"github.com/kubeflow/arena/pkg/apis/types"
 	"github.com/kubeflow/arena/pkg/util/helm"
 	"github.com/kubeflow/arena/pkg/util/kubeclient"
 	"github.com/kubeflow/arena/pkg/util/kubectl"
@@ -26,13 +28,122 @@ import (
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 )
 
+// appInstaller creates and deletes the kubernetes resources of an app,
+// by client-go in process or by the kubectl binary if --kubectl-binary is given
+type appInstaller struct {
+	saveAppInfo         func(fileName, namespace string) (string, error)
+	uninstallApps       func(appInfoFile, namespace string) error
+	installApps         func(fileName, namespace string) (string, error)
+	patchOwnerReference func(name, trainingType, appInfoFile, namespace string) error
+}
+
+func getAppInstaller() appInstaller {
+	if viper.GetBool("kubectl-binary") {
+		return appInstaller{
+			saveAppInfo:         kubectl.SaveAppInfo,
+			uninstallApps:       kubectl.UninstallAppsWithAppInfoFile,
+			installApps:         kubectl.InstallApps,
+			patchOwnerReference: kubectl.PatchOwnerReferenceWithAppInfoFile,
+		}
+	}
+	return appInstaller{
+		saveAppInfo:         kubeclient.SaveAppInfo,
+		uninstallApps:       kubeclient.UninstallAppsWithAppInfoFile,
+		installApps:         kubeclient.InstallApps,
+		patchOwnerReference: kubeclient.PatchOwnerReferenceWithAppInfoFile,
+	}
+}
+
+// checkDryRunStrategy returns the strategy of dry run given by the submit args, DryRunNone if it is not set
+func checkDryRunStrategy(strategy types.DryRunStrategy) (types.DryRunStrategy, error) {
+	switch strategy {
+	case "", types.DryRunNone:
+		return types.DryRunNone, nil
+	case types.DryRunClient, types.DryRunServer:
+		return strategy, nil
+	}
+	return types.DryRunNone, fmt.Errorf("invalid dry run strategy %v, must be one of %v, %v, %v", strategy, types.DryRunNone, types.DryRunClient, types.DryRunServer)
+}
+
+// ErrDryRun is returned by SubmitJob and SubmitOps when the job is only rendered or validated by option --dry-run,
+// it stops the following steps of the submission and is ignored by IgnoreDryRun
+var ErrDryRun = errors.New("the job is not submitted in dry run mode")
+
+// IgnoreDryRun returns nil if the error is ErrDryRun
+func IgnoreDryRun(err error) error {
+	if errors.Is(err, ErrDryRun) {
+		return nil
+	}
+	return err
+}
+
+// dryRunApps prints the rendered manifests of the template file and returns ErrDryRun,
+// they are validated by the api server if the strategy is server
+func dryRunApps(valueFileName, template, namespace string, strategy types.DryRunStrategy) error {
+	defer removeTempFiles(valueFileName, template)
+	if strategy == types.DryRunServer {
+		manifests, err := kubeclient.DryRunApps(template, namespace)
+		fmt.Printf("%s", manifests)
+		if err != nil {
+			return err
+		}
+		return ErrDryRun
+	}
+	data, err := os.ReadFile(template)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%s", string(data))
+	return ErrDryRun
+}
+
+// removeTempFiles removes the temporary files unless the log level is debug
+func removeTempFiles(fileNames ...string) {
+	if log.GetLevel() == log.DebugLevel {
+		return
+	}
+	for _, fileName := range fileNames {
+		if err := os.Remove(fileName); err != nil {
+			log.Warnf("Failed to delete %s due to %v", fileName, err)
+		}
+	}
+}
+
 /**
 *	delete training job with the job name
 **/
 
 func DeleteJob(name, namespace, trainingType string) error {
 	jobName := fmt.Sprintf("%s-%s", name, trainingType)
+	if viper.GetBool("kubectl-binary") {
+		return deleteJobWithKubectl(jobName, namespace)
+	}
+
+	configMap, err := kubeclient.GetConfigMap(namespace, jobName)
+	if err != nil {
+		log.Debugf("Failed to get the app configmap %s due to %v", jobName, err)
+		return err
+	}
+
+	err = kubeclient.UninstallAppsWithAppInfo(configMap.Data["app"], namespace)
+	if err != nil {
+		log.Warnf("Failed to UninstallAppsWithAppInfo due to %v", err)
+		log.Warnln("manually delete the following resource:")
+		return err
+	}
+
+	// Manual deletion is required because serving, evaluation, and analysis job configmaps do not have an owner reference.
+	err = kubeclient.DeleteConfigMap(namespace, jobName)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		log.Warningf("Delete configmap %s failed, please clean it manually due to %v.", jobName, err)
+		log.Warningf("Please run `kubectl delete -n %s cm %s`", namespace, jobName)
+		return err
+	}
+
+	return nil
+}
 
+func deleteJobWithKubectl(jobName, namespace string) error {
 	appInfoFileName, err := kubectl.SaveAppConfigMapToFile(jobName, "app", namespace)
 	if err != nil {
 		log.Debugf("Failed to SaveAppConfigMapToFile due to %v", err)
@@ -62,11 +173,22 @@ func DeleteJob(name, namespace, trainingType string) error {
 *	Submit operation, scaleIn or scaleOut
 **/
 
-func SubmitOps(name string, trainingType string, namespace string, values interface{}, chart string, options ...string) error {
-	found := kubectl.CheckAppConfigMap(fmt.Sprintf("%s-%s", name, trainingType), namespace)
-	if found {
-		return fmt.Errorf("the job configmap %v-%v is already exist, please delete it first", name, trainingType)
+func SubmitOps(name string, trainingType string, namespace string, values interface{}, chart string, dryRun types.DryRunStrategy, options ...string) error {
+	dryRun, err := checkDryRunStrategy(dryRun)
+	if err != nil {
+		return err
+	}
+	// the manifests are rendered offline when the client dry run is given
+	if dryRun != types.DryRunClient {
+		_, err = kubeclient.GetConfigMap(namespace, fmt.Sprintf("%v-%v", name, trainingType))
+		if err == nil {
+			return fmt.Errorf("the job configmap %v-%v is already exist, please delete it first", name, trainingType)
+		}
+		if !k8serrors.IsNotFound(err) {
+			return err
+		}
 	}
+	installer := getAppInstaller()
 
 	// 1. Generate value file
 	valueFileName, err := helm.GenerateValueFile(values)
@@ -85,20 +207,23 @@ func SubmitOps(name string, trainingType string, namespace string, values interf
 	if err != nil {
 		return err
 	}
+	if dryRun != types.DryRunNone {
+		return dryRunApps(valueFileName, template, namespace, dryRun)
+	}
 
 	// 3. Generate AppInfo file
-	appInfoFileName, err := kubectl.SaveAppInfo(template, namespace)
+	appInfoFileName, err := installer.saveAppInfo(template, namespace)
 	if err != nil {
 		return err
 	}
 
 	// 4. Create Application
-	err = kubectl.UninstallAppsWithAppInfoFile(appInfoFileName, namespace)
+	err = installer.uninstallApps(appInfoFileName, namespace)
 	if err != nil {
 		log.Debugf("Failed to UninstallAppsWithAppInfoFile due to %v", err)
 	}
 
-	result, err := kubectl.InstallApps(template, namespace)
+	result, err := installer.installApps(template, namespace)
 	fmt.Printf("%s", result)
 	if err != nil {
 		// clean configmap
@@ -132,14 +257,22 @@ func SubmitOps(name string, trainingType string, namespace string, values interf
 *	Submit training, serving, evaluation and analysis job
 **/
 
-func SubmitJob(name string, trainingType string, namespace string, values interface{}, chart string, options ...string) error {
-	_, err := kubeclient.GetConfigMap(namespace, fmt.Sprintf("%v-%v", name, trainingType))
-	if err == nil {
-		return fmt.Errorf("the job configmap %v-%v is already exist, please delete it first", name, trainingType)
-	}
-	if !k8serrors.IsNotFound(err) {
+func SubmitJob(name string, trainingType string, namespace string, values interface{}, chart string, dryRun types.DryRunStrategy, options ...string) error {
+	dryRun, err := checkDryRunStrategy(dryRun)
+	if err != nil {
 		return err
 	}
+	// the manifests are rendered offline when the client dry run is given
+	if dryRun != types.DryRunClient {
+		_, err = kubeclient.GetConfigMap(namespace, fmt.Sprintf("%v-%v", name, trainingType))
+		if err == nil {
+			return fmt.Errorf("the job configmap %v-%v is already exist, please delete it first", name, trainingType)
+		}
+		if !k8serrors.IsNotFound(err) {
+			return err
+		}
+	}
+	installer := getAppInstaller()
 	// 1. Generate value file
 	valueFileName, err := helm.GenerateValueFile(values)
 	if err != nil {
@@ -157,9 +290,12 @@ func SubmitJob(name string, trainingType string, namespace string, values interf
 	if err != nil {
 		return err
 	}
+	if dryRun != types.DryRunNone {
+		return dryRunApps(valueFileName, template, namespace, dryRun)
+	}
 
 	// 3. Generate AppInfo file
-	appInfoFileName, err := kubectl.SaveAppInfo(template, namespace)
+	appInfoFileName, err := installer.saveAppInfo(template, namespace)
 	if err != nil {
 		return err
 	}
@@ -182,12 +318,12 @@ func SubmitJob(name string, trainingType string, namespace string, values interf
 		return err
 	}
 	// 5. Create Application
-	err = kubectl.UninstallAppsWithAppInfoFile(appInfoFileName, namespace)
+	err = installer.uninstallApps(appInfoFileName, namespace)
 	if err != nil {
 		log.Debugf("Failed to UninstallAppsWithAppInfoFile due to %v", err)
 	}
 
-	result, err := kubectl.InstallApps(template, namespace)
+	result, err := installer.installApps(template, namespace)
 	fmt.Printf("%s", result)
 	if err != nil {
 		// clean configmap
@@ -203,7 +339,7 @@ func SubmitJob(name string, trainingType string, namespace string, values interf
 	}
 
 	// 6. Patch OwnerReference for all training job types
-	err = kubectl.PatchOwnerReferenceWithAppInfoFile(name, trainingType, appInfoFileName, namespace)
+	err = installer.patchOwnerReference(name, trainingType, appInfoFileName, namespace)
 	if err != nil {
 		log.Debugf("Failed to patch ownerReference %s due to %v", name, err)
 	}